@@ -0,0 +1,154 @@
+package fastly
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+
+	"github.com/google/jsonapi"
+)
+
+// These are the kinds of account access an allowlist entry can apply to.
+const (
+	// AllowlistScopeAPI restricts API requests made with the account's tokens.
+	AllowlistScopeAPI = "api"
+
+	// AllowlistScopeUI restricts logins to the web interface.
+	AllowlistScopeUI = "ui"
+
+	// AllowlistScopeAll restricts both API requests and web interface logins.
+	AllowlistScopeAll = "all"
+)
+
+// AllowlistEntry is a CIDR range allowed to access a customer account. Once an
+// account has an entry for a scope, access of that kind from any address
+// outside the account's entries is refused.
+type AllowlistEntry struct {
+	ID          string `jsonapi:"primary,ip_allowlist_entry"`
+	CIDR        string `jsonapi:"attr,cidr,omitempty"`
+	Scope       string `jsonapi:"attr,scope,omitempty"`
+	Description string `jsonapi:"attr,description,omitempty"`
+	CreatedAt   string `jsonapi:"attr,created_at,omitempty"`
+	UpdatedAt   string `jsonapi:"attr,updated_at,omitempty"`
+}
+
+// allowlistEntryType is used for reflection because JSONAPI wants to know
+// what it's decoding into.
+var allowlistEntryType = reflect.TypeOf(new(AllowlistEntry))
+
+// Contains reports whether ip is within the entry's CIDR range.
+func (e *AllowlistEntry) Contains(ip net.IP) bool {
+	_, n, err := net.ParseCIDR(e.CIDR)
+	return err == nil && n.Contains(ip)
+}
+
+// ListAllowlistEntriesInput is used as input to the ListAllowlistEntries
+// function.
+type ListAllowlistEntriesInput struct {
+	// CustomerID is the ID of the customer (required).
+	CustomerID string
+}
+
+// ListAllowlistEntries returns every allowlist entry of a customer account,
+// following every page of results.
+func (c *Client) ListAllowlistEntries(i *ListAllowlistEntriesInput) ([]*AllowlistEntry, error) {
+	if i.CustomerID == "" {
+		return nil, ErrMissingCustomerID
+	}
+
+	path := fmt.Sprintf("/customers/%s/ip_allowlist", i.CustomerID)
+	data, err := c.getAllJSONAPIPages(path, nil, allowlistEntryType)
+	if err != nil {
+		return nil, err
+	}
+
+	es := make([]*AllowlistEntry, len(data))
+	for i := range data {
+		typed, ok := data[i].(*AllowlistEntry)
+		if !ok {
+			return nil, fmt.Errorf("got back a non-AllowlistEntry response")
+		}
+		es[i] = typed
+	}
+	return es, nil
+}
+
+// CreateAllowlistEntryInput is used as input to the CreateAllowlistEntry
+// function.
+type CreateAllowlistEntryInput struct {
+	// CustomerID is the ID of the customer (required).
+	CustomerID string
+
+	// ID is ignored; it is required by the JSON:API encoding.
+	ID string `jsonapi:"primary,ip_allowlist_entry"`
+
+	// CIDR is the range to allow, such as "192.0.2.0/24", and is required.
+	CIDR string `jsonapi:"attr,cidr"`
+
+	// Scope is the kind of access to allow. The default is AllowlistScopeAll.
+	Scope string `jsonapi:"attr,scope,omitempty"`
+
+	// Description is a note on who or what uses the range. Optional.
+	Description string `jsonapi:"attr,description,omitempty"`
+}
+
+// CreateAllowlistEntry allows a CIDR range to access a customer account. The
+// range is checked before it is sent. Adding the first entry for a scope locks
+// out every other address, so the caller's own address should be added first.
+func (c *Client) CreateAllowlistEntry(i *CreateAllowlistEntryInput) (*AllowlistEntry, error) {
+	if i.CustomerID == "" {
+		return nil, ErrMissingCustomerID
+	}
+
+	if i.CIDR == "" {
+		return nil, ErrMissingCIDR
+	}
+
+	if _, _, err := net.ParseCIDR(i.CIDR); err != nil {
+		return nil, err
+	}
+
+	switch i.Scope {
+	case "", AllowlistScopeAPI, AllowlistScopeUI, AllowlistScopeAll:
+	default:
+		return nil, fmt.Errorf("unknown allowlist scope %q", i.Scope)
+	}
+
+	path := fmt.Sprintf("/customers/%s/ip_allowlist", i.CustomerID)
+	resp, err := c.PostJSONAPI(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var e AllowlistEntry
+	if err := jsonapi.UnmarshalPayload(resp.Body, &e); err != nil {
+		return nil, err
+	}
+	return &e, nil
+}
+
+// DeleteAllowlistEntryInput is used as input to the DeleteAllowlistEntry
+// function.
+type DeleteAllowlistEntryInput struct {
+	// CustomerID is the ID of the customer (required).
+	CustomerID string
+
+	// ID is the ID of the entry (required).
+	ID string
+}
+
+// DeleteAllowlistEntry removes an allowlist entry from a customer account.
+// Deleting the last entry for a scope lifts the restriction entirely.
+func (c *Client) DeleteAllowlistEntry(i *DeleteAllowlistEntryInput) error {
+	if i.CustomerID == "" {
+		return ErrMissingCustomerID
+	}
+
+	if i.ID == "" {
+		return ErrMissingID
+	}
+
+	path := fmt.Sprintf("/customers/%s/ip_allowlist/%s", i.CustomerID, i.ID)
+	_, err := c.Delete(path, nil)
+	return err
+}
@@ -0,0 +1,98 @@
+package fastly
+
+import (
+	"net"
+	"testing"
+)
+
+func TestClient_AllowlistEntries(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var es []*AllowlistEntry
+	record(t, "account_allowlist/list", func(c *Client) {
+		es, err = c.ListAllowlistEntries(&ListAllowlistEntriesInput{
+			CustomerID: "zwBncFVs2Ixrhd8xxxxxx",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(es) != 2 {
+		t.Fatalf("expected 2 entries across both pages, got %d", len(es))
+	}
+	if es[0].CIDR != "192.0.2.0/24" || es[0].Scope != AllowlistScopeAll {
+		t.Errorf("bad entry: %+v", es[0])
+	}
+	if !es[1].Contains(net.ParseIP("2001:db8::1")) {
+		t.Errorf("expected %s to contain 2001:db8::1", es[1].CIDR)
+	}
+
+	var e *AllowlistEntry
+	record(t, "account_allowlist/create", func(c *Client) {
+		e, err = c.CreateAllowlistEntry(&CreateAllowlistEntryInput{
+			CustomerID:  "zwBncFVs2Ixrhd8xxxxxx",
+			CIDR:        "198.51.100.7/32",
+			Scope:       AllowlistScopeUI,
+			Description: "vpn",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.ID != "aLw3EnTrYxQ2bWp0cLk3Ad" {
+		t.Errorf("bad id: %q", e.ID)
+	}
+	if e.Scope != AllowlistScopeUI {
+		t.Errorf("bad scope: %q", e.Scope)
+	}
+
+	record(t, "account_allowlist/delete", func(c *Client) {
+		err = c.DeleteAllowlistEntry(&DeleteAllowlistEntryInput{
+			CustomerID: "zwBncFVs2Ixrhd8xxxxxx",
+			ID:         e.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_AllowlistEntries_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListAllowlistEntries(&ListAllowlistEntriesInput{})
+	if err != ErrMissingCustomerID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateAllowlistEntry(&CreateAllowlistEntryInput{
+		CustomerID: "zwBncFVs2Ixrhd8xxxxxx",
+	})
+	if err != ErrMissingCIDR {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateAllowlistEntry(&CreateAllowlistEntryInput{
+		CustomerID: "zwBncFVs2Ixrhd8xxxxxx",
+		CIDR:       "192.0.2.0/33",
+	})
+	if _, ok := err.(*net.ParseError); !ok {
+		t.Errorf("bad error: %v", err)
+	}
+
+	_, err = testClient.CreateAllowlistEntry(&CreateAllowlistEntryInput{
+		CustomerID: "zwBncFVs2Ixrhd8xxxxxx",
+		CIDR:       "192.0.2.0/24",
+		Scope:      "cli",
+	})
+	if err == nil {
+		t.Error("expected an unknown scope to be rejected")
+	}
+
+	err = testClient.DeleteAllowlistEntry(&DeleteAllowlistEntryInput{
+		CustomerID: "zwBncFVs2Ixrhd8xxxxxx",
+	})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
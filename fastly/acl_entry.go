@@ -1,8 +1,13 @@
 package fastly
 
 import (
+	"bufio"
+	"encoding/csv"
 	"fmt"
+	"io"
+	"net"
 	"sort"
+	"strings"
 )
 
 type ACLEntry struct {
@@ -30,6 +35,19 @@ func (s entriesById) Less(i, j int) bool {
 type ListACLEntriesInput struct {
 	Service string
 	ACL     string
+
+	// Optional fields
+
+	// Page and PerPage select a single page of results. When neither is set,
+	// every entry is returned in one response.
+	Page    int
+	PerPage int
+
+	// Sort is the field to sort by on the server, and Direction is
+	// DirectionAscend or DirectionDescend. When Sort is not set, entries are
+	// sorted by ID locally.
+	Sort      string
+	Direction string
 }
 
 // ListACLEntries return a list of entries for an ACL
@@ -44,7 +62,9 @@ func (c *Client) ListACLEntries(i *ListACLEntriesInput) ([]*ACLEntry, error) {
 
 	path := fmt.Sprintf("/service/%s/acl/%s/entries", i.Service, i.ACL)
 
-	resp, err := c.Get(path, nil)
+	resp, err := c.Get(path, &RequestOptions{
+		Params: paginationParams(i.Page, i.PerPage, i.Sort, i.Direction),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -54,11 +74,42 @@ func (c *Client) ListACLEntries(i *ListACLEntriesInput) ([]*ACLEntry, error) {
 		return nil, err
 	}
 
-	sort.Stable(entriesById(es))
+	if i.Sort == "" {
+		sort.Stable(entriesById(es))
+	}
 
 	return es, nil
 }
 
+// ListAllACLEntries returns every entry in an ACL, fetching one page at a
+// time. The Page field of the input is ignored; PerPage defaults to
+// ListAllPerPage.
+func (c *Client) ListAllACLEntries(i *ListACLEntriesInput) ([]*ACLEntry, error) {
+	in := *i
+	if in.PerPage == 0 {
+		in.PerPage = ListAllPerPage
+	}
+
+	var all []*ACLEntry
+	for in.Page = 1; ; in.Page++ {
+		es, err := c.ListACLEntries(&in)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, es...)
+
+		if len(es) < in.PerPage {
+			break
+		}
+	}
+
+	if in.Sort == "" {
+		sort.Stable(entriesById(all))
+	}
+
+	return all, nil
+}
+
 // GetACLEntryInput is the input parameter to GetACLEntry function.
 type GetACLEntryInput struct {
 	Service string
@@ -220,3 +271,284 @@ func (c *Client) UpdateACLEntry(i *UpdateACLEntryInput) (*ACLEntry, error) {
 
 	return e, nil
 }
+
+// BatchACLEntry is a single operation in a BatchModifyACLEntries request.
+type BatchACLEntry struct {
+	Operation BatchOperation `json:"op"`
+	ID        string         `json:"id,omitempty"`
+	IP        string         `json:"ip,omitempty"`
+	Subnet    string         `json:"subnet,omitempty"`
+	Negated   bool           `json:"negated"`
+	Comment   string         `json:"comment,omitempty"`
+}
+
+// BatchModifyACLEntriesInput is the input parameter to the
+// BatchModifyACLEntries function.
+type BatchModifyACLEntriesInput struct {
+	// Required fields
+	Service string           `json:"-"`
+	ACL     string           `json:"-"`
+	Entries []*BatchACLEntry `json:"entries"`
+}
+
+// BatchModifyACLEntries creates, updates, and deletes ACL entries in a single
+// request. At most BatchModifyMaximumOperations entries may be given.
+func (c *Client) BatchModifyACLEntries(i *BatchModifyACLEntriesInput) error {
+	if i.Service == "" {
+		return ErrMissingService
+	}
+
+	if i.ACL == "" {
+		return ErrMissingACL
+	}
+
+	if len(i.Entries) > BatchModifyMaximumOperations {
+		return ErrBatchModifyMaximumOperationsExceeded
+	}
+
+	path := fmt.Sprintf("/service/%s/acl/%s/entries", i.Service, i.ACL)
+
+	body := appendBatchACLEntries(make([]byte, 0, batchACLEntriesSize(i.Entries)), i.Entries)
+	resp, err := c.requestJSONBody("PATCH", path, body)
+	if err != nil {
+		return err
+	}
+
+	var r *statusResp
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return err
+	}
+
+	if !r.Ok() {
+		return fmt.Errorf("Not OK")
+	}
+
+	return nil
+}
+
+// ImportACLEntriesInput is the input parameter to ImportACLEntries function.
+type ImportACLEntriesInput struct {
+	// Required fields
+	Service string
+	ACL     string
+
+	// Source is read for the entries to import. Each line is an IP or CIDR,
+	// optionally followed by a comma and a comment, as in a CSV file. A leading
+	// "!" negates the entry. Blank lines and lines starting with "#" are
+	// ignored. Each IP or CIDR may appear only once.
+	Source io.Reader
+
+	// Optional fields
+
+	// Prune deletes existing entries that are not present in Source, so the
+	// ACL exactly mirrors it.
+	Prune bool
+}
+
+// ImportACLEntries reads entries from the given source and applies them to the
+// ACL using the batch API. Entries that already exist with the same negation
+// and comment are left alone. The operations that were applied are returned.
+func (c *Client) ImportACLEntries(i *ImportACLEntriesInput) ([]*BatchACLEntry, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.ACL == "" {
+		return nil, ErrMissingACL
+	}
+
+	if i.Source == nil {
+		return nil, ErrMissingSource
+	}
+
+	want, err := parseACLEntries(i.Source)
+	if err != nil {
+		return nil, err
+	}
+
+	es, err := c.ListAllACLEntries(&ListACLEntriesInput{
+		Service: i.Service,
+		ACL:     i.ACL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	have := make(map[string]*ACLEntry, len(es))
+	for _, e := range es {
+		have[aclEntryCIDR(e.IP, e.Subnet)] = e
+	}
+
+	var ops []*BatchACLEntry
+	seen := make(map[string]bool, len(want))
+	for _, w := range want {
+		key := aclEntryCIDR(w.IP, w.Subnet)
+		seen[key] = true
+
+		e, ok := have[key]
+		switch {
+		case !ok:
+			w.Operation = BatchOperationCreate
+			ops = append(ops, w)
+		case e.Negated != w.Negated || e.Comment != w.Comment:
+			w.Operation = BatchOperationUpdate
+			w.ID = e.ID
+			ops = append(ops, w)
+		}
+	}
+
+	if i.Prune {
+		for _, e := range es {
+			if seen[aclEntryCIDR(e.IP, e.Subnet)] {
+				continue
+			}
+			ops = append(ops, &BatchACLEntry{
+				Operation: BatchOperationDelete,
+				ID:        e.ID,
+			})
+		}
+	}
+
+	for start := 0; start < len(ops); start += BatchModifyMaximumOperations {
+		end := start + BatchModifyMaximumOperations
+		if end > len(ops) {
+			end = len(ops)
+		}
+
+		if err := c.BatchModifyACLEntries(&BatchModifyACLEntriesInput{
+			Service: i.Service,
+			ACL:     i.ACL,
+			Entries: ops[start:end],
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	return ops, nil
+}
+
+// ExportACLEntriesInput is the input parameter to ExportACLEntries function.
+type ExportACLEntriesInput struct {
+	// Required fields
+	Service string
+	ACL     string
+
+	// Destination is where the entries are written, in the same format read by
+	// ImportACLEntries.
+	Destination io.Writer
+}
+
+// ExportACLEntries writes every entry in the ACL to the given destination, one
+// per line.
+func (c *Client) ExportACLEntries(i *ExportACLEntriesInput) error {
+	if i.Service == "" {
+		return ErrMissingService
+	}
+
+	if i.ACL == "" {
+		return ErrMissingACL
+	}
+
+	if i.Destination == nil {
+		return ErrMissingDestination
+	}
+
+	es, err := c.ListAllACLEntries(&ListACLEntriesInput{
+		Service: i.Service,
+		ACL:     i.ACL,
+	})
+	if err != nil {
+		return err
+	}
+
+	w := csv.NewWriter(i.Destination)
+	for _, e := range es {
+		cidr := aclEntryCIDR(e.IP, e.Subnet)
+		if e.Negated {
+			cidr = "!" + cidr
+		}
+
+		record := []string{cidr}
+		if e.Comment != "" {
+			record = append(record, e.Comment)
+		}
+
+		if err := w.Write(record); err != nil {
+			return err
+		}
+	}
+
+	w.Flush()
+	return w.Error()
+}
+
+// parseACLEntries parses the import format described on ImportACLEntriesInput.
+// Errors name the line of the source they were found on. An IP or CIDR that
+// appears on more than one line is rejected, since the lines may disagree on
+// negation or comment.
+func parseACLEntries(r io.Reader) ([]*BatchACLEntry, error) {
+	var es []*BatchACLEntry
+	lines := make(map[string]int)
+
+	sc := bufio.NewScanner(r)
+	for n := 1; sc.Scan(); n++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+
+		cr := csv.NewReader(strings.NewReader(line))
+		cr.FieldsPerRecord = -1
+		cr.TrimLeadingSpace = true
+		record, err := cr.Read()
+		if err != nil {
+			return nil, fmt.Errorf("Invalid entry on line %d: %s", n, err)
+		}
+
+		cidr := strings.TrimSpace(record[0])
+		if cidr == "" {
+			continue
+		}
+
+		e := &BatchACLEntry{}
+		if strings.HasPrefix(cidr, "!") {
+			e.Negated = true
+			cidr = cidr[1:]
+		}
+
+		e.IP = cidr
+		if idx := strings.Index(cidr, "/"); idx != -1 {
+			e.IP, e.Subnet = cidr[:idx], cidr[idx+1:]
+		}
+
+		if net.ParseIP(e.IP) == nil {
+			return nil, fmt.Errorf("Invalid IP %q on line %d", e.IP, n)
+		}
+
+		key := aclEntryCIDR(e.IP, e.Subnet)
+		if first, ok := lines[key]; ok {
+			return nil, fmt.Errorf("Duplicate entry %q on line %d, first seen on line %d", key, n, first)
+		}
+		lines[key] = n
+
+		if len(record) > 1 {
+			e.Comment = strings.TrimSpace(record[1])
+		}
+
+		es = append(es, e)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return es, nil
+}
+
+// aclEntryCIDR returns the IP and subnet in CIDR notation, or just the IP if
+// there is no subnet.
+func aclEntryCIDR(ip, subnet string) string {
+	if subnet == "" {
+		return ip
+	}
+	return ip + "/" + subnet
+}
@@ -1,6 +1,10 @@
 package fastly
 
-import "testing"
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
 
 func TestClient_ACLEntries(t *testing.T) {
 
@@ -152,6 +156,29 @@ func TestClient_ACLEntries(t *testing.T) {
 
 }
 
+func TestClient_ListAllACLEntries(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var es []*ACLEntry
+	record(t, "acl_entries/list_all", func(c *Client) {
+		es, err = c.ListAllACLEntries(&ListACLEntriesInput{
+			Service: testServiceID,
+			ACL:     "4JAplf6f7kVjXoSHHvX2n5",
+			PerPage: 2,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(es) != 3 {
+		t.Fatalf("bad entries: %v", es)
+	}
+	if es[2].ID != "6xq9tgI41s6bhUuRfq0dzS" {
+		t.Errorf("bad ID: %q", es[2].ID)
+	}
+}
+
 func TestClient_ListACLEntries_validation(t *testing.T) {
 	var err error
 	_, err = testClient.ListACLEntries(&ListACLEntriesInput{
@@ -268,3 +295,155 @@ func TestClient_DeleteACLEntry_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_ImportACLEntries(t *testing.T) {
+	t.Parallel()
+
+	source := strings.NewReader(`# threat feed
+10.0.0.0/8,internal
+!198.51.100.0/24,threat feed
+203.0.113.7
+`)
+
+	var err error
+	var ops []*BatchACLEntry
+	record(t, "acl_entries/import", func(c *Client) {
+		ops, err = c.ImportACLEntries(&ImportACLEntriesInput{
+			Service: testServiceID,
+			ACL:     "4JAplf6f7kVjXoSHHvX2n5",
+			Source:  source,
+			Prune:   true,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ops) != 3 {
+		t.Fatalf("bad ops: %v", ops)
+	}
+	if ops[0].Operation != BatchOperationUpdate || ops[0].ID != "6xq9tgI41s6bhUuRfq0dzS" || !ops[0].Negated {
+		t.Errorf("bad update: %#v", ops[0])
+	}
+	if ops[1].Operation != BatchOperationCreate || ops[1].IP != "203.0.113.7" || ops[1].Subnet != "" {
+		t.Errorf("bad create: %#v", ops[1])
+	}
+	if ops[2].Operation != BatchOperationDelete || ops[2].ID != "3sYSD3DZyjrSTFTiSRWEpv" {
+		t.Errorf("bad delete: %#v", ops[2])
+	}
+}
+
+func TestClient_ExportACLEntries(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var buf bytes.Buffer
+	record(t, "acl_entries/export", func(c *Client) {
+		err = c.ExportACLEntries(&ExportACLEntriesInput{
+			Service:     testServiceID,
+			ACL:         "4JAplf6f7kVjXoSHHvX2n5",
+			Destination: &buf,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := "10.0.0.0/8,internal\n!192.0.2.10,old feed entry\n198.51.100.0/24\n"
+	if buf.String() != expected {
+		t.Errorf("bad export: %q", buf.String())
+	}
+}
+
+func TestClient_ImportACLEntries_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ImportACLEntries(&ImportACLEntriesInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ImportACLEntries(&ImportACLEntriesInput{
+		Service: "foo",
+		ACL:     "",
+	})
+	if err != ErrMissingACL {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ImportACLEntries(&ImportACLEntriesInput{
+		Service: "foo",
+		ACL:     "bar",
+	})
+	if err != ErrMissingSource {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ImportACLEntries(&ImportACLEntriesInput{
+		Service: "foo",
+		ACL:     "bar",
+		Source:  strings.NewReader("not-an-ip\n"),
+	})
+	if err == nil {
+		t.Error("expected error for invalid IP")
+	}
+}
+
+func TestParseACLEntries_lines(t *testing.T) {
+	_, err := parseACLEntries(strings.NewReader(`# threat feed
+
+10.0.0.0/8,internal
+not-an-ip
+`))
+	if err == nil || !strings.Contains(err.Error(), "line 4") {
+		t.Errorf("bad error: %v", err)
+	}
+
+	_, err = parseACLEntries(strings.NewReader(`10.0.0.0/8,internal
+# duplicate below
+
+!10.0.0.0/8,threat feed
+`))
+	if err == nil || !strings.Contains(err.Error(), "line 4, first seen on line 1") {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestClient_ExportACLEntries_validation(t *testing.T) {
+	var err error
+	err = testClient.ExportACLEntries(&ExportACLEntriesInput{
+		Service: "foo",
+		ACL:     "bar",
+	})
+	if err != ErrMissingDestination {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_BatchModifyACLEntries_validation(t *testing.T) {
+	var err error
+	err = testClient.BatchModifyACLEntries(&BatchModifyACLEntriesInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.BatchModifyACLEntries(&BatchModifyACLEntriesInput{
+		Service: "foo",
+		ACL:     "",
+	})
+	if err != ErrMissingACL {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.BatchModifyACLEntries(&BatchModifyACLEntriesInput{
+		Service: "foo",
+		ACL:     "bar",
+		Entries: make([]*BatchACLEntry, BatchModifyMaximumOperations+1),
+	})
+	if err != ErrBatchModifyMaximumOperationsExceeded {
+		t.Errorf("bad error: %s", err)
+	}
+}
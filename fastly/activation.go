@@ -0,0 +1,157 @@
+package fastly
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"time"
+)
+
+// Activation is a single activation of a service version, as recorded in the
+// account's event log.
+type Activation struct {
+	ServiceID string
+	Version   int
+
+	// Comment is the version comment, or empty if the version no longer
+	// exists.
+	Comment string
+
+	// ActivatedAt is when the version was activated.
+	ActivatedAt time.Time
+
+	// UserID and TokenID identify who activated the version, and IP is the
+	// address the request came from. UserLogin and UserName are only set
+	// with ResolveUsers.
+	UserID    string
+	UserLogin string
+	UserName  string
+	TokenID   string
+	IP        string
+
+	// Admin is true when the version was activated by Fastly staff.
+	Admin bool
+
+	// EventID is the ID of the version.activate event.
+	EventID string
+}
+
+// activationsByTime is a sortable list of activations.
+type activationsByTime []*Activation
+
+// Len, Swap, and Less implement the sortable interface.
+func (s activationsByTime) Len() int      { return len(s) }
+func (s activationsByTime) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s activationsByTime) Less(i, j int) bool {
+	return s[i].ActivatedAt.Before(s[j].ActivatedAt)
+}
+
+// ListActivationsInput is used as input to the ListActivations function.
+type ListActivationsInput struct {
+	// Service is the ID of the service (required).
+	Service string
+
+	// CreatedAfter and CreatedBefore limit the activations to a time range.
+	// Optional.
+	CreatedAfter  time.Time
+	CreatedBefore time.Time
+
+	// ResolveUsers looks up the login and name of each user who activated a
+	// version. Users who cannot be looked up, such as those who have left
+	// the account, are left unresolved.
+	ResolveUsers bool
+}
+
+// activationDescription matches the description of a version.activate
+// event, for events without the version in their metadata.
+var activationDescription = regexp.MustCompile(`^Version (\d+) was activated`)
+
+// ListActivations returns the activation history of a service, oldest first,
+// built from the version.activate events in the event log and the service's
+// versions. A version appears once for each time it was activated.
+func (c *Client) ListActivations(i *ListActivationsInput) ([]*Activation, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	versions, err := c.ListVersions(&ListVersionsInput{Service: i.Service})
+	if err != nil {
+		return nil, err
+	}
+	comments := make(map[int]string, len(versions))
+	for _, v := range versions {
+		comments[v.Number] = v.Comment
+	}
+
+	var activations []*Activation
+	it := c.NewEventIterator(&GetAPIEventsFilterInput{
+		ServiceID:     i.Service,
+		EventType:     "version.activate",
+		CreatedAfter:  i.CreatedAfter,
+		CreatedBefore: i.CreatedBefore,
+	})
+	for it.Next() {
+		e := it.Event()
+		version, ok := activatedVersion(e)
+		if !ok {
+			continue
+		}
+		at, err := time.Parse(time.RFC3339, e.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		activations = append(activations, &Activation{
+			ServiceID:   i.Service,
+			Version:     version,
+			Comment:     comments[version],
+			ActivatedAt: at,
+			UserID:      e.UserID,
+			TokenID:     e.TokenID,
+			IP:          e.IP,
+			Admin:       e.Admin,
+			EventID:     e.ID,
+		})
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+
+	if i.ResolveUsers {
+		users := make(map[string]*User)
+		for _, a := range activations {
+			if a.UserID == "" {
+				continue
+			}
+			u, ok := users[a.UserID]
+			if !ok {
+				u, _ = c.GetUser(&GetUserInput{ID: a.UserID})
+				users[a.UserID] = u
+			}
+			if u != nil {
+				a.UserLogin, a.UserName = u.Login, u.Name
+			}
+		}
+	}
+
+	sort.Stable(activationsByTime(activations))
+	return activations, nil
+}
+
+// activatedVersion returns the version number of a version.activate event,
+// from its metadata or, failing that, its description.
+func activatedVersion(e *Event) (int, bool) {
+	switch v := e.Metadata["version"].(type) {
+	case float64:
+		return int(v), true
+	case string:
+		if n, err := strconv.Atoi(v); err == nil {
+			return n, true
+		}
+	}
+
+	if m := activationDescription.FindStringSubmatch(e.Description); m != nil {
+		n, err := strconv.Atoi(m[1])
+		return n, err == nil
+	}
+	return 0, false
+}
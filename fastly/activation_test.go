@@ -0,0 +1,50 @@
+package fastly
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClient_ListActivations(t *testing.T) {
+	t.Parallel()
+
+	var as []*Activation
+	var err error
+	record(t, "activations/list", func(c *Client) {
+		as, err = c.ListActivations(&ListActivationsInput{
+			Service:      "7kQfFIWFhi3TS1y8xxxxxx",
+			ResolveUsers: true,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(as) != 3 {
+		t.Fatalf("bad activations: %v", as)
+	}
+	for n, a := range as {
+		if a.Version != n+1 {
+			t.Errorf("bad version at %d: %d", n, a.Version)
+		}
+	}
+	if as[1].Comment != "Add shielding" {
+		t.Errorf("bad comment: %q", as[1].Comment)
+	}
+	if !as[1].ActivatedAt.Equal(time.Date(2026, 9, 20, 14, 30, 0, 0, time.UTC)) {
+		t.Errorf("bad activated at: %s", as[1].ActivatedAt)
+	}
+	if as[0].UserLogin != "jane@example.com" || as[0].UserName != "Jane Doe" {
+		t.Errorf("bad user: %q %q", as[0].UserLogin, as[0].UserName)
+	}
+	if as[2].UserID != "1RkQdPb5y7C2n0aYxxxxxx" || as[2].UserLogin != "" {
+		t.Errorf("bad unresolved user: %+v", as[2])
+	}
+}
+
+func TestClient_ListActivations_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListActivations(&ListActivationsInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"net/url"
 	"sort"
 )
 
@@ -10,31 +11,41 @@ type Backend struct {
 	ServiceID string `mapstructure:"service_id"`
 	Version   int    `mapstructure:"version"`
 
-	Name                string   `mapstructure:"name"`
-	Address             string   `mapstructure:"address"`
-	Port                uint     `mapstructure:"port"`
-	ConnectTimeout      uint     `mapstructure:"connect_timeout"`
-	MaxConn             uint     `mapstructure:"max_conn"`
-	ErrorThreshold      uint     `mapstructure:"error_threshold"`
-	FirstByteTimeout    uint     `mapstructure:"first_byte_timeout"`
-	BetweenBytesTimeout uint     `mapstructure:"between_bytes_timeout"`
-	AutoLoadbalance     bool     `mapstructure:"auto_loadbalance"`
-	Weight              uint     `mapstructure:"weight"`
-	RequestCondition    string   `mapstructure:"request_condition"`
-	HealthCheck         string   `mapstructure:"healthcheck"`
-	Hostname            string   `mapstructure:"hostname"`
-	Shield              string   `mapstructure:"shield"`
-	UseSSL              bool     `mapstructure:"use_ssl"`
-	SSLCheckCert        bool     `mapstructure:"ssl_check_cert"`
-	SSLCACert           string   `mapstructure:"ssl_ca_cert"`
-	SSLClientCert       string   `mapstructure:"ssl_client_cert"`
-	SSLClientKey        string   `mapstructure:"ssl_client_key"`
-	SSLHostname         string   `mapstructure:"ssl_hostname"`
-	SSLCertHostname     string   `mapstructure:"ssl_cert_hostname"`
-	SSLSNIHostname      string   `mapstructure:"ssl_sni_hostname"`
-	MinTLSVersion       string   `mapstructure:"min_tls_version"`
-	MaxTLSVersion       string   `mapstructure:"max_tls_version"`
-	SSLCiphers          []string `mapstructure:"ssl_ciphers"`
+	Name                string     `mapstructure:"name"`
+	Address             string     `mapstructure:"address"`
+	Port                uint       `mapstructure:"port"`
+	ConnectTimeout      uint       `mapstructure:"connect_timeout"`
+	MaxConn             uint       `mapstructure:"max_conn"`
+	ErrorThreshold      uint       `mapstructure:"error_threshold"`
+	FirstByteTimeout    uint       `mapstructure:"first_byte_timeout"`
+	BetweenBytesTimeout uint       `mapstructure:"between_bytes_timeout"`
+	AutoLoadbalance     bool       `mapstructure:"auto_loadbalance"`
+	Weight              uint       `mapstructure:"weight"`
+	RequestCondition    string     `mapstructure:"request_condition"`
+	HealthCheck         string     `mapstructure:"healthcheck"`
+	Hostname            string     `mapstructure:"hostname"`
+	Shield              string     `mapstructure:"shield"`
+	UseSSL              bool       `mapstructure:"use_ssl"`
+	SSLCheckCert        bool       `mapstructure:"ssl_check_cert"`
+	SSLCACert           string     `mapstructure:"ssl_ca_cert"`
+	SSLClientCert       string     `mapstructure:"ssl_client_cert"`
+	SSLClientKey        string     `mapstructure:"ssl_client_key" sensitive:"true"`
+	SSLHostname         string     `mapstructure:"ssl_hostname"`
+	SSLCertHostname     string     `mapstructure:"ssl_cert_hostname"`
+	SSLSNIHostname      string     `mapstructure:"ssl_sni_hostname"`
+	MinTLSVersion       string     `mapstructure:"min_tls_version"`
+	MaxTLSVersion       string     `mapstructure:"max_tls_version"`
+	SSLCiphers          CipherList `mapstructure:"ssl_ciphers"`
+}
+
+// String formats the backend with its SSL client key redacted.
+func (b *Backend) String() string {
+	return redactedString(b)
+}
+
+// GoString is like String, for the %#v verb.
+func (b *Backend) GoString() string {
+	return redactedGoString(b)
 }
 
 // backendsByName is a sortable list of backends.
@@ -104,13 +115,13 @@ type CreateBackendInput struct {
 	SSLCheckCert        *Compatibool `form:"ssl_check_cert,omitempty"`
 	SSLCACert           string       `form:"ssl_ca_cert,omitempty"`
 	SSLClientCert       string       `form:"ssl_client_cert,omitempty"`
-	SSLClientKey        string       `form:"ssl_client_key,omitempty"`
+	SSLClientKey        string       `form:"ssl_client_key,omitempty" sensitive:"true"`
 	SSLHostname         string       `form:"ssl_hostname,omitempty"`
 	SSLCertHostname     string       `form:"ssl_cert_hostname,omitempty"`
 	SSLSNIHostname      string       `form:"ssl_sni_hostname,omitempty"`
 	MinTLSVersion       string       `form:"min_tls_version,omitempty"`
 	MaxTLSVersion       string       `form:"max_tls_version,omitempty"`
-	SSLCiphers          []string     `form:"ssl_ciphers,omitempty"`
+	SSLCiphers          CipherList   `form:"ssl_ciphers,omitempty"`
 }
 
 // CreateBackend creates a new Fastly backend.
@@ -123,6 +134,18 @@ func (c *Client) CreateBackend(i *CreateBackendInput) (*Backend, error) {
 		return nil, ErrMissingVersion
 	}
 
+	if c.ValidateShields {
+		if err := c.ValidateBackendShield(i.Shield); err != nil {
+			return nil, err
+		}
+	}
+
+	if v, ok := c.APIVersions[APIEndpointBackend]; ok {
+		in := *i
+		compatSSLHostnames(v, &in.SSLHostname, &in.SSLCertHostname, &in.SSLSNIHostname)
+		i = &in
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/backend", i.Service, i.Version)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -161,7 +184,7 @@ func (c *Client) GetBackend(i *GetBackendInput) (*Backend, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/backend/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/backend/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Get(path, nil)
 	if err != nil {
 		return nil, err
@@ -201,13 +224,13 @@ type UpdateBackendInput struct {
 	SSLCheckCert        *Compatibool `form:"ssl_check_cert,omitempty"`
 	SSLCACert           string       `form:"ssl_ca_cert,omitempty"`
 	SSLClientCert       string       `form:"ssl_client_cert,omitempty"`
-	SSLClientKey        string       `form:"ssl_client_key,omitempty"`
+	SSLClientKey        string       `form:"ssl_client_key,omitempty" sensitive:"true"`
 	SSLHostname         string       `form:"ssl_hostname,omitempty"`
 	SSLCertHostname     string       `form:"ssl_cert_hostname,omitempty"`
 	SSLSNIHostname      string       `form:"ssl_sni_hostname,omitempty"`
 	MinTLSVersion       string       `form:"min_tls_version,omitempty"`
 	MaxTLSVersion       string       `form:"max_tls_version,omitempty"`
-	SSLCiphers          []string     `form:"ssl_ciphers,omitempty"`
+	SSLCiphers          CipherList   `form:"ssl_ciphers,omitempty"`
 }
 
 // UpdateBackend updates a specific backend.
@@ -224,7 +247,19 @@ func (c *Client) UpdateBackend(i *UpdateBackendInput) (*Backend, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/backend/%s", i.Service, i.Version, i.Name)
+	if c.ValidateShields {
+		if err := c.ValidateBackendShield(i.Shield); err != nil {
+			return nil, err
+		}
+	}
+
+	if v, ok := c.APIVersions[APIEndpointBackend]; ok {
+		in := *i
+		compatSSLHostnames(v, &in.SSLHostname, &in.SSLCertHostname, &in.SSLSNIHostname)
+		i = &in
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/backend/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
 		return nil, err
@@ -262,7 +297,7 @@ func (c *Client) DeleteBackend(i *DeleteBackendInput) error {
 		return ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/backend/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/backend/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Delete(path, nil)
 	if err != nil {
 		return err
@@ -0,0 +1,164 @@
+package fastly
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// The batch endpoints take up to BatchModifyMaximumOperations items per
+// request, and syncing large dictionaries and ACLs sends many such requests.
+// Their payloads are encoded by hand into a single buffer sized up front,
+// rather than with encoding/json, which spends most of its time on reflection
+// for these simple, flat structs; see the benchmarks in batch_test.go. The
+// output is identical to json.Marshal.
+
+// appendBatchDictionaryItems appends the JSON encoding of a
+// BatchModifyDictionaryItems request body to b.
+func appendBatchDictionaryItems(b []byte, items []*BatchDictionaryItem) []byte {
+	if items == nil {
+		return append(b, `{"items":null}`...)
+	}
+
+	b = append(b, `{"items":[`...)
+	for n, it := range items {
+		if n > 0 {
+			b = append(b, ',')
+		}
+		if it == nil {
+			b = append(b, "null"...)
+			continue
+		}
+		b = append(b, `{"op":`...)
+		b = appendJSONString(b, string(it.Operation))
+		b = append(b, `,"item_key":`...)
+		b = appendJSONString(b, it.ItemKey)
+		if it.ItemValue != "" {
+			b = append(b, `,"item_value":`...)
+			b = appendJSONString(b, it.ItemValue)
+		}
+		b = append(b, '}')
+	}
+	return append(b, "]}"...)
+}
+
+// batchDictionaryItemsSize estimates the encoded size of a
+// BatchModifyDictionaryItems request body, assuming no escaping is needed.
+func batchDictionaryItemsSize(items []*BatchDictionaryItem) int {
+	size := len(`{"items":[]}`)
+	for _, it := range items {
+		if it != nil {
+			size += len(`{"op":"","item_key":"","item_value":""},`) +
+				len(it.Operation) + len(it.ItemKey) + len(it.ItemValue)
+		}
+	}
+	return size
+}
+
+// appendBatchACLEntries appends the JSON encoding of a BatchModifyACLEntries
+// request body to b.
+func appendBatchACLEntries(b []byte, entries []*BatchACLEntry) []byte {
+	if entries == nil {
+		return append(b, `{"entries":null}`...)
+	}
+
+	b = append(b, `{"entries":[`...)
+	for n, e := range entries {
+		if n > 0 {
+			b = append(b, ',')
+		}
+		if e == nil {
+			b = append(b, "null"...)
+			continue
+		}
+		b = append(b, `{"op":`...)
+		b = appendJSONString(b, string(e.Operation))
+		if e.ID != "" {
+			b = append(b, `,"id":`...)
+			b = appendJSONString(b, e.ID)
+		}
+		if e.IP != "" {
+			b = append(b, `,"ip":`...)
+			b = appendJSONString(b, e.IP)
+		}
+		if e.Subnet != "" {
+			b = append(b, `,"subnet":`...)
+			b = appendJSONString(b, e.Subnet)
+		}
+		b = append(b, `,"negated":`...)
+		b = strconv.AppendBool(b, e.Negated)
+		if e.Comment != "" {
+			b = append(b, `,"comment":`...)
+			b = appendJSONString(b, e.Comment)
+		}
+		b = append(b, '}')
+	}
+	return append(b, "]}"...)
+}
+
+// batchACLEntriesSize estimates the encoded size of a BatchModifyACLEntries
+// request body, assuming no escaping is needed.
+func batchACLEntriesSize(entries []*BatchACLEntry) int {
+	size := len(`{"entries":[]}`)
+	for _, e := range entries {
+		if e != nil {
+			size += len(`{"op":"","id":"","ip":"","subnet":"","negated":false,"comment":""},`) +
+				len(e.Operation) + len(e.ID) + len(e.IP) + len(e.Subnet) + len(e.Comment)
+		}
+	}
+	return size
+}
+
+// hexDigits are used to write \u escapes.
+const hexDigits = "0123456789abcdef"
+
+// appendJSONString appends s to b as a quoted JSON string, escaped the same
+// way as encoding/json: quotes, backslashes, control characters, HTML
+// characters and U+2028/U+2029 are escaped, and invalid UTF-8 is replaced
+// with U+FFFD.
+func appendJSONString(b []byte, s string) []byte {
+	b = append(b, '"')
+	start := 0
+	for i := 0; i < len(s); {
+		if c := s[i]; c < utf8.RuneSelf {
+			if c >= 0x20 && c != '"' && c != '\\' && c != '<' && c != '>' && c != '&' {
+				i++
+				continue
+			}
+			b = append(b, s[start:i]...)
+			switch c {
+			case '"', '\\':
+				b = append(b, '\\', c)
+			case '\n':
+				b = append(b, '\\', 'n')
+			case '\r':
+				b = append(b, '\\', 'r')
+			case '\t':
+				b = append(b, '\\', 't')
+			default:
+				b = append(b, '\\', 'u', '0', '0', hexDigits[c>>4], hexDigits[c&0xf])
+			}
+			i++
+			start = i
+			continue
+		}
+
+		r, size := utf8.DecodeRuneInString(s[i:])
+		if r == utf8.RuneError && size == 1 {
+			b = append(b, s[start:i]...)
+			b = append(b, "\ufffd"...)
+			i += size
+			start = i
+			continue
+		}
+		if r == '\u2028' || r == '\u2029' {
+			b = append(b, s[start:i]...)
+			b = append(b, '\\', 'u', '2', '0', '2', hexDigits[r&0xf])
+			i += size
+			start = i
+			continue
+		}
+		i += size
+	}
+	b = append(b, s[start:]...)
+	return append(b, '"')
+}
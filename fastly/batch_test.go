@@ -0,0 +1,139 @@
+package fastly
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+)
+
+// batchTestStrings exercise every escaping rule of appendJSONString.
+var batchTestStrings = []string{
+	"",
+	"plain",
+	`quote " and backslash \`,
+	"control \x00\x01\x1f\n\r\t",
+	"html <script>&amp;</script>",
+	"unicode é 日本    ",
+	"invalid \xff\xfe utf-8",
+}
+
+func TestAppendBatchDictionaryItems(t *testing.T) {
+	inputs := []*BatchModifyDictionaryItemsInput{
+		{},
+		{Items: []*BatchDictionaryItem{}},
+		{Items: []*BatchDictionaryItem{nil}},
+	}
+	for _, s := range batchTestStrings {
+		inputs = append(inputs, &BatchModifyDictionaryItemsInput{
+			Items: []*BatchDictionaryItem{
+				{Operation: BatchOperationUpsert, ItemKey: s, ItemValue: s},
+				{Operation: BatchOperationDelete, ItemKey: s},
+			},
+		})
+	}
+
+	for _, i := range inputs {
+		want, err := json.Marshal(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := appendBatchDictionaryItems(nil, i.Items)
+		if string(got) != string(want) {
+			t.Errorf("bad encoding:\n got: %s\nwant: %s", got, want)
+		}
+	}
+}
+
+func TestAppendBatchACLEntries(t *testing.T) {
+	inputs := []*BatchModifyACLEntriesInput{
+		{},
+		{Entries: []*BatchACLEntry{}},
+		{Entries: []*BatchACLEntry{nil}},
+	}
+	for _, s := range batchTestStrings {
+		inputs = append(inputs, &BatchModifyACLEntriesInput{
+			Entries: []*BatchACLEntry{
+				{Operation: BatchOperationCreate, IP: "192.0.2.0", Subnet: "24", Negated: true, Comment: s},
+				{Operation: BatchOperationDelete, ID: s},
+			},
+		})
+	}
+
+	for _, i := range inputs {
+		want, err := json.Marshal(i)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got := appendBatchACLEntries(nil, i.Entries)
+		if string(got) != string(want) {
+			t.Errorf("bad encoding:\n got: %s\nwant: %s", got, want)
+		}
+	}
+}
+
+// benchmarkDictionaryItems is a full batch of dictionary items.
+func benchmarkDictionaryItems() []*BatchDictionaryItem {
+	items := make([]*BatchDictionaryItem, BatchModifyMaximumOperations)
+	for n := range items {
+		items[n] = &BatchDictionaryItem{
+			Operation: BatchOperationUpsert,
+			ItemKey:   fmt.Sprintf("key-%06d", n),
+			ItemValue: fmt.Sprintf("https://origin-%d.example.com/path?id=%d", n%16, n),
+		}
+	}
+	return items
+}
+
+// benchmarkACLEntries is a full batch of ACL entries.
+func benchmarkACLEntries() []*BatchACLEntry {
+	entries := make([]*BatchACLEntry, BatchModifyMaximumOperations)
+	for n := range entries {
+		entries[n] = &BatchACLEntry{
+			Operation: BatchOperationCreate,
+			IP:        fmt.Sprintf("10.%d.%d.0", n/256, n%256),
+			Subnet:    "24",
+			Comment:   "imported",
+		}
+	}
+	return entries
+}
+
+func BenchmarkBatchDictionaryItems_append(b *testing.B) {
+	items := benchmarkDictionaryItems()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		appendBatchDictionaryItems(make([]byte, 0, batchDictionaryItemsSize(items)), items)
+	}
+}
+
+func BenchmarkBatchDictionaryItems_json(b *testing.B) {
+	i := &BatchModifyDictionaryItemsInput{Items: benchmarkDictionaryItems()}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := json.Marshal(i); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkBatchACLEntries_append(b *testing.B) {
+	entries := benchmarkACLEntries()
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		appendBatchACLEntries(make([]byte, 0, batchACLEntriesSize(entries)), entries)
+	}
+}
+
+func BenchmarkBatchACLEntries_json(b *testing.B) {
+	i := &BatchModifyACLEntriesInput{Entries: benchmarkACLEntries()}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		if _, err := json.Marshal(i); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
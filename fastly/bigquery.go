@@ -2,22 +2,34 @@ package fastly
 
 import (
 	"fmt"
+	"net/url"
+	"time"
 )
 
 // BigQuery represents a BigQuery logging response from the Fastly API.
 type BigQuery struct {
-	ServiceID         string `mapstructure:"service_id"`
-	Name              string `mapstructure:"name"`
-	Format            string `mapstructure:"format"`
-	User              string `mapstructure:"user"`
-	ProjectID         string `mapstructure:"project_id"`
-	Dataset           string `mapstructure:"dataset"`
-	Table             string `mapstructure:"table"`
-	SecretKey         string `mapstructure:"secret_key"`
-	CreatedAt         string `mapstructure:"created_at"`
-	UpdatedAt         string `mapstructure:"updated_at"`
-	DeletedAt         string `mapstructure:"deleted_at"`
-	ResponseCondition string `mapstructure:"response_condition"`
+	ServiceID         string     `mapstructure:"service_id"`
+	Name              string     `mapstructure:"name"`
+	Format            string     `mapstructure:"format"`
+	User              string     `mapstructure:"user"`
+	ProjectID         string     `mapstructure:"project_id"`
+	Dataset           string     `mapstructure:"dataset"`
+	Table             string     `mapstructure:"table"`
+	SecretKey         string     `mapstructure:"secret_key" sensitive:"true"`
+	CreatedAt         string     `mapstructure:"created_at"`
+	UpdatedAt         string     `mapstructure:"updated_at"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	ResponseCondition string     `mapstructure:"response_condition"`
+}
+
+// String formats the BigQuery endpoint with its secret key redacted.
+func (b *BigQuery) String() string {
+	return redactedString(b)
+}
+
+// GoString is like String, for the %#v verb.
+func (b *BigQuery) GoString() string {
+	return redactedGoString(b)
 }
 
 // GetBigQueryInput is used as input to the GetBigQuery function.
@@ -76,7 +88,7 @@ type CreateBigQueryInput struct {
 	User string
 
 	// Secret key is the user's secret key.
-	SecretKey string
+	SecretKey string `sensitive:"true"`
 
 	// Format is the log formatting desired for your BigQuery dataset.
 	// Optional.
@@ -126,6 +138,8 @@ func (c *Client) CreateBigQuery(i *CreateBigQueryInput) (*BigQuery, error) {
 	params["secret_key"] = i.SecretKey
 	if i.Format != "" {
 		params["format"] = i.Format
+	} else if c.DefaultLogFormat != "" {
+		params["format"] = c.DefaultLogFormat
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/bigquery", i.Service, i.Version)
@@ -176,7 +190,7 @@ type UpdateBigQueryInput struct {
 	User string
 
 	// Secret key is the user's secret key.
-	SecretKey string
+	SecretKey string `sensitive:"true"`
 
 	// Format is the log formatting desired for your BigQuery dataset.
 	// Optional.
@@ -222,7 +236,7 @@ func (c *Client) UpdateBigQuery(i *UpdateBigQueryInput) (*BigQuery, error) {
 		params["format"] = i.Format
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/bigquery/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/logging/bigquery/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, &RequestOptions{
 		Params: params,
 	})
@@ -264,7 +278,7 @@ func (c *Client) DeleteBigQuery(i *DeleteBigQueryInput) error {
 		return ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/bigquery/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/logging/bigquery/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Delete(path, nil)
 	if err != nil {
 		return err
@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"sort"
 	"time"
 )
 
@@ -13,6 +14,9 @@ type Billing struct {
 	EndTime   *time.Time     `mapstructure:"end_time"`
 	Status    *BillingStatus `mapstructure:"status"`
 	Total     *BillingTotal  `mapstructure:"total"`
+
+	// LineItems are only returned by GetBillingV2.
+	LineItems []*BillingLineItem `mapstructure:"line_items"`
 }
 
 // BillingStatus is a representation of the status of the bill from the Fastly
@@ -51,6 +55,78 @@ type BillingExtra struct {
 	Recurring float64 `mapstructure:"recurring"`
 }
 
+// BillingLineItem is a single charge on a bill, for one product and,
+// when ServiceID is set, one service.
+type BillingLineItem struct {
+	ID               string  `mapstructure:"id"`
+	LineNumber       int     `mapstructure:"line_number"`
+	Description      string  `mapstructure:"description"`
+	Amount           float64 `mapstructure:"amount"`
+	Units            float64 `mapstructure:"units"`
+	RatePerUnit      float64 `mapstructure:"rate_per_unit"`
+	UsageType        string  `mapstructure:"usage_type"`
+	UsageTypeCode    string  `mapstructure:"usage_type_cd"`
+	PlanName         string  `mapstructure:"plan_name"`
+	ServiceName      string  `mapstructure:"service_name"`
+	ServiceID        string  `mapstructure:"client_service_id"`
+	CreditCouponCode string  `mapstructure:"credit_coupon_code"`
+}
+
+// BillingServiceTotal is the total of a bill's line items for one service.
+type BillingServiceTotal struct {
+	// ServiceID is the ID of the service, or empty for charges which are not
+	// for a particular service.
+	ServiceID string
+
+	// Amount is the sum of the line item amounts.
+	Amount float64
+
+	// LineItems are the service's line items, in line number order.
+	LineItems []*BillingLineItem
+}
+
+// ServiceTotals groups the bill's line items by service, sorted by service ID.
+func (b *Billing) ServiceTotals() []*BillingServiceTotal {
+	byService := make(map[string]*BillingServiceTotal)
+	for _, li := range b.LineItems {
+		t, ok := byService[li.ServiceID]
+		if !ok {
+			t = &BillingServiceTotal{ServiceID: li.ServiceID}
+			byService[li.ServiceID] = t
+		}
+		t.Amount += li.Amount
+		t.LineItems = append(t.LineItems, li)
+	}
+
+	totals := make([]*BillingServiceTotal, 0, len(byService))
+	for _, t := range byService {
+		sort.Stable(billingLineItemsByNumber(t.LineItems))
+		totals = append(totals, t)
+	}
+	sort.Sort(billingServiceTotalsByID(totals))
+	return totals
+}
+
+// billingLineItemsByNumber is a sortable list of billing line items.
+type billingLineItemsByNumber []*BillingLineItem
+
+// Len, Swap, and Less implement the sortable interface.
+func (s billingLineItemsByNumber) Len() int      { return len(s) }
+func (s billingLineItemsByNumber) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s billingLineItemsByNumber) Less(i, j int) bool {
+	return s[i].LineNumber < s[j].LineNumber
+}
+
+// billingServiceTotalsByID is a sortable list of billing service totals.
+type billingServiceTotalsByID []*BillingServiceTotal
+
+// Len, Swap, and Less implement the sortable interface.
+func (s billingServiceTotalsByID) Len() int      { return len(s) }
+func (s billingServiceTotalsByID) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s billingServiceTotalsByID) Less(i, j int) bool {
+	return s[i].ServiceID < s[j].ServiceID
+}
+
 // GetBillingInput is used as input to the GetBilling function.
 type GetBillingInput struct {
 	Year  uint16
@@ -79,3 +155,27 @@ func (c *Client) GetBilling(i *GetBillingInput) (*Billing, error) {
 	}
 	return b, nil
 }
+
+// GetBillingV2 returns the billing information for the current account,
+// including the per-service, per-product line items.
+func (c *Client) GetBillingV2(i *GetBillingInput) (*Billing, error) {
+	if i.Year == 0 {
+		return nil, ErrMissingYear
+	}
+
+	if i.Month == 0 {
+		return nil, ErrMissingMonth
+	}
+
+	path := fmt.Sprintf("/billing/v2/year/%d/month/%02d", i.Year, i.Month)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var b *Billing
+	if err := decodeJSON(&b, resp.Body); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
@@ -0,0 +1,87 @@
+package fastly
+
+import (
+	"fmt"
+
+	"github.com/google/jsonapi"
+)
+
+// BillingAddress is the billing address of a customer.
+type BillingAddress struct {
+	ID         string `jsonapi:"primary,billing_address"`
+	Address1   string `jsonapi:"attr,address_1,omitempty"`
+	Address2   string `jsonapi:"attr,address_2,omitempty"`
+	City       string `jsonapi:"attr,city,omitempty"`
+	Country    string `jsonapi:"attr,country,omitempty"`
+	Locality   string `jsonapi:"attr,locality,omitempty"`
+	PostalCode string `jsonapi:"attr,postal_code,omitempty"`
+	State      string `jsonapi:"attr,state,omitempty"`
+	CreatedAt  string `jsonapi:"attr,created_at,omitempty"`
+	UpdatedAt  string `jsonapi:"attr,updated_at,omitempty"`
+}
+
+// GetBillingAddressInput is used as input to the GetBillingAddress function.
+type GetBillingAddressInput struct {
+	// CustomerID is the ID of the customer (required).
+	CustomerID string
+}
+
+// GetBillingAddress returns the billing address of a customer.
+func (c *Client) GetBillingAddress(i *GetBillingAddressInput) (*BillingAddress, error) {
+	if i.CustomerID == "" {
+		return nil, ErrMissingCustomerID
+	}
+
+	path := fmt.Sprintf("/customers/%s/billing_address", i.CustomerID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ba BillingAddress
+	if err := jsonapi.UnmarshalPayload(resp.Body, &ba); err != nil {
+		return nil, err
+	}
+	return &ba, nil
+}
+
+// UpdateBillingAddressInput is used as input to the UpdateBillingAddress
+// function. Only the fields which are set are changed.
+type UpdateBillingAddressInput struct {
+	// CustomerID is the ID of the customer (required).
+	CustomerID string
+
+	// ID is the ID of the billing address (required).
+	ID string `jsonapi:"primary,billing_address"`
+
+	Address1   string `jsonapi:"attr,address_1,omitempty"`
+	Address2   string `jsonapi:"attr,address_2,omitempty"`
+	City       string `jsonapi:"attr,city,omitempty"`
+	Country    string `jsonapi:"attr,country,omitempty"`
+	Locality   string `jsonapi:"attr,locality,omitempty"`
+	PostalCode string `jsonapi:"attr,postal_code,omitempty"`
+	State      string `jsonapi:"attr,state,omitempty"`
+}
+
+// UpdateBillingAddress updates the billing address of a customer.
+func (c *Client) UpdateBillingAddress(i *UpdateBillingAddressInput) (*BillingAddress, error) {
+	if i.CustomerID == "" {
+		return nil, ErrMissingCustomerID
+	}
+
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/customers/%s/billing_address", i.CustomerID)
+	resp, err := c.PatchJSONAPI(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ba BillingAddress
+	if err := jsonapi.UnmarshalPayload(resp.Body, &ba); err != nil {
+		return nil, err
+	}
+	return &ba, nil
+}
@@ -0,0 +1,67 @@
+package fastly
+
+import "testing"
+
+func TestClient_BillingAddress(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var ba *BillingAddress
+	record(t, "billing_address/get", func(c *Client) {
+		ba, err = c.GetBillingAddress(&GetBillingAddressInput{
+			CustomerID: "zwBncFVs2Ixrhd8xxxxxx",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ba.ID != "bAdDrqTzWAD0acXr3RG1a" {
+		t.Errorf("bad id: %q", ba.ID)
+	}
+	if ba.City != "San Francisco" {
+		t.Errorf("bad city: %q", ba.City)
+	}
+
+	record(t, "billing_address/update", func(c *Client) {
+		ba, err = c.UpdateBillingAddress(&UpdateBillingAddressInput{
+			CustomerID: "zwBncFVs2Ixrhd8xxxxxx",
+			ID:         ba.ID,
+			Address1:   "1 Main St",
+			City:       "Austin",
+			PostalCode: "78701",
+			State:      "TX",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ba.City != "Austin" || ba.State != "TX" {
+		t.Errorf("bad address: %#v", ba)
+	}
+	if ba.Address2 != "Suite 400" {
+		t.Errorf("bad address_2: %q", ba.Address2)
+	}
+}
+
+func TestClient_GetBillingAddress_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetBillingAddress(&GetBillingAddressInput{})
+	if err != ErrMissingCustomerID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdateBillingAddress_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateBillingAddress(&UpdateBillingAddressInput{})
+	if err != ErrMissingCustomerID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateBillingAddress(&UpdateBillingAddressInput{
+		CustomerID: "zwBncFVs2Ixrhd8xxxxxx",
+	})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -0,0 +1,54 @@
+package fastly
+
+import "testing"
+
+func TestClient_GetBillingV2(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var b *Billing
+	record(t, "billing/v2", func(c *Client) {
+		b, err = c.GetBillingV2(&GetBillingInput{
+			Year:  2020,
+			Month: 4,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.InvoiceID != "12345" {
+		t.Errorf("bad invoice_id: %q", b.InvoiceID)
+	}
+	if len(b.LineItems) != 5 {
+		t.Fatalf("bad line items: %v", b.LineItems)
+	}
+
+	totals := b.ServiceTotals()
+	if len(totals) != 3 {
+		t.Fatalf("bad totals: %v", totals)
+	}
+	if totals[0].ServiceID != "" || totals[0].Amount != 10 {
+		t.Errorf("bad total: %#v", totals[0])
+	}
+	if totals[2].ServiceID != testServiceID || totals[2].Amount != 660.5 {
+		t.Errorf("bad total: %#v", totals[2])
+	}
+	if li := totals[2].LineItems[0]; li.LineNumber != 1 || li.Units != 5004.17 || li.RatePerUnit != 0.12 || li.UsageTypeCode != "NA_BW" {
+		t.Errorf("bad line item: %#v", li)
+	}
+}
+
+func TestClient_GetBilling_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetBillingV2(&GetBillingInput{})
+	if err != ErrMissingYear {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetBillingV2(&GetBillingInput{
+		Year: 2020,
+	})
+	if err != ErrMissingMonth {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -0,0 +1,135 @@
+package fastly
+
+import (
+	"fmt"
+	"sort"
+)
+
+// BotChallengeType is the kind of challenge Bot Management presents to
+// suspected bots.
+type BotChallengeType string
+
+const (
+	BotChallengeTypeNone       BotChallengeType = "none"
+	BotChallengeTypeJavaScript BotChallengeType = "javascript"
+	BotChallengeTypeCaptcha    BotChallengeType = "captcha"
+)
+
+// BotManagementConfiguration is the Bot Management configuration of a
+// service.
+type BotManagementConfiguration struct {
+	ServiceID string `mapstructure:"service_id"`
+
+	// ChallengeType is the challenge presented to suspected bots.
+	ChallengeType BotChallengeType `mapstructure:"challenge_type"`
+
+	// ChallengeTTL is how long, in seconds, a passed challenge is remembered.
+	ChallengeTTL uint `mapstructure:"challenge_ttl"`
+
+	// ExemptPaths are URL path prefixes which are never challenged, such as
+	// health checks or webhooks.
+	ExemptPaths []string `mapstructure:"exempt_paths"`
+
+	// AllowVerifiedBots lets verified bots, such as search engine crawlers,
+	// through without a challenge.
+	AllowVerifiedBots bool `mapstructure:"allow_verified_bots"`
+}
+
+// EnableBotManagementInput is used as input to the EnableBotManagement
+// function.
+type EnableBotManagementInput struct {
+	// Service is the ID of the service (required).
+	Service string
+}
+
+// EnableBotManagement enables Bot Management on a service.
+func (c *Client) EnableBotManagement(i *EnableBotManagementInput) (*ProductEnablement, error) {
+	return c.EnableProduct(&ProductEnablementInput{
+		Product: ProductBotManagement,
+		Service: i.Service,
+	})
+}
+
+// DisableBotManagementInput is used as input to the DisableBotManagement
+// function.
+type DisableBotManagementInput struct {
+	// Service is the ID of the service (required).
+	Service string
+}
+
+// DisableBotManagement disables Bot Management on a service.
+func (c *Client) DisableBotManagement(i *DisableBotManagementInput) error {
+	return c.DisableProduct(&ProductEnablementInput{
+		Product: ProductBotManagement,
+		Service: i.Service,
+	})
+}
+
+// GetBotManagementConfigurationInput is used as input to the
+// GetBotManagementConfiguration function.
+type GetBotManagementConfigurationInput struct {
+	// Service is the ID of the service (required).
+	Service string
+}
+
+// GetBotManagementConfiguration gets the Bot Management configuration of a
+// service. Bot Management must be enabled on the service.
+func (c *Client) GetBotManagementConfiguration(i *GetBotManagementConfigurationInput) (*BotManagementConfiguration, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	path := fmt.Sprintf("/enabled-products/v1/%s/services/%s/configuration", ProductBotManagement, i.Service)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var b *BotManagementConfiguration
+	if err := decodeJSON(&b, resp.Body); err != nil {
+		return nil, err
+	}
+	sort.Strings(b.ExemptPaths)
+	return b, nil
+}
+
+// UpdateBotManagementConfigurationInput is used as input to the
+// UpdateBotManagementConfiguration function. Fields which are not set are
+// left unchanged.
+type UpdateBotManagementConfigurationInput struct {
+	// Service is the ID of the service (required).
+	Service string `json:"-"`
+
+	ChallengeType     BotChallengeType `json:"challenge_type,omitempty"`
+	ChallengeTTL      *uint            `json:"challenge_ttl,omitempty"`
+	ExemptPaths       *[]string        `json:"exempt_paths,omitempty"`
+	AllowVerifiedBots *bool            `json:"allow_verified_bots,omitempty"`
+}
+
+// UpdateBotManagementConfiguration updates the Bot Management configuration
+// of a service. Setting ExemptPaths replaces the whole list; set it to an
+// empty list to remove every exemption.
+func (c *Client) UpdateBotManagementConfiguration(i *UpdateBotManagementConfigurationInput) (*BotManagementConfiguration, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	switch i.ChallengeType {
+	case "", BotChallengeTypeNone, BotChallengeTypeJavaScript, BotChallengeTypeCaptcha:
+	default:
+		return nil, fmt.Errorf("Unknown bot challenge type %q", i.ChallengeType)
+	}
+
+	path := fmt.Sprintf("/enabled-products/v1/%s/services/%s/configuration", ProductBotManagement, i.Service)
+	resp, err := c.PatchJSON(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var b *BotManagementConfiguration
+	if err := decodeJSON(&b, resp.Body); err != nil {
+		return nil, err
+	}
+	sort.Strings(b.ExemptPaths)
+	return b, nil
+}
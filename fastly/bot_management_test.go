@@ -0,0 +1,94 @@
+package fastly
+
+import "testing"
+
+func TestClient_BotManagement(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	// Enable
+	var p *ProductEnablement
+	record(t, "bot_management/enable", func(c *Client) {
+		p, err = c.EnableBotManagement(&EnableBotManagementInput{
+			Service: testServiceID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.Product.ID != string(ProductBotManagement) || p.Service.ID != testServiceID {
+		t.Errorf("bad enablement: %q, %q", p.Product.ID, p.Service.ID)
+	}
+
+	// Get
+	var b *BotManagementConfiguration
+	record(t, "bot_management/get_configuration", func(c *Client) {
+		b, err = c.GetBotManagementConfiguration(&GetBotManagementConfigurationInput{
+			Service: testServiceID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.ChallengeType != BotChallengeTypeJavaScript || b.ChallengeTTL != 1800 {
+		t.Errorf("bad challenge: %q, %d", b.ChallengeType, b.ChallengeTTL)
+	}
+	if len(b.ExemptPaths) != 2 || b.ExemptPaths[0] != "/healthz" {
+		t.Errorf("bad exempt_paths: %v", b.ExemptPaths)
+	}
+	if !b.AllowVerifiedBots {
+		t.Error("bad allow_verified_bots")
+	}
+
+	// Update
+	paths := []string{"/status"}
+	var ub *BotManagementConfiguration
+	record(t, "bot_management/update_configuration", func(c *Client) {
+		ub, err = c.UpdateBotManagementConfiguration(&UpdateBotManagementConfigurationInput{
+			Service:       testServiceID,
+			ChallengeType: BotChallengeTypeCaptcha,
+			ExemptPaths:   &paths,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ub.ChallengeType != BotChallengeTypeCaptcha {
+		t.Errorf("bad challenge_type: %q", ub.ChallengeType)
+	}
+	if len(ub.ExemptPaths) != 1 || ub.ExemptPaths[0] != "/status" {
+		t.Errorf("bad exempt_paths: %v", ub.ExemptPaths)
+	}
+
+	// Disable
+	record(t, "bot_management/disable", func(c *Client) {
+		err = c.DisableBotManagement(&DisableBotManagementInput{
+			Service: testServiceID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_BotManagement_validation(t *testing.T) {
+	var err error
+	_, err = testClient.EnableBotManagement(&EnableBotManagementInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetBotManagementConfiguration(&GetBotManagementConfigurationInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateBotManagementConfiguration(&UpdateBotManagementConfigurationInput{
+		Service:       "foo",
+		ChallengeType: "puzzle",
+	})
+	if err == nil {
+		t.Error("expected error for unknown challenge type")
+	}
+}
@@ -0,0 +1,107 @@
+package fastly
+
+import "strings"
+
+// DefaultCompressibleContentTypes are the content types
+// EnableBrotliCompression checks when no content types are given.
+var DefaultCompressibleContentTypes = []string{
+	"text/html",
+	"text/css",
+	"application/javascript",
+	"application/json",
+	"image/svg+xml",
+}
+
+// EnableBrotliCompressionInput is used as input to the EnableBrotliCompression
+// function.
+type EnableBrotliCompressionInput struct {
+	// Service is the ID of the service. Version is the configuration version
+	// whose compression policies are checked. Both fields are required.
+	Service string
+	Version int
+
+	// ContentTypes are the content types which are expected to be compressed.
+	// The default is DefaultCompressibleContentTypes.
+	ContentTypes []string
+}
+
+// BrotliCompression is the result of EnableBrotliCompression.
+type BrotliCompression struct {
+	Enablement *ProductEnablement
+
+	// Uncompressed are the expected content types which none of the
+	// version's compression policies include, and so will not be compressed
+	// with brotli either.
+	Uncompressed []string
+}
+
+// EnableBrotliCompression enables brotli compression on a service. Brotli is
+// only applied to responses which a compression policy (a Gzip) of the active
+// version selects, so the given version is checked first: if it has no
+// compression policy, ErrMissingCompressionPolicy is returned and the product
+// is not enabled. Expected content types which no policy includes are
+// reported in the result's Uncompressed field.
+func (c *Client) EnableBrotliCompression(i *EnableBrotliCompressionInput) (*BrotliCompression, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	gzips, err := c.ListGzips(&ListGzipsInput{
+		Service: i.Service,
+		Version: i.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(gzips) == 0 {
+		return nil, ErrMissingCompressionPolicy
+	}
+
+	compressed := make(map[string]bool)
+	for _, g := range gzips {
+		for _, t := range strings.Fields(g.ContentTypes) {
+			compressed[strings.ToLower(t)] = true
+		}
+	}
+
+	types := i.ContentTypes
+	if len(types) == 0 {
+		types = DefaultCompressibleContentTypes
+	}
+
+	b := &BrotliCompression{}
+	for _, t := range types {
+		if !compressed[strings.ToLower(t)] {
+			b.Uncompressed = append(b.Uncompressed, t)
+		}
+	}
+
+	b.Enablement, err = c.EnableProduct(&ProductEnablementInput{
+		Product: ProductBrotliCompression,
+		Service: i.Service,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// DisableBrotliCompressionInput is used as input to the
+// DisableBrotliCompression function.
+type DisableBrotliCompressionInput struct {
+	// Service is the ID of the service (required).
+	Service string
+}
+
+// DisableBrotliCompression disables brotli compression on a service.
+// Responses selected by a compression policy are still compressed with gzip.
+func (c *Client) DisableBrotliCompression(i *DisableBrotliCompressionInput) error {
+	return c.DisableProduct(&ProductEnablementInput{
+		Product: ProductBrotliCompression,
+		Service: i.Service,
+	})
+}
@@ -0,0 +1,67 @@
+package fastly
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestClient_BrotliCompression(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	// Enable
+	var b *BrotliCompression
+	record(t, "brotli_compression/enable", func(c *Client) {
+		b, err = c.EnableBrotliCompression(&EnableBrotliCompressionInput{
+			Service: testServiceID,
+			Version: 696,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b.Enablement.Product.ID != string(ProductBrotliCompression) {
+		t.Errorf("bad product: %q", b.Enablement.Product.ID)
+	}
+	if want := []string{"application/json", "image/svg+xml"}; !reflect.DeepEqual(b.Uncompressed, want) {
+		t.Errorf("bad uncompressed: %v", b.Uncompressed)
+	}
+
+	// Enable without a compression policy
+	record(t, "brotli_compression/enable_no_policy", func(c *Client) {
+		_, err = c.EnableBrotliCompression(&EnableBrotliCompressionInput{
+			Service:      testServiceID,
+			Version:      697,
+			ContentTypes: []string{"text/html"},
+		})
+	})
+	if err != ErrMissingCompressionPolicy {
+		t.Errorf("bad error: %v", err)
+	}
+
+	// Disable
+	record(t, "brotli_compression/disable", func(c *Client) {
+		err = c.DisableBrotliCompression(&DisableBrotliCompressionInput{
+			Service: testServiceID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_EnableBrotliCompression_validation(t *testing.T) {
+	var err error
+	_, err = testClient.EnableBrotliCompression(&EnableBrotliCompressionInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.EnableBrotliCompression(&EnableBrotliCompressionInput{
+		Service: "foo",
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -2,14 +2,18 @@ package fastly
 
 import (
 	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"net/http"
 	"net/url"
 	"os"
 	"runtime"
-	"strings"
+	"sync"
 
 	"github.com/ajg/form"
 	"github.com/google/jsonapi"
@@ -31,6 +35,12 @@ const DefaultEndpoint = "https://api.fastly.com"
 // RealtimeStatsEndpoint is the realtime stats endpoint for Fastly.
 const RealtimeStatsEndpoint = "https://rt.fastly.com"
 
+// FiddleEndpoint is the Fastly Fiddle endpoint.
+const FiddleEndpoint = "https://fiddle.fastly.dev"
+
+// StatusEndpoint is the Fastly status page endpoint.
+const StatusEndpoint = "https://status.fastly.com"
+
 // ProjectURL is the url for this library.
 var ProjectURL = "github.com/sethvargo/go-fastly"
 
@@ -50,6 +60,71 @@ type Client struct {
 	// client will be used.
 	HTTPClient *http.Client
 
+	// AllowPurgeAll permits PurgeAll without setting Confirm on each input.
+	AllowPurgeAll bool
+
+	// VersionCache, if set, caches the versions of each service returned by
+	// ListVersions. Version changes made through the client invalidate the
+	// service's entry.
+	VersionCache *VersionCache
+
+	// CheckServiceTypes makes VCL-only calls look up the type of a service the
+	// client has not seen yet, so they return ErrNotVCLService for a Compute
+	// service. Types seen in service responses are always checked.
+	CheckServiceTypes bool
+
+	// CheckConditions makes creates and updates check that the conditions they
+	// reference exist in the version, with CheckConditionReferences, before
+	// they are sent.
+	CheckConditions bool
+
+	// GuardLockedVersions makes changes to the objects of a version, such as
+	// creating a backend, check that the version is not locked or active
+	// first, and return a *VersionLockedError if it is.
+	GuardLockedVersions bool
+
+	// ValidateShields makes CreateBackend and UpdateBackend check the
+	// backend's Shield with ValidateBackendShield before it is sent.
+	ValidateShields bool
+
+	// ValidateLogFormats makes logging endpoint creates and updates check
+	// their Format with ValidateLogFormat before they are sent.
+	ValidateLogFormats bool
+
+	// DefaultLogFormat and DefaultLogFormatVersion are used when creating a
+	// logging endpoint whose input has no Format or FormatVersion, so that
+	// every endpoint created through the client logs the same schema.
+	DefaultLogFormat        string
+	DefaultLogFormatVersion uint
+
+	// DefaultTLSConfigurationID is the TLS configuration used by
+	// CreateTLSSubscription and CreateTLSActivation when their input has
+	// none. If it is empty, the account's default configuration is used. A
+	// configuration can be looked up by name with FindTLSConfiguration. It
+	// must not be changed while the client is in use.
+	DefaultTLSConfigurationID string
+
+	// APIVersions pins the shape requests to an APIEndpoint are sent in, such
+	// as BackendAPIVersion1 for tools which still set SSLHostname, or
+	// LoggingAPIVersion1 to leave FormatVersion out of logging endpoint
+	// requests. Inputs to endpoints which are not pinned are sent as given.
+	// Responses in any known shape are decoded the same way, whatever the
+	// pins.
+	APIVersions map[APIEndpoint]APIVersion
+
+	// serviceTypes caches the type of each service seen by the client.
+	serviceTypesMu sync.Mutex
+	serviceTypes   map[string]ServiceType
+
+	// regions caches the regions returned by GetRegions, for ValidateRegion.
+	regionsMu sync.Mutex
+	regions   map[Region]bool
+
+	// shields caches the datacenters returned by ListShieldDatacenters, for
+	// ValidateBackendShield.
+	shieldsMu sync.Mutex
+	shields   []*Datacenter
+
 	// apiKey is the Fastly API key to authenticate requests.
 	apiKey string
 
@@ -62,6 +137,16 @@ type RTSClient struct {
 	client *Client
 }
 
+// FiddleClient is the entrypoint to the Fastly Fiddle API.
+type FiddleClient struct {
+	client *Client
+}
+
+// StatusClient is the entrypoint to the Fastly status page API.
+type StatusClient struct {
+	client *Client
+}
+
 // DefaultClient instantiates a new Fastly API client. This function requires
 // the environment variable `FASTLY_API_KEY` is set and contains a valid API key
 // to authenticate with Fastly.
@@ -101,6 +186,26 @@ func NewRealtimeStatsClient() *RTSClient {
 	return &RTSClient{client: c}
 }
 
+// NewFiddleClient instantiates a new client for Fastly Fiddle. Fiddles are
+// public and do not require an API key.
+func NewFiddleClient() *FiddleClient {
+	c, err := NewClientForEndpoint("", FiddleEndpoint)
+	if err != nil {
+		panic(err)
+	}
+	return &FiddleClient{client: c}
+}
+
+// NewStatusClient instantiates a new client for the Fastly status page. The
+// status page is public and does not require an API key.
+func NewStatusClient() *StatusClient {
+	c, err := NewClientForEndpoint("", StatusEndpoint)
+	if err != nil {
+		panic(err)
+	}
+	return &StatusClient{client: c}
+}
+
 func (c *Client) init() (*Client, error) {
 	u, err := url.Parse(c.Address)
 	if err != nil {
@@ -115,6 +220,79 @@ func (c *Client) init() (*Client, error) {
 	return c, nil
 }
 
+// SetClientCertificate configures the client to present the given certificate
+// when connecting, for environments where an egress proxy requires mutual
+// TLS. The client's HTTPClient.Transport must be an *http.Transport, or nil to
+// use a new default transport.
+func (c *Client) SetClientCertificate(cert tls.Certificate) error {
+	return c.updateTLSConfig(func(config *tls.Config) {
+		config.Certificates = []tls.Certificate{cert}
+	})
+}
+
+// LoadClientCertificate reads a PEM encoded certificate and private key from
+// the given files and configures the client to present them, as with
+// SetClientCertificate.
+func (c *Client) LoadClientCertificate(certFile, keyFile string) error {
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return err
+	}
+	return c.SetClientCertificate(cert)
+}
+
+// SetRootCAs configures the client to verify the API endpoint against the
+// given certificate pool instead of the system roots, such as when TLS is
+// intercepted by a corporate proxy. As with SetClientCertificate, the
+// client's HTTPClient.Transport must be an *http.Transport or nil.
+func (c *Client) SetRootCAs(pool *x509.CertPool) error {
+	return c.updateTLSConfig(func(config *tls.Config) {
+		config.RootCAs = pool
+	})
+}
+
+// LoadRootCAs reads a bundle of PEM encoded CA certificates from the given
+// file and configures the client to verify the API endpoint against them, as
+// with SetRootCAs.
+func (c *Client) LoadRootCAs(caFile string) error {
+	pem, err := ioutil.ReadFile(caFile)
+	if err != nil {
+		return err
+	}
+
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(pem) {
+		return fmt.Errorf("no certificates found in %s", caFile)
+	}
+	return c.SetRootCAs(pool)
+}
+
+// updateTLSConfig applies fn to a copy of the TLS configuration of the
+// client's transport and installs the result. A nil transport is replaced
+// with a new default transport, rather than modifying http.DefaultTransport.
+func (c *Client) updateTLSConfig(fn func(*tls.Config)) error {
+	var t *http.Transport
+	switch rt := c.HTTPClient.Transport.(type) {
+	case nil:
+		t = cleanhttp.DefaultTransport()
+		c.HTTPClient.Transport = t
+	case *http.Transport:
+		t = rt
+	default:
+		return ErrUnsupportedTransport
+	}
+
+	var config *tls.Config
+	if t.TLSClientConfig != nil {
+		config = t.TLSClientConfig.Clone()
+	} else {
+		config = &tls.Config{}
+	}
+	fn(config)
+	t.TLSClientConfig = config
+	return nil
+}
+
 // Get issues an HTTP GET request.
 func (c *Client) Get(p string, ro *RequestOptions) (*http.Response, error) {
 	return c.Request("GET", p, ro)
@@ -193,15 +371,28 @@ func (c *Client) Delete(p string, ro *RequestOptions) (*http.Response, error) {
 // Request makes an HTTP request against the HTTPClient using the given verb,
 // Path, and request options.
 func (c *Client) Request(verb, p string, ro *RequestOptions) (*http.Response, error) {
+	if err := c.checkVersionGuard(verb, p); err != nil {
+		return nil, err
+	}
+
 	req, err := c.RawRequest(verb, p, ro)
 	if err != nil {
 		return nil, err
 	}
 
+	cancel := func() {}
+	if ro != nil && ro.Timeout > 0 {
+		var ctx context.Context
+		ctx, cancel = context.WithTimeout(req.Context(), ro.Timeout)
+		req = req.WithContext(ctx)
+	}
+
 	resp, err := checkResp(c.HTTPClient.Do(req))
 	if err != nil {
-		return resp, err
+		cancel()
+		return resp, c.redactError(err, nil)
 	}
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
 
 	return resp, nil
 }
@@ -209,69 +400,50 @@ func (c *Client) Request(verb, p string, ro *RequestOptions) (*http.Response, er
 // RequestForm makes an HTTP request with the given interface being encoded as
 // form data.
 func (c *Client) RequestForm(verb, p string, i interface{}, ro *RequestOptions) (*http.Response, error) {
-	if ro == nil {
-		ro = new(RequestOptions)
+	i = c.compatLoggingInput(p, i)
+
+	if err := c.checkFormConditionReferences(i); err != nil {
+		return nil, err
 	}
 
-	if ro.Headers == nil {
-		ro.Headers = make(map[string]string)
+	if err := c.checkLogFormat(p, i); err != nil {
+		return nil, err
 	}
-	ro.Headers["Content-Type"] = "application/x-www-form-urlencoded"
 
 	buf := new(bytes.Buffer)
 	if err := form.NewEncoder(buf).KeepZeros(true).DelimitWith('|').Encode(i); err != nil {
 		return nil, err
 	}
-	body := buf.String()
 
-	ro.Body = strings.NewReader(body)
-	ro.BodyLength = int64(len(body))
-
-	return c.Request(verb, p, ro)
+	resp, err := c.Request(verb, p, ro.withBody(buf.Bytes(), "application/x-www-form-urlencoded"))
+	return resp, c.redactError(err, i)
 }
 
 func (c *Client) RequestJSON(verb, p string, i interface{}, ro *RequestOptions) (*http.Response, error) {
-	if ro == nil {
-		ro = new(RequestOptions)
-	}
-
-	if ro.Headers == nil {
-		ro.Headers = make(map[string]string)
-	}
-	ro.Headers["Content-Type"] = "application/json"
-	ro.Headers["Accept"] = "application/json"
-
 	body, err := json.Marshal(i)
 	if err != nil {
 		return nil, err
 	}
 
-	ro.Body = bytes.NewReader(body)
-	ro.BodyLength = int64(len(body))
+	resp, err := c.Request(verb, p, ro.withBody(body, "application/json"))
+	return resp, c.redactError(err, i)
+}
 
-	return c.Request(verb, p, ro)
+// requestJSONBody makes an HTTP request with a body which is already encoded
+// as JSON.
+func (c *Client) requestJSONBody(verb, p string, body []byte) (*http.Response, error) {
+	var ro *RequestOptions
+	return c.Request(verb, p, ro.withBody(body, "application/json"))
 }
 
 func (c *Client) RequestJSONAPI(verb, p string, i interface{}, ro *RequestOptions) (*http.Response, error) {
-	if ro == nil {
-		ro = new(RequestOptions)
-	}
-
-	if ro.Headers == nil {
-		ro.Headers = make(map[string]string)
-	}
-	ro.Headers["Content-Type"] = jsonapi.MediaType
-	ro.Headers["Accept"] = jsonapi.MediaType
-
 	var buf bytes.Buffer
 	if err := jsonapi.MarshalPayload(&buf, i); err != nil {
 		return nil, err
 	}
 
-	ro.Body = &buf
-	ro.BodyLength = int64(buf.Len())
-
-	return c.Request(verb, p, ro)
+	resp, err := c.Request(verb, p, ro.withBody(buf.Bytes(), jsonapi.MediaType))
+	return resp, c.redactError(err, i)
 }
 
 // checkResp wraps an HTTP request from the default client and verifies that the
@@ -306,6 +478,8 @@ func decodeJSON(out interface{}, body io.ReadCloser) error {
 		DecodeHook: mapstructure.ComposeDecodeHookFunc(
 			mapToHTTPHeaderHookFunc(),
 			stringToTimeHookFunc(),
+			stringToCipherListHookFunc(),
+			compatHookFunc(),
 		),
 		WeaklyTypedInput: true,
 		Result:           out,
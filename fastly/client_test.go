@@ -0,0 +1,156 @@
+package fastly
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// testClientCertificate writes a self-signed client certificate and key to
+// dir, returning their paths.
+func testClientCertificate(t *testing.T, dir string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "go-fastly test client"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certFile := filepath.Join(dir, "client.pem")
+	keyFile := filepath.Join(dir, "client-key.pem")
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	if err := ioutil.WriteFile(certFile, certPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile
+}
+
+func TestClient_LoadClientCertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "go-fastly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	certFile, keyFile := testClientCertificate(t, dir)
+
+	c, err := NewClient("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := c.LoadClientCertificate(certFile, keyFile); err != nil {
+		t.Fatal(err)
+	}
+
+	tr, ok := c.HTTPClient.Transport.(*http.Transport)
+	if !ok {
+		t.Fatalf("bad transport: %T", c.HTTPClient.Transport)
+	}
+	if tr.TLSClientConfig == nil || len(tr.TLSClientConfig.Certificates) != 1 {
+		t.Errorf("bad tls config: %#v", tr.TLSClientConfig)
+	}
+
+	// A nil transport is replaced rather than modifying http.DefaultTransport.
+	c.HTTPClient.Transport = nil
+	if err := c.LoadClientCertificate(certFile, keyFile); err != nil {
+		t.Fatal(err)
+	}
+	if c.HTTPClient.Transport == http.DefaultTransport {
+		t.Error("expected a new transport")
+	}
+
+	if err := c.LoadClientCertificate(filepath.Join(dir, "missing.pem"), keyFile); err == nil {
+		t.Error("expected error for missing certificate")
+	}
+}
+
+func TestClient_SetClientCertificate_unsupportedTransport(t *testing.T) {
+	c, err := NewClient("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.HTTPClient.Transport = roundTripperFunc(func(r *http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+
+	if err := c.SetClientCertificate(tls.Certificate{}); err != ErrUnsupportedTransport {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+// roundTripperFunc adapts a function to an http.RoundTripper.
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(r *http.Request) (*http.Response, error) {
+	return f(r)
+}
+
+func TestClient_LoadRootCAs(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	dir, err := ioutil.TempDir("", "go-fastly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	caFile := filepath.Join(dir, "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	if err := ioutil.WriteFile(caFile, caPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The test server's certificate is not trusted by the system roots.
+	if _, err := c.Get("/", nil); err == nil {
+		t.Fatal("expected error before loading CA")
+	}
+
+	if err := c.LoadRootCAs(caFile); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.Get("/", nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := c.LoadRootCAs(filepath.Join(dir, "missing.pem")); err == nil {
+		t.Error("expected error for missing bundle")
+	}
+}
@@ -0,0 +1,298 @@
+package fastly
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// APIEndpoint names a group of API endpoints whose request and response
+// shapes have changed over time.
+type APIEndpoint string
+
+const (
+	// APIEndpointBackend is the backend endpoints.
+	APIEndpointBackend APIEndpoint = "backend"
+
+	// APIEndpointLogging is the endpoints of every logging provider.
+	APIEndpointLogging APIEndpoint = "logging"
+
+	// APIEndpointWAF is the WAF firewall endpoints.
+	APIEndpointWAF APIEndpoint = "waf"
+)
+
+// APIVersion is a version of the shape of an APIEndpoint.
+type APIVersion int
+
+const (
+	// BackendAPIVersion1 backends have a single ssl_hostname, used both to
+	// verify the origin's certificate and as the SNI hostname.
+	BackendAPIVersion1 APIVersion = 1
+
+	// BackendAPIVersion2 backends replace ssl_hostname with separate
+	// ssl_cert_hostname and ssl_sni_hostname fields.
+	BackendAPIVersion2 APIVersion = 2
+
+	// LoggingAPIVersion1 logging endpoints have no format_version, and their
+	// formats use the version 1 syntax.
+	LoggingAPIVersion1 APIVersion = 1
+
+	// LoggingAPIVersion2 logging endpoints report the format_version of their
+	// formats.
+	LoggingAPIVersion2 APIVersion = 2
+
+	// WAFAPIVersion1 firewalls are JSON API resources of type "waf", with the
+	// service version they belong to as version.
+	WAFAPIVersion1 APIVersion = 1
+
+	// WAFAPIVersion2 firewalls are resources of type "waf_firewall", with the
+	// service version as service_version_number. Requests are always sent to
+	// the version 1 endpoints; either shape of response decodes to a WAF.
+	WAFAPIVersion2 APIVersion = 2
+)
+
+// CurrentAPIVersions are the versions of each APIEndpoint the API currently
+// returns.
+var CurrentAPIVersions = map[APIEndpoint]APIVersion{
+	APIEndpointBackend: BackendAPIVersion2,
+	APIEndpointLogging: LoggingAPIVersion2,
+	APIEndpointWAF:     WAFAPIVersion2,
+}
+
+// APIVersion returns the version of the endpoint the client sends requests
+// in: the one pinned in the client's APIVersions, or else the current one.
+func (c *Client) APIVersion(e APIEndpoint) APIVersion {
+	if v, ok := c.APIVersions[e]; ok {
+		return v
+	}
+	return CurrentAPIVersions[e]
+}
+
+// compatFallback fills in the fields of one shape of an endpoint's response
+// from the fields of another, so that either shape decodes to the same
+// struct.
+type compatFallback struct {
+	// applies reports whether the fallback is for the struct type t.
+	applies func(t reflect.Type) bool
+
+	// fill adds the missing fields to the decoded JSON object m.
+	fill func(m map[string]interface{})
+}
+
+// compatFallbacks are applied to every response decoded by decodeJSON,
+// whatever the client's APIVersions. Responses decoded from JSON API
+// documents do not pass through decodeJSON; the WAF endpoints use
+// compatWAFBody instead.
+var compatFallbacks = []compatFallback{
+	{
+		// Version 1 backends only have ssl_hostname, and version 2 backends
+		// have it empty; each gets the other's hostnames.
+		applies: isCompatType(Backend{}),
+		fill: func(m map[string]interface{}) {
+			if h := compatString(m, "ssl_hostname"); h != "" {
+				if compatString(m, "ssl_cert_hostname") == "" {
+					m["ssl_cert_hostname"] = h
+				}
+				if compatString(m, "ssl_sni_hostname") == "" {
+					m["ssl_sni_hostname"] = h
+				}
+				return
+			}
+			if h := compatString(m, "ssl_cert_hostname"); h != "" && h == compatString(m, "ssl_sni_hostname") {
+				m["ssl_hostname"] = h
+			}
+		},
+	},
+	{
+		// Version 1 logging endpoints use format version 1 without saying so.
+		applies: hasCompatField("format_version"),
+		fill: func(m map[string]interface{}) {
+			if v, ok := m["format_version"]; !ok || v == nil {
+				m["format_version"] = 1
+			}
+		},
+	},
+}
+
+// compatHookFunc returns a function that applies the compatFallbacks for the
+// struct being decoded to its JSON object.
+func compatHookFunc() mapstructure.DecodeHookFunc {
+	return func(
+		f reflect.Type,
+		t reflect.Type,
+		data interface{}) (interface{}, error) {
+		if f.Kind() != reflect.Map || t.Kind() != reflect.Struct {
+			return data, nil
+		}
+
+		m, ok := data.(map[string]interface{})
+		if !ok {
+			return data, nil
+		}
+		for _, fb := range compatFallbacksFor(t) {
+			fb.fill(m)
+		}
+		return m, nil
+	}
+}
+
+// compatTypes caches the compatFallbacks which apply to each struct type, as
+// every struct decoded is looked up.
+var (
+	compatTypesMu sync.Mutex
+	compatTypes   = make(map[reflect.Type][]compatFallback)
+)
+
+// compatFallbacksFor returns the compatFallbacks which apply to the struct
+// type t.
+func compatFallbacksFor(t reflect.Type) []compatFallback {
+	compatTypesMu.Lock()
+	defer compatTypesMu.Unlock()
+
+	fbs, ok := compatTypes[t]
+	if !ok {
+		for _, fb := range compatFallbacks {
+			if fb.applies(t) {
+				fbs = append(fbs, fb)
+			}
+		}
+		compatTypes[t] = fbs
+	}
+	return fbs
+}
+
+// isCompatType returns an applies function for the type of v.
+func isCompatType(v interface{}) func(reflect.Type) bool {
+	want := reflect.TypeOf(v)
+	return func(t reflect.Type) bool {
+		return t == want
+	}
+}
+
+// hasCompatField returns an applies function for struct types with a field
+// decoded from the given key.
+func hasCompatField(key string) func(reflect.Type) bool {
+	return func(t reflect.Type) bool {
+		for n := 0; n < t.NumField(); n++ {
+			if strings.Split(t.Field(n).Tag.Get("mapstructure"), ",")[0] == key {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// compatString returns the string value of key in m, or "" if it is not a
+// string.
+func compatString(m map[string]interface{}, key string) string {
+	s, _ := m[key].(string)
+	return s
+}
+
+// compatSSLHostnames rewrites the hostname fields of a backend input in the
+// shape of the given backend version: version 1 sends a single ssl_hostname,
+// and version 2 sends ssl_cert_hostname and ssl_sni_hostname.
+func compatSSLHostnames(v APIVersion, hostname, certHostname, sniHostname *string) {
+	switch v {
+	case BackendAPIVersion1:
+		if *hostname == "" {
+			*hostname = *certHostname
+		}
+		if *hostname == "" {
+			*hostname = *sniHostname
+		}
+		*certHostname, *sniHostname = "", ""
+	case BackendAPIVersion2:
+		if *hostname == "" {
+			return
+		}
+		if *certHostname == "" {
+			*certHostname = *hostname
+		}
+		if *sniHostname == "" {
+			*sniHostname = *hostname
+		}
+		*hostname = ""
+	}
+}
+
+// compatLoggingInput returns the input of a request to the path p in the
+// shape of the client's logging version: version 1 endpoints have no
+// format_version, so it is left out. Other inputs are returned as given,
+// and the input is copied rather than modified.
+func (c *Client) compatLoggingInput(p string, i interface{}) interface{} {
+	if c.APIVersion(APIEndpointLogging) != LoggingAPIVersion1 || !strings.Contains(p, "/logging/") {
+		return i
+	}
+
+	in := reflect.ValueOf(i)
+	if in.Kind() != reflect.Ptr || in.IsNil() || in.Elem().Kind() != reflect.Struct {
+		return i
+	}
+	if f := in.Elem().FieldByName("FormatVersion"); !f.IsValid() {
+		return i
+	}
+	out := reflect.New(in.Elem().Type())
+	out.Elem().Set(in.Elem())
+	f := out.Elem().FieldByName("FormatVersion")
+	f.Set(reflect.Zero(f.Type()))
+	return out.Interface()
+}
+
+// compatWAFBody returns the JSON API document read from r with the
+// resources of a WAFAPIVersion2 response, of type "waf_firewall", in the
+// WAFAPIVersion1 shape the WAF struct decodes. Other documents are returned
+// as they were read.
+func compatWAFBody(r io.Reader) (io.Reader, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return bytes.NewReader(b), nil
+	}
+
+	changed := false
+	switch data := doc["data"].(type) {
+	case map[string]interface{}:
+		changed = compatWAFResource(data)
+	case []interface{}:
+		for _, d := range data {
+			if m, ok := d.(map[string]interface{}); ok && compatWAFResource(m) {
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return bytes.NewReader(b), nil
+	}
+
+	b, err = json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+	return bytes.NewReader(b), nil
+}
+
+// compatWAFResource rewrites a "waf_firewall" resource as a "waf" resource,
+// reporting whether it did.
+func compatWAFResource(m map[string]interface{}) bool {
+	if m["type"] != "waf_firewall" {
+		return false
+	}
+	m["type"] = "waf"
+	if attrs, ok := m["attributes"].(map[string]interface{}); ok {
+		if _, ok := attrs["version"]; !ok {
+			attrs["version"] = attrs["service_version_number"]
+		}
+	}
+	return true
+}
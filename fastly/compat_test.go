@@ -0,0 +1,206 @@
+package fastly
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestDecodeJSON_compatFallbacks(t *testing.T) {
+	t.Parallel()
+
+	decode := func(body string, out interface{}) {
+		if err := decodeJSON(out, ioutil.NopCloser(strings.NewReader(body))); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A version 1 backend has only ssl_hostname.
+	var b1 *Backend
+	decode(`{"name":"origin","ssl_hostname":"origin.example.com"}`, &b1)
+	if b1.SSLCertHostname != "origin.example.com" || b1.SSLSNIHostname != "origin.example.com" {
+		t.Errorf("bad version 1 backend: %#v", b1)
+	}
+
+	// A version 2 backend has ssl_hostname empty.
+	var b2 []*Backend
+	decode(`[{"name":"origin","ssl_hostname":null,"ssl_cert_hostname":"origin.example.com","ssl_sni_hostname":"origin.example.com"}]`, &b2)
+	if b2[0].SSLHostname != "origin.example.com" {
+		t.Errorf("bad version 2 backend: %#v", b2[0])
+	}
+
+	var s1, s2 *S3
+	decode(`{"name":"logs"}`, &s1)
+	if s1.FormatVersion != 1 {
+		t.Errorf("expected format version 1, got %d", s1.FormatVersion)
+	}
+	decode(`{"name":"logs","format_version":"2"}`, &s2)
+	if s2.FormatVersion != 2 {
+		t.Errorf("expected format version 2, got %d", s2.FormatVersion)
+	}
+}
+
+func TestClient_APIVersions_backend(t *testing.T) {
+	t.Parallel()
+
+	var form string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		form = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name":"origin","ssl_hostname":"origin.example.com"}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v := c.APIVersion(APIEndpointBackend); v != BackendAPIVersion2 {
+		t.Errorf("bad default version: %d", v)
+	}
+
+	i := &CreateBackendInput{
+		Service:         testServiceID,
+		Version:         1,
+		Name:            "origin",
+		SSLCertHostname: "origin.example.com",
+	}
+	c.APIVersions = map[APIEndpoint]APIVersion{APIEndpointBackend: BackendAPIVersion1}
+	if _, err := c.CreateBackend(i); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(form, "ssl_hostname=origin.example.com") || strings.Contains(form, "ssl_cert_hostname") {
+		t.Errorf("bad version 1 form: %s", form)
+	}
+	if i.SSLHostname != "" {
+		t.Errorf("expected the input to be left unchanged: %#v", i)
+	}
+
+	c.APIVersions[APIEndpointBackend] = BackendAPIVersion2
+	if _, err := c.UpdateBackend(&UpdateBackendInput{
+		Service:     testServiceID,
+		Version:     1,
+		Name:        "origin",
+		SSLHostname: "origin.example.com",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(form, "ssl_hostname") || !strings.Contains(form, "ssl_sni_hostname=origin.example.com") {
+		t.Errorf("bad version 2 form: %s", form)
+	}
+}
+
+func TestClient_APIVersions_logging(t *testing.T) {
+	t.Parallel()
+
+	var form string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		form = string(body)
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"name":"logs"}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	i := &CreateSyslogInput{
+		Service:       testServiceID,
+		Version:       1,
+		Name:          "logs",
+		FormatVersion: 2,
+	}
+	if _, err := c.CreateSyslog(i); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(form, "format_version=2") {
+		t.Errorf("bad version 2 form: %s", form)
+	}
+
+	c.APIVersions = map[APIEndpoint]APIVersion{APIEndpointLogging: LoggingAPIVersion1}
+	if _, err := c.CreateSyslog(i); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(form, "format_version") || !strings.Contains(form, "name=logs") {
+		t.Errorf("bad version 1 form: %s", form)
+	}
+	if i.FormatVersion != 2 {
+		t.Errorf("expected the input to be left unchanged: %#v", i)
+	}
+}
+
+func TestCompatFallbacksFor(t *testing.T) {
+	t.Parallel()
+
+	backend := reflect.TypeOf(Backend{})
+	if fbs := compatFallbacksFor(backend); len(fbs) != 1 {
+		t.Errorf("expected 1 backend fallback, got %d", len(fbs))
+	}
+	if fbs := compatFallbacksFor(reflect.TypeOf(S3{})); len(fbs) != 1 {
+		t.Errorf("expected 1 S3 fallback, got %d", len(fbs))
+	}
+	if fbs := compatFallbacksFor(reflect.TypeOf(Service{})); len(fbs) != 0 {
+		t.Errorf("expected no service fallbacks, got %d", len(fbs))
+	}
+
+	compatTypesMu.Lock()
+	_, ok := compatTypes[backend]
+	compatTypesMu.Unlock()
+	if !ok {
+		t.Error("expected the backend fallbacks to be cached")
+	}
+}
+
+func TestClient_WAF_compatShapes(t *testing.T) {
+	legacy := `{"id":"3ZUpVLVtuYMU2XHcu7c2A1","type":"waf","attributes":{"version":7,"prefetch_condition":"WAF-Prefetch","response":"WAF-Response","last_push":"2017-11-21T20:40:29Z"},"relationships":{"configuration_set":{"data":{"id":"4r1mZFeQTsPdD0GNaLdEyj","type":"configuration_set"}}}}`
+	current := `{"id":"3ZUpVLVtuYMU2XHcu7c2A1","type":"waf_firewall","attributes":{"service_id":"foo","service_version_number":7,"prefetch_condition":"WAF-Prefetch","response":"WAF-Response","disabled":false}}`
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/vnd.api+json")
+		switch r.URL.Path {
+		case "/service/foo/version/7/wafs":
+			w.Write([]byte(`{"data":[` + legacy + `,` + current + `]}`))
+		case "/service/foo/version/7/wafs/legacy":
+			w.Write([]byte(`{"data":` + legacy + `}`))
+		default:
+			w.Write([]byte(`{"data":` + current + `}`))
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	wafs, err := c.ListWAFs(&ListWAFsInput{Service: "foo", Version: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(wafs) != 2 {
+		t.Fatalf("bad wafs: %v", wafs)
+	}
+	for _, id := range []string{"legacy", "current"} {
+		waf, err := c.GetWAF(&GetWAFInput{Service: "foo", Version: 7, ID: id})
+		if err != nil {
+			t.Fatal(err)
+		}
+		wafs = append(wafs, waf)
+	}
+
+	for n, waf := range wafs {
+		if waf.ID != "3ZUpVLVtuYMU2XHcu7c2A1" || waf.Version != 7 || waf.PrefetchCondition != "WAF-Prefetch" || waf.Response != "WAF-Response" {
+			t.Errorf("bad waf %d: %#v", n, waf)
+		}
+	}
+	if wafs[0].ConfigurationSet == nil || wafs[0].ConfigurationSet.ID != "4r1mZFeQTsPdD0GNaLdEyj" {
+		t.Errorf("bad configuration set: %#v", wafs[0].ConfigurationSet)
+	}
+}
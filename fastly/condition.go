@@ -46,6 +46,10 @@ func (c *Client) ListConditions(i *ListConditionsInput) ([]*Condition, error) {
 		return nil, ErrMissingVersion
 	}
 
+	if err := c.requireVCLService(i.Service); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/condition", i.Service, i.Version)
 	resp, err := c.Get(path, nil)
 	if err != nil {
@@ -83,6 +87,10 @@ func (c *Client) CreateCondition(i *CreateConditionInput) (*Condition, error) {
 		return nil, ErrMissingVersion
 	}
 
+	if err := c.requireVCLService(i.Service); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/condition", i.Service, i.Version)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -121,6 +129,10 @@ func (c *Client) GetCondition(i *GetConditionInput) (*Condition, error) {
 		return nil, ErrMissingName
 	}
 
+	if err := c.requireVCLService(i.Service); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/condition/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Get(path, nil)
 	if err != nil {
@@ -163,6 +175,10 @@ func (c *Client) UpdateCondition(i *UpdateConditionInput) (*Condition, error) {
 		return nil, ErrMissingName
 	}
 
+	if err := c.requireVCLService(i.Service); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/condition/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
@@ -201,6 +217,10 @@ func (c *Client) DeleteCondition(i *DeleteConditionInput) error {
 		return ErrMissingName
 	}
 
+	if err := c.requireVCLService(i.Service); err != nil {
+		return err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/condition/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Delete(path, nil)
 	if err != nil {
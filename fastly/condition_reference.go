@@ -0,0 +1,149 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// ConditionReferenceError is returned when an object references a condition
+// which does not exist in the version, or which is of the wrong type.
+type ConditionReferenceError struct {
+	// Field is the referencing field, such as "request_condition".
+	Field string
+
+	// Condition is the referenced condition name.
+	Condition string
+
+	// Want is the condition type the field requires, such as "REQUEST", and
+	// Got is the type of the existing condition, or empty if it does not exist.
+	Want string
+	Got  string
+}
+
+// Error implements the error interface.
+func (e *ConditionReferenceError) Error() string {
+	if e.Got == "" {
+		return fmt.Sprintf("%s %q does not exist", e.Field, e.Condition)
+	}
+	return fmt.Sprintf("%s %q is a %s condition, not %s", e.Field, e.Condition, e.Got, e.Want)
+}
+
+// conditionReference is a condition named by an input struct field.
+type conditionReference struct {
+	field string
+	name  string
+	want  string
+}
+
+// conditionReferences returns the conditions referenced by the
+// "request_condition", "cache_condition" and "response_condition" fields of
+// i, which may be a struct or a pointer to one. Empty references are skipped.
+func conditionReferences(i interface{}) []*conditionReference {
+	v := reflect.ValueOf(i)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var refs []*conditionReference
+	t := v.Type()
+	for n := 0; n < t.NumField(); n++ {
+		field := strings.Split(t.Field(n).Tag.Get("form"), ",")[0]
+		if !strings.HasSuffix(field, "_condition") {
+			continue
+		}
+		if f := v.Field(n); f.Kind() == reflect.String && f.String() != "" {
+			refs = append(refs, &conditionReference{
+				field: field,
+				name:  f.String(),
+				want:  strings.ToUpper(strings.TrimSuffix(field, "_condition")),
+			})
+		}
+	}
+	return refs
+}
+
+// CheckConditionReferencesInput is used as input to the
+// CheckConditionReferences function.
+type CheckConditionReferencesInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Input is the create or update input to check, such as a
+	// *CreateHeaderInput (required).
+	Input interface{}
+}
+
+// CheckConditionReferences checks that every condition referenced by the
+// input's request_condition, cache_condition and response_condition fields
+// exists in the version and is of the matching type. The API accepts
+// references to missing conditions, which then silently never apply. The first
+// bad reference is returned as a *ConditionReferenceError.
+func (c *Client) CheckConditionReferences(i *CheckConditionReferencesInput) error {
+	if i.Service == "" {
+		return ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return ErrMissingVersion
+	}
+
+	refs := conditionReferences(i.Input)
+	if len(refs) == 0 {
+		return nil
+	}
+
+	cs, err := c.ListConditions(&ListConditionsInput{
+		Service: i.Service,
+		Version: i.Version,
+	})
+	if err != nil {
+		return err
+	}
+
+	types := make(map[string]string, len(cs))
+	for _, cond := range cs {
+		types[cond.Name] = strings.ToUpper(cond.Type)
+	}
+
+	for _, ref := range refs {
+		if got := types[ref.name]; got != ref.want {
+			return &ConditionReferenceError{
+				Field:     ref.field,
+				Condition: ref.name,
+				Want:      ref.want,
+				Got:       got,
+			}
+		}
+	}
+	return nil
+}
+
+// checkFormConditionReferences runs CheckConditionReferences for a form
+// input when CheckConditions is set. The service and version are taken from
+// the input's Service and Version fields; inputs without them are skipped.
+func (c *Client) checkFormConditionReferences(i interface{}) error {
+	if !c.CheckConditions || len(conditionReferences(i)) == 0 {
+		return nil
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(i))
+	service, version := v.FieldByName("Service"), v.FieldByName("Version")
+	if service.Kind() != reflect.String || version.Kind() != reflect.Int {
+		return nil
+	}
+
+	return c.CheckConditionReferences(&CheckConditionReferencesInput{
+		Service: service.String(),
+		Version: int(version.Int()),
+		Input:   i,
+	})
+}
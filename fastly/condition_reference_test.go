@@ -0,0 +1,46 @@
+package fastly
+
+import "testing"
+
+func TestClient_CheckConditionReferences(t *testing.T) {
+	t.Parallel()
+
+	var valid, missing, mistyped error
+	record(t, "conditions/references", func(c *Client) {
+		valid = c.CheckConditionReferences(&CheckConditionReferencesInput{
+			Service: "7i6HN3TK9wS159v2gPAZ8A",
+			Version: 5,
+			Input: &CreateHeaderInput{
+				RequestCondition: "is-api",
+				CacheCondition:   "is-static",
+			},
+		})
+
+		c.CheckConditions = true
+		_, missing = c.CreateHeader(&CreateHeaderInput{
+			Service:           "7i6HN3TK9wS159v2gPAZ8A",
+			Version:           5,
+			Name:              "test-header",
+			ResponseCondition: "is-error",
+		})
+		_, mistyped = c.CreateHeader(&CreateHeaderInput{
+			Service:          "7i6HN3TK9wS159v2gPAZ8A",
+			Version:          5,
+			Name:             "test-header",
+			RequestCondition: "is-static",
+		})
+	})
+	if valid != nil {
+		t.Errorf("bad error: %s", valid)
+	}
+
+	if err, ok := missing.(*ConditionReferenceError); !ok || err.Field != "response_condition" || err.Got != "" {
+		t.Errorf("bad error: %v", missing)
+	} else if err.Error() != `response_condition "is-error" does not exist` {
+		t.Errorf("bad message: %s", err)
+	}
+
+	if err, ok := mistyped.(*ConditionReferenceError); !ok || err.Want != "REQUEST" || err.Got != "CACHE" {
+		t.Errorf("bad error: %v", mistyped)
+	}
+}
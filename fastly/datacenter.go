@@ -0,0 +1,111 @@
+package fastly
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Datacenter represents a Fastly point of presence.
+type Datacenter struct {
+	Code          string       `mapstructure:"code"`
+	Name          string       `mapstructure:"name"`
+	Group         string       `mapstructure:"group"`
+	Shield        string       `mapstructure:"shield"`
+	BillingRegion string       `mapstructure:"billing_region"`
+	Coordinates   *Coordinates `mapstructure:"coordinates"`
+}
+
+// Coordinates is the location of a datacenter.
+type Coordinates struct {
+	Latitude  float64 `mapstructure:"latitude"`
+	Longitude float64 `mapstructure:"longitude"`
+	X         float64 `mapstructure:"x"`
+	Y         float64 `mapstructure:"y"`
+}
+
+// datacentersByCode is a sortable list of datacenters.
+type datacentersByCode []*Datacenter
+
+// Len, Swap, and Less implement the sortable interface.
+func (s datacentersByCode) Len() int      { return len(s) }
+func (s datacentersByCode) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s datacentersByCode) Less(i, j int) bool {
+	return s[i].Code < s[j].Code
+}
+
+// ListDatacenters returns the list of all Fastly datacenters, sorted by code.
+func (c *Client) ListDatacenters() ([]*Datacenter, error) {
+	resp, err := c.Get("/datacenters", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ds []*Datacenter
+	if err := decodeJSON(&ds, resp.Body); err != nil {
+		return nil, err
+	}
+	sort.Stable(datacentersByCode(ds))
+	return ds, nil
+}
+
+// ListShieldDatacenters returns the datacenters which may be used as a shield,
+// sorted by code. The value to use for a backend's Shield field is the
+// datacenter's Shield, such as "amsterdam-nl", not its Code.
+func (c *Client) ListShieldDatacenters() ([]*Datacenter, error) {
+	ds, err := c.ListDatacenters()
+	if err != nil {
+		return nil, err
+	}
+
+	var shields []*Datacenter
+	for _, d := range ds {
+		if d.Shield != "" {
+			shields = append(shields, d)
+		}
+	}
+	return shields, nil
+}
+
+// ValidateShield checks a backend Shield value against the list of shield
+// datacenters from ListShieldDatacenters, so that a typo can be reported
+// before the API rejects the version. An empty value, meaning no shielding, is
+// always valid.
+func ValidateShield(datacenters []*Datacenter, shield string) error {
+	if shield == "" {
+		return nil
+	}
+
+	for _, d := range datacenters {
+		if d.Shield == shield {
+			return nil
+		}
+	}
+
+	for _, d := range datacenters {
+		if d.Shield != "" && d.Code == shield {
+			return fmt.Errorf("Invalid shield %q: use the shield code %q for datacenter %s", shield, d.Shield, d.Code)
+		}
+	}
+	return fmt.Errorf("Invalid shield %q: not a shield datacenter", shield)
+}
+
+// ValidateBackendShield checks a backend Shield value, as
+// CreateBackendInput.Shield or UpdateBackendInput.Shield, against the shield
+// datacenters. ListShieldDatacenters is called once per client and cached, so
+// many backends can be checked without a request each.
+func (c *Client) ValidateBackendShield(shield string) error {
+	if shield == "" {
+		return nil
+	}
+
+	c.shieldsMu.Lock()
+	defer c.shieldsMu.Unlock()
+	if c.shields == nil {
+		shields, err := c.ListShieldDatacenters()
+		if err != nil {
+			return err
+		}
+		c.shields = append([]*Datacenter{}, shields...)
+	}
+	return ValidateShield(c.shields, shield)
+}
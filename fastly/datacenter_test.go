@@ -0,0 +1,64 @@
+package fastly
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestClient_ListDatacenters(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var ds []*Datacenter
+	record(t, "datacenters/list", func(c *Client) {
+		ds, err = c.ListDatacenters()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ds) != 4 {
+		t.Fatalf("bad datacenters: %d", len(ds))
+	}
+	if ds[0].Code != "AMS" || ds[len(ds)-1].Code != "IAD" {
+		t.Errorf("bad order: %q, %q", ds[0].Code, ds[len(ds)-1].Code)
+	}
+	if ds[0].Coordinates == nil || ds[0].Coordinates.Latitude != 52.308613 {
+		t.Errorf("bad coordinates: %#v", ds[0].Coordinates)
+	}
+
+	var shields []*Datacenter
+	record(t, "datacenters/list", func(c *Client) {
+		shields, err = c.ListShieldDatacenters()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(shields) != 3 {
+		t.Fatalf("bad shields: %d", len(shields))
+	}
+	for _, d := range shields {
+		if d.Code == "BOG" {
+			t.Errorf("non-shield datacenter %q returned", d.Code)
+		}
+	}
+
+	if err := ValidateShield(shields, ""); err != nil {
+		t.Errorf("bad error for no shield: %s", err)
+	}
+	if err := ValidateShield(shields, "iad-va-us"); err != nil {
+		t.Errorf("bad error for valid shield: %s", err)
+	}
+	if err := ValidateShield(shields, "FRA"); err == nil || !strings.Contains(err.Error(), "frankfurt-de") {
+		t.Errorf("bad error for datacenter code: %v", err)
+	}
+	if err := ValidateShield(shields, "bogota-co"); err == nil {
+		t.Error("expected error for unknown shield")
+	}
+
+	record(t, "datacenters/list", func(c *Client) {
+		err = c.ValidateBackendShield("amsterdam-nl")
+	})
+	if err != nil {
+		t.Errorf("bad error: %s", err)
+	}
+}
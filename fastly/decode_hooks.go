@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"net/http"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/mitchellh/mapstructure"
@@ -66,3 +67,24 @@ func stringToTimeHookFunc() mapstructure.DecodeHookFunc {
 		return time.Parse(time.RFC3339, data.(string))
 	}
 }
+
+// stringToCipherListHookFunc returns a function that splits the colon-separated
+// cipher strings returned by the API into a CipherList.
+func stringToCipherListHookFunc() mapstructure.DecodeHookFunc {
+	return func(
+		f reflect.Type,
+		t reflect.Type,
+		data interface{}) (interface{}, error) {
+		if f.Kind() != reflect.String {
+			return data, nil
+		}
+		if t != reflect.TypeOf(CipherList{}) {
+			return data, nil
+		}
+
+		if data.(string) == "" {
+			return CipherList{}, nil
+		}
+		return CipherList(strings.Split(data.(string), ":")), nil
+	}
+}
@@ -0,0 +1,274 @@
+package fastly
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultDeleteAllParallelism is the number of deletes the DeleteAll
+	// functions run at once when no parallelism is given.
+	DefaultDeleteAllParallelism = 4
+
+	// DefaultDeleteAllRetries is the number of times a delete is retried
+	// when no retry count is given.
+	DefaultDeleteAllRetries = 3
+
+	// DefaultDeleteAllRetryInterval is the delay before the first retry of a
+	// delete when no interval is given.
+	DefaultDeleteAllRetryInterval = 1 * time.Second
+)
+
+// DeleteAllInput is used as input to the DeleteAll functions, such as
+// DeleteAllBackends.
+type DeleteAllInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Parallelism is the number of deletes run at once. Optional, defaults to
+	// DefaultDeleteAllParallelism.
+	Parallelism int
+
+	// MaxRetries is the number of times a delete rejected with a 429 or 5xx
+	// response is retried. RetryInterval is the delay before the first retry,
+	// which doubles after each one. Both are optional.
+	MaxRetries    int
+	RetryInterval time.Duration
+}
+
+// DeleteAllBackends deletes every backend of a version. It returns the number
+// of backends deleted.
+func (c *Client) DeleteAllBackends(i *DeleteAllInput) (int, error) {
+	return c.deleteAll(i, func() ([]string, error) {
+		list, err := c.ListBackends(&ListBackendsInput{Service: i.Service, Version: i.Version})
+		names := make([]string, len(list))
+		for n, b := range list {
+			names[n] = b.Name
+		}
+		return names, err
+	}, func(name string) error {
+		return c.DeleteBackend(&DeleteBackendInput{Service: i.Service, Version: i.Version, Name: name})
+	})
+}
+
+// DeleteAllConditions deletes every condition of a version. Objects which
+// use a condition must be deleted first. It returns the number of conditions
+// deleted.
+func (c *Client) DeleteAllConditions(i *DeleteAllInput) (int, error) {
+	return c.deleteAll(i, func() ([]string, error) {
+		list, err := c.ListConditions(&ListConditionsInput{Service: i.Service, Version: i.Version})
+		names := make([]string, len(list))
+		for n, cond := range list {
+			names[n] = cond.Name
+		}
+		return names, err
+	}, func(name string) error {
+		return c.DeleteCondition(&DeleteConditionInput{Service: i.Service, Version: i.Version, Name: name})
+	})
+}
+
+// DeleteAllDirectors deletes every director of a version. It returns the
+// number of directors deleted.
+func (c *Client) DeleteAllDirectors(i *DeleteAllInput) (int, error) {
+	return c.deleteAll(i, func() ([]string, error) {
+		list, err := c.ListDirectors(&ListDirectorsInput{Service: i.Service, Version: i.Version})
+		names := make([]string, len(list))
+		for n, d := range list {
+			names[n] = d.Name
+		}
+		return names, err
+	}, func(name string) error {
+		return c.DeleteDirector(&DeleteDirectorInput{Service: i.Service, Version: i.Version, Name: name})
+	})
+}
+
+// DeleteAllDomains deletes every domain of a version. It returns the number
+// of domains deleted.
+func (c *Client) DeleteAllDomains(i *DeleteAllInput) (int, error) {
+	return c.deleteAll(i, func() ([]string, error) {
+		list, err := c.ListDomains(&ListDomainsInput{Service: i.Service, Version: i.Version})
+		names := make([]string, len(list))
+		for n, d := range list {
+			names[n] = d.Name
+		}
+		return names, err
+	}, func(name string) error {
+		return c.DeleteDomain(&DeleteDomainInput{Service: i.Service, Version: i.Version, Name: name})
+	})
+}
+
+// DeleteAllHeaders deletes every header of a version. It returns the number
+// of headers deleted.
+func (c *Client) DeleteAllHeaders(i *DeleteAllInput) (int, error) {
+	return c.deleteAll(i, func() ([]string, error) {
+		list, err := c.ListHeaders(&ListHeadersInput{Service: i.Service, Version: i.Version})
+		names := make([]string, len(list))
+		for n, h := range list {
+			names[n] = h.Name
+		}
+		return names, err
+	}, func(name string) error {
+		return c.DeleteHeader(&DeleteHeaderInput{Service: i.Service, Version: i.Version, Name: name})
+	})
+}
+
+// DeleteAllSnippets deletes every VCL snippet of a version. It returns the
+// number of snippets deleted.
+func (c *Client) DeleteAllSnippets(i *DeleteAllInput) (int, error) {
+	return c.deleteAll(i, func() ([]string, error) {
+		list, err := c.ListSnippets(&ListSnippetsInput{Service: i.Service, Version: i.Version})
+		names := make([]string, len(list))
+		for n, s := range list {
+			names[n] = s.Name
+		}
+		return names, err
+	}, func(name string) error {
+		return c.DeleteSnippet(&DeleteSnippetInput{Service: i.Service, Version: i.Version, Name: name})
+	})
+}
+
+// DeleteAllLoggingEndpoints deletes every logging endpoint of every type of a
+// version. It returns the number of endpoints deleted.
+func (c *Client) DeleteAllLoggingEndpoints(i *DeleteAllInput) (int, error) {
+	types := make(map[string]LoggingType)
+	return c.deleteAll(i, func() ([]string, error) {
+		list, err := c.ListAllLoggingEndpoints(&ListAllLoggingEndpointsInput{Service: i.Service, Version: i.Version})
+		names := make([]string, len(list))
+		for n, e := range list {
+			// Endpoints of different types may share a name, so the type is
+			// part of the key passed to the delete.
+			names[n] = string(e.Type) + "/" + e.Name
+			types[names[n]] = e.Type
+		}
+		return names, err
+	}, func(key string) error {
+		t := types[key]
+		return c.DeleteLoggingEndpoint(&DeleteLoggingEndpointInput{
+			Service: i.Service,
+			Version: i.Version,
+			Type:    t,
+			Name:    key[len(t)+1:],
+		})
+	})
+}
+
+// ResetVersion deletes the headers, snippets, logging endpoints, domains,
+// directors, backends and conditions of a version, in an order which removes
+// objects before the ones they refer to. A Compute service has no headers,
+// snippets, directors or conditions, so for one only its logging endpoints,
+// domains and backends are deleted. It is meant for returning a scratch
+// version, such as one used by tests, to a clean slate. It returns the number
+// of objects deleted.
+func (c *Client) ResetVersion(i *DeleteAllInput) (int, error) {
+	if i.Service == "" {
+		return 0, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return 0, ErrMissingVersion
+	}
+
+	t := c.serviceType(i.Service)
+	if t == "" {
+		s, err := c.GetService(&GetServiceInput{ID: i.Service})
+		if err != nil {
+			return 0, err
+		}
+		t = s.Type
+	}
+
+	deleteAlls := []func(*DeleteAllInput) (int, error){
+		c.DeleteAllHeaders,
+		c.DeleteAllSnippets,
+		c.DeleteAllLoggingEndpoints,
+		c.DeleteAllDomains,
+		c.DeleteAllDirectors,
+		c.DeleteAllBackends,
+		c.DeleteAllConditions,
+	}
+	if t == ServiceTypeWasm {
+		deleteAlls = []func(*DeleteAllInput) (int, error){
+			c.DeleteAllLoggingEndpoints,
+			c.DeleteAllDomains,
+			c.DeleteAllBackends,
+		}
+	}
+
+	var total int
+	for _, deleteAll := range deleteAlls {
+		n, err := deleteAll(i)
+		total += n
+		if err != nil {
+			return total, err
+		}
+	}
+	return total, nil
+}
+
+// deleteAll lists the names of a kind of object and deletes them
+// concurrently, retrying deletes which fail with a 429 or 5xx response.
+// Objects already deleted by someone else are counted as deleted. The first
+// error is returned.
+func (c *Client) deleteAll(i *DeleteAllInput, list func() ([]string, error), del func(string) error) (int, error) {
+	if i.Service == "" {
+		return 0, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return 0, ErrMissingVersion
+	}
+
+	names, err := list()
+	if err != nil {
+		return 0, err
+	}
+
+	parallelism := i.Parallelism
+	if parallelism <= 0 {
+		parallelism = DefaultDeleteAllParallelism
+	}
+	retries := i.MaxRetries
+	if retries <= 0 {
+		retries = DefaultDeleteAllRetries
+	}
+	interval := i.RetryInterval
+	if interval <= 0 {
+		interval = DefaultDeleteAllRetryInterval
+	}
+
+	var (
+		mu       sync.Mutex
+		deleted  int
+		firstErr error
+	)
+	RunBounded(parallelism, len(names), func(n int) {
+		delay := interval
+		var err error
+		for attempt := 0; ; attempt++ {
+			err = del(names[n])
+			if err == nil || isNotFound(err) {
+				err = nil
+				break
+			}
+			herr, ok := err.(*HTTPError)
+			if !ok || (herr.StatusCode != 429 && herr.StatusCode < 500) || attempt == retries {
+				break
+			}
+			time.Sleep(delay)
+			delay *= 2
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			return
+		}
+		deleted++
+	})
+	return deleted, firstErr
+}
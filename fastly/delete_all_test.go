@@ -0,0 +1,144 @@
+package fastly
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClient_DeleteAllBackends(t *testing.T) {
+	var mu sync.Mutex
+	deletes := make(map[string]int)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/service/foo/version/2/backend":
+			w.Write([]byte(`[{"name":"a"},{"name":"b"},{"name":"c"},{"name":"d"}]`))
+		case r.Method == "DELETE":
+			name := r.URL.Path[len("/service/foo/version/2/backend/"):]
+			deletes[name]++
+			switch {
+			case name == "b" && deletes[name] < 3:
+				w.WriteHeader(http.StatusServiceUnavailable)
+			case name == "c":
+				w.WriteHeader(http.StatusNotFound)
+			case name == "d":
+				w.WriteHeader(http.StatusBadRequest)
+			default:
+				w.Write([]byte(`{"status":"ok"}`))
+			}
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := c.DeleteAllBackends(&DeleteAllInput{
+		Service:       "foo",
+		Version:       2,
+		RetryInterval: time.Millisecond,
+	})
+	if herr, ok := err.(*HTTPError); !ok || herr.StatusCode != http.StatusBadRequest {
+		t.Errorf("bad error: %v", err)
+	}
+	if n != 3 {
+		t.Errorf("expected 3 backends deleted, got %d", n)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if deletes["b"] != 3 {
+		t.Errorf("expected b to be retried, got %d deletes", deletes["b"])
+	}
+	if deletes["d"] != 1 {
+		t.Errorf("expected d not to be retried, got %d deletes", deletes["d"])
+	}
+}
+
+func TestClient_ResetVersion_wasm(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/service/foo":
+			w.Write([]byte(`{"id":"foo","type":"wasm","version":1}`))
+		case r.Method == "GET" && r.URL.Path == "/service/foo/version/2/backend":
+			w.Write([]byte(`[{"name":"origin"}]`))
+		case r.Method == "GET" && r.URL.Path == "/service/foo/version/2/domain":
+			w.Write([]byte(`[{"name":"www.example.com"}]`))
+		case r.Method == "GET":
+			w.Write([]byte(`[]`))
+		default:
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			w.Write([]byte(`{"status":"ok"}`))
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The client has not seen the service, so its type is looked up rather
+	// than failing partway through with ErrNotVCLService.
+	n, err := c.ResetVersion(&DeleteAllInput{
+		Service: "foo",
+		Version: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("expected 2 objects deleted, got %d", n)
+	}
+
+	// Now that the type is known, the VCL-only calls would fail, so the
+	// reset must skip them.
+	if _, err := c.ResetVersion(&DeleteAllInput{Service: "foo", Version: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	expected := []string{
+		"DELETE /service/foo/version/2/domain/www.example.com",
+		"DELETE /service/foo/version/2/backend/origin",
+		"DELETE /service/foo/version/2/domain/www.example.com",
+		"DELETE /service/foo/version/2/backend/origin",
+	}
+	if !reflect.DeepEqual(requests, expected) {
+		t.Errorf("bad requests: %q", requests)
+	}
+}
+
+func TestClient_DeleteAll_validation(t *testing.T) {
+	var err error
+	_, err = testClient.DeleteAllBackends(&DeleteAllInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ResetVersion(&DeleteAllInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
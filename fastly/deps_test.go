@@ -0,0 +1,32 @@
+package fastly
+
+import (
+	"go/build"
+	"strings"
+	"testing"
+)
+
+// coreDependencies are the only non-standard packages the fastly package may
+// import. Optional subsystems with heavier dependencies belong in their own
+// packages, so users who only need the API client do not pull them in.
+var coreDependencies = map[string]bool{
+	"github.com/ajg/form":               true,
+	"github.com/google/jsonapi":         true,
+	"github.com/hashicorp/go-cleanhttp": true,
+	"github.com/mitchellh/mapstructure": true,
+}
+
+func TestDependencies(t *testing.T) {
+	pkg, err := build.ImportDir(".", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, imp := range pkg.Imports {
+		if !strings.Contains(strings.SplitN(imp, "/", 2)[0], ".") {
+			continue
+		}
+		if !coreDependencies[imp] {
+			t.Errorf("fastly must not import %q; move the code using it to another package", imp)
+		}
+	}
+}
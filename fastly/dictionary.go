@@ -2,7 +2,9 @@ package fastly
 
 import (
 	"fmt"
+	"net/url"
 	"sort"
+	"time"
 )
 
 // Dictionary represents a dictionary response from the Fastly API.
@@ -13,6 +15,9 @@ type Dictionary struct {
 	ID      string `mapstructure:"id"`
 	Name    string `mapstructure:"name"`
 	Address string `mapstructure:"address"`
+
+	// WriteOnly dictionaries hide the values of their items from the API.
+	WriteOnly bool `mapstructure:"write_only"`
 }
 
 // dictionariesByName is a sortable list of dictionaries.
@@ -66,6 +71,10 @@ type CreateDictionaryInput struct {
 	Version int
 
 	Name string `form:"name,omitempty"`
+
+	// WriteOnly hides the values of the dictionary's items from the API, so
+	// they can only be read by VCL. Optional.
+	WriteOnly *Compatibool `form:"write_only,omitempty"`
 }
 
 // CreateDictionary creates a new Fastly dictionary.
@@ -116,7 +125,7 @@ func (c *Client) GetDictionary(i *GetDictionaryInput) (*Dictionary, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/dictionary/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/dictionary/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Get(path, nil)
 	if err != nil {
 		return nil, err
@@ -139,7 +148,8 @@ type UpdateDictionaryInput struct {
 	// Name is the name of the dictionary to update.
 	Name string
 
-	NewName string `form:"name,omitempty"`
+	NewName   string       `form:"name,omitempty"`
+	WriteOnly *Compatibool `form:"write_only,omitempty"`
 }
 
 // UpdateDictionary updates a specific dictionary.
@@ -156,7 +166,7 @@ func (c *Client) UpdateDictionary(i *UpdateDictionaryInput) (*Dictionary, error)
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/dictionary/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/dictionary/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
 		return nil, err
@@ -169,6 +179,54 @@ func (c *Client) UpdateDictionary(i *UpdateDictionaryInput) (*Dictionary, error)
 	return b, nil
 }
 
+// DictionaryInfo is a summary of the items of a dictionary. Digest changes
+// whenever the items do, so changes to a write-only dictionary, whose values
+// cannot be read, can still be detected.
+type DictionaryInfo struct {
+	ItemCount   int        `mapstructure:"item_count"`
+	Digest      string     `mapstructure:"digest"`
+	LastUpdated *time.Time `mapstructure:"last_updated"`
+}
+
+// GetDictionaryInfoInput is used as input to the GetDictionaryInfo function.
+type GetDictionaryInfoInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// ID is the ID of the dictionary (required).
+	ID string
+}
+
+// GetDictionaryInfo returns the number of items in a dictionary and a digest
+// of their contents.
+func (c *Client) GetDictionaryInfo(i *GetDictionaryInfoInput) (*DictionaryInfo, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/dictionary/%s/info", i.Service, i.Version, i.ID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var info *DictionaryInfo
+	if err := decodeJSON(&info, resp.Body); err != nil {
+		return nil, err
+	}
+	return info, nil
+}
+
 // DeleteDictionaryInput is the input parameter to DeleteDictionary.
 type DeleteDictionaryInput struct {
 	// Service is the ID of the service. Version is the specific configuration
@@ -194,7 +252,7 @@ func (c *Client) DeleteDictionary(i *DeleteDictionaryInput) error {
 		return ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/dictionary/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/dictionary/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Delete(path, nil)
 	if err != nil {
 		return err
@@ -1,8 +1,16 @@
 package fastly
 
 import (
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/url"
 	"sort"
+
+	"github.com/mitchellh/mapstructure"
 )
 
 // DictionaryItem represents a dictionary item response from the Fastly API.
@@ -31,6 +39,18 @@ type ListDictionaryItemsInput struct {
 
 	// Dictionary is the ID of the dictionary to retrieve items for (required).
 	Dictionary string
+
+	// Page and PerPage select a single page of results. When neither is set,
+	// the API returns every item in one response, which can time out for
+	// large dictionaries.
+	Page    int
+	PerPage int
+
+	// Sort is the field to sort by on the server, and Direction is
+	// DirectionAscend or DirectionDescend. When Sort is not set, items are
+	// sorted by key locally.
+	Sort      string
+	Direction string
 }
 
 // ListDictionaryItems returns the list of dictionary items for the
@@ -45,7 +65,9 @@ func (c *Client) ListDictionaryItems(i *ListDictionaryItemsInput) ([]*Dictionary
 	}
 
 	path := fmt.Sprintf("/service/%s/dictionary/%s/items", i.Service, i.Dictionary)
-	resp, err := c.Get(path, nil)
+	resp, err := c.Get(path, &RequestOptions{
+		Params: paginationParams(i.Page, i.PerPage, i.Sort, i.Direction),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -54,10 +76,40 @@ func (c *Client) ListDictionaryItems(i *ListDictionaryItemsInput) ([]*Dictionary
 	if err := decodeJSON(&bs, resp.Body); err != nil {
 		return nil, err
 	}
-	sort.Stable(dictionaryItemsByKey(bs))
+	if i.Sort == "" {
+		sort.Stable(dictionaryItemsByKey(bs))
+	}
 	return bs, nil
 }
 
+// ListAllDictionaryItems returns every item in the dictionary, fetching one
+// page at a time. The Page field of the input is ignored; PerPage defaults to
+// ListAllPerPage.
+func (c *Client) ListAllDictionaryItems(i *ListDictionaryItemsInput) ([]*DictionaryItem, error) {
+	in := *i
+	if in.PerPage == 0 {
+		in.PerPage = ListAllPerPage
+	}
+
+	var all []*DictionaryItem
+	for in.Page = 1; ; in.Page++ {
+		bs, err := c.ListDictionaryItems(&in)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, bs...)
+
+		if len(bs) < in.PerPage {
+			break
+		}
+	}
+
+	if in.Sort == "" {
+		sort.Stable(dictionaryItemsByKey(all))
+	}
+	return all, nil
+}
+
 // CreateDictionaryItemInput is used as input to the CreateDictionaryItem function.
 type CreateDictionaryItemInput struct {
 	// Service is the ID of the service. Dictionary is the ID of the dictionary.
@@ -117,19 +169,90 @@ func (c *Client) GetDictionaryItem(i *GetDictionaryItemInput) (*DictionaryItem,
 		return nil, ErrMissingItemKey
 	}
 
-	path := fmt.Sprintf("/service/%s/dictionary/%s/item/%s", i.Service, i.Dictionary, i.ItemKey)
+	path := fmt.Sprintf("/service/%s/dictionary/%s/item/%s", i.Service, i.Dictionary, url.PathEscape(i.ItemKey))
 	resp, err := c.Get(path, nil)
 	if err != nil {
 		return nil, err
 	}
 
+	// The items of write-only dictionaries are returned without a value.
+	var raw map[string]interface{}
+	if err := decodeJSON(&raw, resp.Body); err != nil {
+		return nil, err
+	}
+	if raw["item_value"] == nil {
+		return nil, ErrWriteOnlyDictionary
+	}
+
 	var b *DictionaryItem
-	if err := decodeJSON(&b, resp.Body); err != nil {
+	if err := mapstructure.WeakDecode(raw, &b); err != nil {
 		return nil, err
 	}
 	return b, nil
 }
 
+// VerifyDictionaryItemExistsInput is used as input to the
+// VerifyDictionaryItemExists function.
+type VerifyDictionaryItemExistsInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Dictionary is the ID of the dictionary. All three fields are
+	// required.
+	Service    string
+	Version    int
+	Dictionary string
+
+	// ItemKey is the name of the dictionary item to check (required).
+	ItemKey string
+}
+
+// VerifyDictionaryItemExists reports whether a dictionary has an item with the
+// given key, without reading its value, so it also works for write-only
+// dictionaries. The dictionary's info is read first, so a dictionary which
+// does not exist is an error and an empty one is answered without looking
+// the item up.
+func (c *Client) VerifyDictionaryItemExists(i *VerifyDictionaryItemExistsInput) (bool, error) {
+	if i.Service == "" {
+		return false, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return false, ErrMissingVersion
+	}
+
+	if i.Dictionary == "" {
+		return false, ErrMissingDictionary
+	}
+
+	if i.ItemKey == "" {
+		return false, ErrMissingItemKey
+	}
+
+	info, err := c.GetDictionaryInfo(&GetDictionaryInfoInput{
+		Service: i.Service,
+		Version: i.Version,
+		ID:      i.Dictionary,
+	})
+	if err != nil {
+		return false, err
+	}
+	if info.ItemCount == 0 {
+		return false, nil
+	}
+
+	_, err = c.GetDictionaryItem(&GetDictionaryItemInput{
+		Service:    i.Service,
+		Dictionary: i.Dictionary,
+		ItemKey:    i.ItemKey,
+	})
+	switch {
+	case err == nil, err == ErrWriteOnlyDictionary:
+		return true, nil
+	case isNotFound(err):
+		return false, nil
+	}
+	return false, err
+}
+
 // UpdateDictionaryItemInput is used as input to the UpdateDictionaryItem function.
 type UpdateDictionaryItemInput struct {
 	// Service is the ID of the service. Dictionary is the ID of the dictionary.
@@ -157,7 +280,7 @@ func (c *Client) UpdateDictionaryItem(i *UpdateDictionaryItemInput) (*Dictionary
 		return nil, ErrMissingItemKey
 	}
 
-	path := fmt.Sprintf("/service/%s/dictionary/%s/item/%s", i.Service, i.Dictionary, i.ItemKey)
+	path := fmt.Sprintf("/service/%s/dictionary/%s/item/%s", i.Service, i.Dictionary, url.PathEscape(i.ItemKey))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
 		return nil, err
@@ -195,7 +318,7 @@ func (c *Client) DeleteDictionaryItem(i *DeleteDictionaryItemInput) error {
 		return ErrMissingItemKey
 	}
 
-	path := fmt.Sprintf("/service/%s/dictionary/%s/item/%s", i.Service, i.Dictionary, i.ItemKey)
+	path := fmt.Sprintf("/service/%s/dictionary/%s/item/%s", i.Service, i.Dictionary, url.PathEscape(i.ItemKey))
 	resp, err := c.Delete(path, nil)
 	if err != nil {
 		return err
@@ -206,3 +329,321 @@ func (c *Client) DeleteDictionaryItem(i *DeleteDictionaryItemInput) error {
 	// response - it just returns a 200 OK.
 	return nil
 }
+
+// DictionaryFormat is a file format used to import and export dictionaries.
+type DictionaryFormat string
+
+const (
+	// DictionaryFormatCSV is one "key,value" record per line.
+	DictionaryFormatCSV DictionaryFormat = "csv"
+
+	// DictionaryFormatJSON is a single JSON object of string keys to string
+	// values.
+	DictionaryFormatJSON DictionaryFormat = "json"
+)
+
+// BatchDictionaryItem is a single operation in a BatchModifyDictionaryItems
+// request.
+type BatchDictionaryItem struct {
+	Operation BatchOperation `json:"op"`
+	ItemKey   string         `json:"item_key"`
+	ItemValue string         `json:"item_value,omitempty"`
+}
+
+// BatchModifyDictionaryItemsInput is used as input to the
+// BatchModifyDictionaryItems function.
+type BatchModifyDictionaryItemsInput struct {
+	// Service is the ID of the service. Dictionary is the ID of the dictionary.
+	// Both fields are required.
+	Service    string `json:"-"`
+	Dictionary string `json:"-"`
+
+	Items []*BatchDictionaryItem `json:"items"`
+}
+
+// BatchModifyDictionaryItems creates, updates, and deletes dictionary items in
+// a single request. At most BatchModifyMaximumOperations items may be given.
+func (c *Client) BatchModifyDictionaryItems(i *BatchModifyDictionaryItemsInput) error {
+	if i.Service == "" {
+		return ErrMissingService
+	}
+
+	if i.Dictionary == "" {
+		return ErrMissingDictionary
+	}
+
+	if len(i.Items) > BatchModifyMaximumOperations {
+		return ErrBatchModifyMaximumOperationsExceeded
+	}
+
+	path := fmt.Sprintf("/service/%s/dictionary/%s/items", i.Service, i.Dictionary)
+	body := appendBatchDictionaryItems(make([]byte, 0, batchDictionaryItemsSize(i.Items)), i.Items)
+	resp, err := c.requestJSONBody("PATCH", path, body)
+	if err != nil {
+		return err
+	}
+
+	var r *statusResp
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return err
+	}
+	if !r.Ok() {
+		return fmt.Errorf("Not Ok")
+	}
+	return nil
+}
+
+// ImportDictionaryItemsInput is used as input to the ImportDictionaryItems
+// function.
+type ImportDictionaryItemsInput struct {
+	// Service is the ID of the service. Dictionary is the ID of the dictionary.
+	// Both fields are required.
+	Service    string
+	Dictionary string
+
+	// Source is read for the items to import, in the given Format (required).
+	Source io.Reader
+	Format DictionaryFormat
+
+	// Prune deletes existing items whose keys are not present in Source, so the
+	// dictionary exactly mirrors it.
+	Prune bool
+
+	// State records the digests of the last import, and is updated after
+	// each one. When neither Source nor the dictionary has changed since,
+	// nothing is listed or sent. Optional; Version is required with it.
+	State   *DictionaryImportState
+	Version int
+}
+
+// DictionaryImportState is the state ImportDictionaryItems keeps between
+// imports of the same dictionary.
+type DictionaryImportState struct {
+	// SourceDigest is a digest of the items imported.
+	SourceDigest string
+
+	// Digest is the Digest of the dictionary's info after the import.
+	Digest string
+}
+
+// ImportDictionaryItems loads items from the given source and applies only the
+// differences to the dictionary, using the batch API. Keys that are new or
+// whose value changed are upserted. The operations that were applied are
+// returned, sorted by key. The values of write-only dictionaries cannot be
+// read, so every item of Source is upserted into them unless State shows
+// that nothing has changed since the last import.
+func (c *Client) ImportDictionaryItems(i *ImportDictionaryItemsInput) ([]*BatchDictionaryItem, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Dictionary == "" {
+		return nil, ErrMissingDictionary
+	}
+
+	if i.Source == nil {
+		return nil, ErrMissingSource
+	}
+
+	if i.State != nil && i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	want, err := readDictionaryItems(i.Source, i.Format)
+	if err != nil {
+		return nil, err
+	}
+
+	var sourceDigest string
+	if i.State != nil {
+		sourceDigest = dictionaryItemsDigest(want)
+		info, err := c.GetDictionaryInfo(&GetDictionaryInfoInput{
+			Service: i.Service,
+			Version: i.Version,
+			ID:      i.Dictionary,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if i.State.SourceDigest == sourceDigest && i.State.Digest == info.Digest {
+			return nil, nil
+		}
+	}
+
+	bs, err := c.ListAllDictionaryItems(&ListDictionaryItemsInput{
+		Service:    i.Service,
+		Dictionary: i.Dictionary,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	have := make(map[string]string, len(bs))
+	for _, b := range bs {
+		have[b.ItemKey] = b.ItemValue
+	}
+
+	var ops []*BatchDictionaryItem
+	for k, v := range want {
+		if old, ok := have[k]; ok && old == v {
+			continue
+		}
+		ops = append(ops, &BatchDictionaryItem{
+			Operation: BatchOperationUpsert,
+			ItemKey:   k,
+			ItemValue: v,
+		})
+	}
+
+	if i.Prune {
+		for k := range have {
+			if _, ok := want[k]; ok {
+				continue
+			}
+			ops = append(ops, &BatchDictionaryItem{
+				Operation: BatchOperationDelete,
+				ItemKey:   k,
+			})
+		}
+	}
+
+	sort.Slice(ops, func(a, b int) bool {
+		return ops[a].ItemKey < ops[b].ItemKey
+	})
+
+	for start := 0; start < len(ops); start += BatchModifyMaximumOperations {
+		end := start + BatchModifyMaximumOperations
+		if end > len(ops) {
+			end = len(ops)
+		}
+
+		if err := c.BatchModifyDictionaryItems(&BatchModifyDictionaryItemsInput{
+			Service:    i.Service,
+			Dictionary: i.Dictionary,
+			Items:      ops[start:end],
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	if i.State != nil {
+		info, err := c.GetDictionaryInfo(&GetDictionaryInfoInput{
+			Service: i.Service,
+			Version: i.Version,
+			ID:      i.Dictionary,
+		})
+		if err != nil {
+			return nil, err
+		}
+		*i.State = DictionaryImportState{SourceDigest: sourceDigest, Digest: info.Digest}
+	}
+
+	return ops, nil
+}
+
+// dictionaryItemsDigest returns a digest of the keys and values of items.
+func dictionaryItemsDigest(items map[string]string) string {
+	keys := make([]string, 0, len(items))
+	for k := range items {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	h := sha256.New()
+	for _, k := range keys {
+		fmt.Fprintf(h, "%s\x00%s\x00", k, items[k])
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// ExportDictionaryItemsInput is used as input to the ExportDictionaryItems
+// function.
+type ExportDictionaryItemsInput struct {
+	// Service is the ID of the service. Dictionary is the ID of the dictionary.
+	// Both fields are required.
+	Service    string
+	Dictionary string
+
+	// Destination is where the items are written, in the given Format
+	// (required).
+	Destination io.Writer
+	Format      DictionaryFormat
+}
+
+// ExportDictionaryItems writes every item in the dictionary to the given
+// destination, sorted by key.
+func (c *Client) ExportDictionaryItems(i *ExportDictionaryItemsInput) error {
+	if i.Service == "" {
+		return ErrMissingService
+	}
+
+	if i.Dictionary == "" {
+		return ErrMissingDictionary
+	}
+
+	if i.Destination == nil {
+		return ErrMissingDestination
+	}
+
+	if i.Format != DictionaryFormatCSV && i.Format != DictionaryFormatJSON {
+		return fmt.Errorf("Unknown dictionary format %q", i.Format)
+	}
+
+	bs, err := c.ListAllDictionaryItems(&ListDictionaryItemsInput{
+		Service:    i.Service,
+		Dictionary: i.Dictionary,
+	})
+	if err != nil {
+		return err
+	}
+
+	if i.Format == DictionaryFormatJSON {
+		m := make(map[string]string, len(bs))
+		for _, b := range bs {
+			m[b.ItemKey] = b.ItemValue
+		}
+
+		enc := json.NewEncoder(i.Destination)
+		enc.SetIndent("", "  ")
+		return enc.Encode(m)
+	}
+
+	w := csv.NewWriter(i.Destination)
+	for _, b := range bs {
+		if err := w.Write([]string{b.ItemKey, b.ItemValue}); err != nil {
+			return err
+		}
+	}
+	w.Flush()
+	return w.Error()
+}
+
+// readDictionaryItems reads a map of keys to values in the given format.
+func readDictionaryItems(r io.Reader, f DictionaryFormat) (map[string]string, error) {
+	switch f {
+	case DictionaryFormatJSON:
+		var m map[string]string
+		if err := json.NewDecoder(r).Decode(&m); err != nil {
+			return nil, err
+		}
+		return m, nil
+	case DictionaryFormatCSV:
+		cr := csv.NewReader(r)
+		cr.FieldsPerRecord = 2
+
+		m := make(map[string]string)
+		for {
+			record, err := cr.Read()
+			if err == io.EOF {
+				break
+			}
+			if err != nil {
+				return nil, err
+			}
+			m[record[0]] = record[1]
+		}
+		return m, nil
+	default:
+		return nil, fmt.Errorf("Unknown dictionary format %q", f)
+	}
+}
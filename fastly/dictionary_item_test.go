@@ -1,6 +1,14 @@
 package fastly
 
-import "testing"
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
 
 func createTestDictionary(t *testing.T) *Dictionary {
 	t.Parallel()
@@ -140,6 +148,29 @@ func TestClient_DictionaryItems(t *testing.T) {
 	}
 }
 
+func TestClient_ListAllDictionaryItems(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var bs []*DictionaryItem
+	record(t, "dictionary_items/list_all", func(c *Client) {
+		bs, err = c.ListAllDictionaryItems(&ListDictionaryItemsInput{
+			Service:    testServiceID,
+			Dictionary: "5clCytcTJNrKqDtdfSbJyS",
+			PerPage:    2,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bs) != 3 {
+		t.Fatalf("bad items: %v", bs)
+	}
+	if bs[2].ItemKey != "legacy" {
+		t.Errorf("bad item_key: %q", bs[2].ItemKey)
+	}
+}
+
 func TestClient_ListDictionaryItems_validation(t *testing.T) {
 	var err error
 	_, err = testClient.ListDictionaryItems(&ListDictionaryItemsInput{
@@ -256,3 +287,350 @@ func TestClient_DeleteDictionaryItem_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_ImportDictionaryItems(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var ops []*BatchDictionaryItem
+	record(t, "dictionary_items/import", func(c *Client) {
+		ops, err = c.ImportDictionaryItems(&ImportDictionaryItemsInput{
+			Service:    testServiceID,
+			Dictionary: "5clCytcTJNrKqDtdfSbJyS",
+			Source:     strings.NewReader("feature-a,on\nfeature-b,on\nfeature-c,off\n"),
+			Format:     DictionaryFormatCSV,
+			Prune:      true,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if len(ops) != 3 {
+		t.Fatalf("bad ops: %v", ops)
+	}
+	if ops[0].Operation != BatchOperationUpsert || ops[0].ItemKey != "feature-b" || ops[0].ItemValue != "on" {
+		t.Errorf("bad upsert: %#v", ops[0])
+	}
+	if ops[1].Operation != BatchOperationUpsert || ops[1].ItemKey != "feature-c" {
+		t.Errorf("bad upsert: %#v", ops[1])
+	}
+	if ops[2].Operation != BatchOperationDelete || ops[2].ItemKey != "legacy" {
+		t.Errorf("bad delete: %#v", ops[2])
+	}
+}
+
+func TestClient_ExportDictionaryItems(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var buf bytes.Buffer
+	record(t, "dictionary_items/export", func(c *Client) {
+		err = c.ExportDictionaryItems(&ExportDictionaryItemsInput{
+			Service:     testServiceID,
+			Dictionary:  "5clCytcTJNrKqDtdfSbJyS",
+			Destination: &buf,
+			Format:      DictionaryFormatCSV,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != "feature-a,on\nfeature-b,off\nlegacy,1\n" {
+		t.Errorf("bad csv: %q", buf.String())
+	}
+
+	buf.Reset()
+	record(t, "dictionary_items/export", func(c *Client) {
+		err = c.ExportDictionaryItems(&ExportDictionaryItemsInput{
+			Service:     testServiceID,
+			Dictionary:  "5clCytcTJNrKqDtdfSbJyS",
+			Destination: &buf,
+			Format:      DictionaryFormatJSON,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "{\n  \"feature-a\": \"on\",\n  \"feature-b\": \"off\",\n  \"legacy\": \"1\"\n}\n"
+	if buf.String() != expected {
+		t.Errorf("bad json: %q", buf.String())
+	}
+}
+
+func TestClient_ImportDictionaryItems_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ImportDictionaryItems(&ImportDictionaryItemsInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ImportDictionaryItems(&ImportDictionaryItemsInput{
+		Service:    "foo",
+		Dictionary: "",
+	})
+	if err != ErrMissingDictionary {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ImportDictionaryItems(&ImportDictionaryItemsInput{
+		Service:    "foo",
+		Dictionary: "bar",
+	})
+	if err != ErrMissingSource {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ImportDictionaryItems(&ImportDictionaryItemsInput{
+		Service:    "foo",
+		Dictionary: "bar",
+		Source:     strings.NewReader("{}"),
+		Format:     "yaml",
+	})
+	if err == nil {
+		t.Error("expected error for unknown format")
+	}
+
+	_, err = testClient.ImportDictionaryItems(&ImportDictionaryItemsInput{
+		Service:    "foo",
+		Dictionary: "bar",
+		Source:     strings.NewReader("{}"),
+		State:      &DictionaryImportState{},
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_ImportDictionaryItems_state(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	digest := "d1"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		switch {
+		case r.URL.Path == "/service/foo/version/1/dictionary/bar/info":
+			w.Write([]byte(`{"item_count":1,"digest":"` + digest + `"}`))
+		case r.Method == "GET":
+			// The values of write-only dictionaries are not returned.
+			w.Write([]byte(`[{"item_key":"api-key","item_value":null}]`))
+		case r.Method == "PATCH":
+			digest = "d2"
+			w.Write([]byte(`{"status":"ok"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	state := &DictionaryImportState{}
+	importItems := func() []*BatchDictionaryItem {
+		ops, err := c.ImportDictionaryItems(&ImportDictionaryItemsInput{
+			Service:    "foo",
+			Version:    1,
+			Dictionary: "bar",
+			Source:     strings.NewReader(`{"api-key":"secret"}`),
+			Format:     DictionaryFormatJSON,
+			State:      state,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return ops
+	}
+
+	if ops := importItems(); len(ops) != 1 || ops[0].ItemKey != "api-key" {
+		t.Errorf("bad ops: %v", ops)
+	}
+	if state.Digest != "d2" || state.SourceDigest == "" {
+		t.Errorf("bad state: %#v", state)
+	}
+
+	mu.Lock()
+	requests = nil
+	mu.Unlock()
+	if ops := importItems(); len(ops) != 0 {
+		t.Errorf("expected an unchanged import to do nothing, got %v", ops)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if expected := []string{"GET /service/foo/version/1/dictionary/bar/info"}; !reflect.DeepEqual(requests, expected) {
+		t.Errorf("bad requests: %q", requests)
+	}
+}
+
+func TestClient_ExportDictionaryItems_validation(t *testing.T) {
+	var err error
+	err = testClient.ExportDictionaryItems(&ExportDictionaryItemsInput{
+		Service:    "foo",
+		Dictionary: "bar",
+	})
+	if err != ErrMissingDestination {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_BatchModifyDictionaryItems_validation(t *testing.T) {
+	var err error
+	err = testClient.BatchModifyDictionaryItems(&BatchModifyDictionaryItemsInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.BatchModifyDictionaryItems(&BatchModifyDictionaryItemsInput{
+		Service:    "foo",
+		Dictionary: "",
+	})
+	if err != ErrMissingDictionary {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.BatchModifyDictionaryItems(&BatchModifyDictionaryItemsInput{
+		Service:    "foo",
+		Dictionary: "bar",
+		Items:      make([]*BatchDictionaryItem, BatchModifyMaximumOperations+1),
+	})
+	if err != ErrBatchModifyMaximumOperationsExceeded {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_DictionaryItems_writeOnly(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var exists, missing bool
+	var info *DictionaryInfo
+	record(t, "dictionary_items/write_only", func(c *Client) {
+		_, err = c.GetDictionaryItem(&GetDictionaryItemInput{
+			Service:    testServiceID,
+			Dictionary: "5clCytcTJrnvPi0wJi7Yxh",
+			ItemKey:    "api-key",
+		})
+		if err != ErrWriteOnlyDictionary {
+			return
+		}
+
+		if exists, err = c.VerifyDictionaryItemExists(&VerifyDictionaryItemExistsInput{
+			Service:    testServiceID,
+			Version:    1,
+			Dictionary: "5clCytcTJrnvPi0wJi7Yxh",
+			ItemKey:    "api-key",
+		}); err != nil {
+			return
+		}
+		if missing, err = c.VerifyDictionaryItemExists(&VerifyDictionaryItemExistsInput{
+			Service:    testServiceID,
+			Version:    1,
+			Dictionary: "5clCytcTJrnvPi0wJi7Yxh",
+			ItemKey:    "missing",
+		}); err != nil {
+			return
+		}
+
+		info, err = c.GetDictionaryInfo(&GetDictionaryInfoInput{
+			Service: testServiceID,
+			Version: 1,
+			ID:      "5clCytcTJrnvPi0wJi7Yxh",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exists {
+		t.Error("expected api-key to exist")
+	}
+	if missing {
+		t.Error("expected missing not to exist")
+	}
+	if info.ItemCount != 1 || info.Digest != "2c3e7c5f1c4e8a" || info.LastUpdated == nil {
+		t.Errorf("bad info: %#v", info)
+	}
+}
+
+func TestClient_VerifyDictionaryItemExists_empty(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		requests = append(requests, r.Method+" "+r.URL.Path)
+		w.Write([]byte(`{"item_count":0,"digest":"empty"}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	exists, err := c.VerifyDictionaryItemExists(&VerifyDictionaryItemExistsInput{
+		Service:    "foo",
+		Version:    1,
+		Dictionary: "bar",
+		ItemKey:    "api-key",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exists {
+		t.Error("expected no item in an empty dictionary")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if expected := []string{"GET /service/foo/version/1/dictionary/bar/info"}; !reflect.DeepEqual(requests, expected) {
+		t.Errorf("bad requests: %q", requests)
+	}
+}
+
+func TestClient_VerifyDictionaryItemExists_validation(t *testing.T) {
+	var err error
+	_, err = testClient.VerifyDictionaryItemExists(&VerifyDictionaryItemExistsInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.VerifyDictionaryItemExists(&VerifyDictionaryItemExistsInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.VerifyDictionaryItemExists(&VerifyDictionaryItemExistsInput{
+		Service:    "foo",
+		Version:    1,
+		Dictionary: "",
+	})
+	if err != ErrMissingDictionary {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.VerifyDictionaryItemExists(&VerifyDictionaryItemExistsInput{
+		Service:    "foo",
+		Version:    1,
+		Dictionary: "bar",
+		ItemKey:    "",
+	})
+	if err != ErrMissingItemKey {
+		t.Errorf("bad error: %s", err)
+	}
+}
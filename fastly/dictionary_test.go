@@ -222,3 +222,21 @@ func TestClient_DeleteDictionary_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_GetDictionaryInfo_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetDictionaryInfo(&GetDictionaryInfoInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetDictionaryInfo(&GetDictionaryInfoInput{
+		Service: "foo",
+		Version: 1,
+	})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -2,6 +2,8 @@ package fastly
 
 import (
 	"fmt"
+	"net/url"
+	"sort"
 	"time"
 )
 
@@ -52,7 +54,7 @@ func (c *Client) CreateDirectorBackend(i *CreateDirectorBackendInput) (*Director
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/director/%s/backend/%s",
-		i.Service, i.Version, i.Director, i.Backend)
+		i.Service, i.Version, url.PathEscape(i.Director), url.PathEscape(i.Backend))
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
 		return nil, err
@@ -98,7 +100,7 @@ func (c *Client) GetDirectorBackend(i *GetDirectorBackendInput) (*DirectorBacken
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/director/%s/backend/%s",
-		i.Service, i.Version, i.Director, i.Backend)
+		i.Service, i.Version, url.PathEscape(i.Director), url.PathEscape(i.Backend))
 	resp, err := c.Get(path, nil)
 	if err != nil {
 		return nil, err
@@ -144,7 +146,7 @@ func (c *Client) DeleteDirectorBackend(i *DeleteDirectorBackendInput) error {
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/director/%s/backend/%s",
-		i.Service, i.Version, i.Director, i.Backend)
+		i.Service, i.Version, url.PathEscape(i.Director), url.PathEscape(i.Backend))
 	resp, err := c.Delete(path, nil)
 	if err != nil {
 		return err
@@ -159,3 +161,102 @@ func (c *Client) DeleteDirectorBackend(i *DeleteDirectorBackendInput) error {
 	}
 	return nil
 }
+
+// SetDirectorBackendsInput is used as input to the SetDirectorBackends
+// function.
+type SetDirectorBackendsInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Director is the name of the director (required).
+	Director string
+
+	// Backends are the names of the backends the director should have. An
+	// empty list removes every backend from the director.
+	Backends []string
+}
+
+// DirectorBackendsChange is the result of SetDirectorBackends.
+type DirectorBackendsChange struct {
+	// Added and Removed are the backends linked to and unlinked from the
+	// director, sorted by name.
+	Added   []string
+	Removed []string
+}
+
+// SetDirectorBackends makes the backends of a director exactly the given
+// list. Only the differences from the current membership are sent, so
+// backends which stay in the director are not unlinked and relinked. New
+// backends are added before old ones are removed, so the director never
+// serves from fewer backends than necessary while it converges.
+func (c *Client) SetDirectorBackends(i *SetDirectorBackendsInput) (*DirectorBackendsChange, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Director == "" {
+		return nil, ErrMissingDirector
+	}
+
+	d, err := c.GetDirector(&GetDirectorInput{
+		Service: i.Service,
+		Version: i.Version,
+		Name:    i.Director,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	current := make(map[string]bool, len(d.Backends))
+	for _, b := range d.Backends {
+		current[b] = true
+	}
+	wanted := make(map[string]bool, len(i.Backends))
+	for _, b := range i.Backends {
+		wanted[b] = true
+	}
+
+	change := &DirectorBackendsChange{}
+	for b := range wanted {
+		if !current[b] {
+			change.Added = append(change.Added, b)
+		}
+	}
+	for b := range current {
+		if !wanted[b] {
+			change.Removed = append(change.Removed, b)
+		}
+	}
+	sort.Strings(change.Added)
+	sort.Strings(change.Removed)
+
+	for _, b := range change.Added {
+		_, err := c.CreateDirectorBackend(&CreateDirectorBackendInput{
+			Service:  i.Service,
+			Version:  i.Version,
+			Director: i.Director,
+			Backend:  b,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, b := range change.Removed {
+		err := c.DeleteDirectorBackend(&DeleteDirectorBackendInput{
+			Service:  i.Service,
+			Version:  i.Version,
+			Director: i.Director,
+			Backend:  b,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return change, nil
+}
@@ -1,6 +1,12 @@
 package fastly
 
-import "testing"
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+)
 
 func TestClient_DirectorBackends(t *testing.T) {
 	t.Parallel()
@@ -79,6 +85,62 @@ func TestClient_DirectorBackends(t *testing.T) {
 	}
 }
 
+func TestClient_DirectorBackends_escapedNames(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		paths = append(paths, r.Method+" "+r.URL.EscapedPath())
+		switch r.Method {
+		case "DELETE":
+			w.Write([]byte(`{"status":"ok"}`))
+		default:
+			w.Write([]byte(`{"director_name":"pool/a b","backend_name":"100%?"}`))
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.CreateDirectorBackend(&CreateDirectorBackendInput{
+		Service:  "foo",
+		Version:  1,
+		Director: "pool/a b",
+		Backend:  "100%?",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.GetDirectorBackend(&GetDirectorBackendInput{
+		Service:  "foo",
+		Version:  1,
+		Director: "pool/a b",
+		Backend:  "100%?",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.DeleteDirectorBackend(&DeleteDirectorBackendInput{
+		Service:  "foo",
+		Version:  1,
+		Director: "pool/a b",
+		Backend:  "100%?",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	const path = "/service/foo/version/1/director/pool%2Fa%20b/backend/100%25%3F"
+	expected := []string{"POST " + path, "GET " + path, "DELETE " + path}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("bad paths: %q", paths)
+	}
+}
+
 func TestClient_CreateDirectorBackend_validation(t *testing.T) {
 	var err error
 	_, err = testClient.CreateDirectorBackend(&CreateDirectorBackendInput{
@@ -170,3 +232,54 @@ func TestClient_DeleteDirectorBackend_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_SetDirectorBackends(t *testing.T) {
+	t.Parallel()
+
+	var change *DirectorBackendsChange
+	var err error
+	record(t, "director_backends/set", func(c *Client) {
+		change, err = c.SetDirectorBackends(&SetDirectorBackendsInput{
+			Service:  testServiceID,
+			Version:  8,
+			Director: "pool",
+			Backends: []string{"origin-d", "origin-b"},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(change.Added, []string{"origin-d"}) {
+		t.Errorf("bad added: %v", change.Added)
+	}
+	if !reflect.DeepEqual(change.Removed, []string{"origin-a", "origin-c"}) {
+		t.Errorf("bad removed: %v", change.Removed)
+	}
+}
+
+func TestClient_SetDirectorBackends_validation(t *testing.T) {
+	var err error
+	_, err = testClient.SetDirectorBackends(&SetDirectorBackendsInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.SetDirectorBackends(&SetDirectorBackendsInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.SetDirectorBackends(&SetDirectorBackendsInput{
+		Service:  "foo",
+		Version:  1,
+		Director: "",
+	})
+	if err != ErrMissingDirector {
+		t.Errorf("bad error: %s", err)
+	}
+}
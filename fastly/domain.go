@@ -2,7 +2,9 @@ package fastly
 
 import (
 	"fmt"
+	"net/url"
 	"sort"
+	"strings"
 )
 
 // Domain represents the the domain name Fastly will serve content for.
@@ -119,7 +121,7 @@ func (c *Client) GetDomain(i *GetDomainInput) (*Domain, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/domain/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/domain/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Get(path, nil)
 	if err != nil {
 		return nil, err
@@ -164,7 +166,7 @@ func (c *Client) UpdateDomain(i *UpdateDomainInput) (*Domain, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/domain/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/domain/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
 		return nil, err
@@ -202,10 +204,116 @@ func (c *Client) DeleteDomain(i *DeleteDomainInput) error {
 		return ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/domain/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/domain/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	_, err := c.Delete(path, nil)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+// ServiceDomain is a domain of a service's active version, as returned by
+// ListAllServiceDomains.
+type ServiceDomain struct {
+	Domain      string
+	ServiceID   string
+	ServiceName string
+	Version     int
+}
+
+// serviceDomainsByName is a sortable list of service domains.
+type serviceDomainsByName []*ServiceDomain
+
+// Len, Swap, and Less implement the sortable interface.
+func (s serviceDomainsByName) Len() int      { return len(s) }
+func (s serviceDomainsByName) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s serviceDomainsByName) Less(i, j int) bool {
+	if s[i].Domain != s[j].Domain {
+		return s[i].Domain < s[j].Domain
+	}
+	return s[i].ServiceID < s[j].ServiceID
+}
+
+// ListAllServiceDomainsInput is used as input to the ListAllServiceDomains
+// function.
+type ListAllServiceDomainsInput struct {
+	// Parallelism is the maximum number of services queried at once. The
+	// default is DefaultFleetParallelism.
+	Parallelism int
+}
+
+// ListAllServiceDomains returns the domains of the active version of every
+// service in the account, sorted by domain and then service ID. Services
+// without an active version are skipped. The services are queried
+// concurrently, and if any of the list calls fails, the first error is
+// returned.
+func (c *Client) ListAllServiceDomains(i *ListAllServiceDomainsInput) ([]*ServiceDomain, error) {
+	parallelism := i.Parallelism
+	if parallelism <= 0 {
+		parallelism = DefaultFleetParallelism
+	}
+
+	services, err := c.ListServices(&ListServicesInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	var active []*Service
+	for _, s := range services {
+		if s.ActiveVersion != 0 {
+			active = append(active, s)
+		}
+	}
+
+	domains := make([][]*Domain, len(active))
+	errs := make([]error, len(active))
+	RunBounded(parallelism, len(active), func(n int) {
+		domains[n], errs[n] = c.ListDomains(&ListDomainsInput{
+			Service: active[n].ID,
+			Version: int(active[n].ActiveVersion),
+		})
+	})
+
+	var all []*ServiceDomain
+	for n, s := range active {
+		if errs[n] != nil {
+			return nil, errs[n]
+		}
+		for _, d := range domains[n] {
+			all = append(all, &ServiceDomain{
+				Domain:      d.Name,
+				ServiceID:   s.ID,
+				ServiceName: s.Name,
+				Version:     d.Version,
+			})
+		}
+	}
+
+	sort.Stable(serviceDomainsByName(all))
+	return all, nil
+}
+
+// FindServiceDomain returns the entry of a list from ListAllServiceDomains
+// which serves the given hostname, or nil if there is none. An exact domain
+// is preferred over a wildcard domain such as "*.example.com", which matches
+// a single label.
+func FindServiceDomain(domains []*ServiceDomain, host string) *ServiceDomain {
+	host = normalizeDNSName(host)
+
+	var wildcard string
+	if n := strings.Index(host, "."); n >= 0 {
+		wildcard = "*" + host[n:]
+	}
+
+	var match *ServiceDomain
+	for _, d := range domains {
+		name := normalizeDNSName(d.Domain)
+		if name == host {
+			return d
+		}
+		if match == nil && wildcard != "" && name == wildcard {
+			match = d
+		}
+	}
+	return match
+}
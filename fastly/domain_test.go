@@ -113,6 +113,38 @@ func TestClient_Domains(t *testing.T) {
 	}
 }
 
+func TestClient_ListAllServiceDomains(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var ds []*ServiceDomain
+	record(t, "domains/list_all", func(c *Client) {
+		ds, err = c.ListAllServiceDomains(&ListAllServiceDomainsInput{})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ds) != 3 {
+		t.Fatalf("bad domains: %d", len(ds))
+	}
+	if ds[0].Domain != "*.go-fastly.com" || ds[2].Domain != "www.go-fastly.com" {
+		t.Errorf("bad order: %q, %q", ds[0].Domain, ds[2].Domain)
+	}
+	if ds[2].ServiceName != "go-fastly-www" || ds[2].Version != 12 {
+		t.Errorf("bad service: %q, %d", ds[2].ServiceName, ds[2].Version)
+	}
+
+	if d := FindServiceDomain(ds, "WWW.go-fastly.com."); d == nil || d.ServiceID != "3x6HNbQKGwS1h3v2gPBZ9C" {
+		t.Errorf("bad exact match: %v", d)
+	}
+	if d := FindServiceDomain(ds, "api.go-fastly.com"); d == nil || d.ServiceID != testServiceID {
+		t.Errorf("bad wildcard match: %v", d)
+	}
+	if d := FindServiceDomain(ds, "a.b.go-fastly.com"); d != nil {
+		t.Errorf("bad multi-label match: %v", d)
+	}
+}
+
 func TestClient_ListDomains_validation(t *testing.T) {
 	var err error
 	_, err = testClient.ListDomains(&ListDomainsInput{
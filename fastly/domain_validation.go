@@ -0,0 +1,173 @@
+package fastly
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// DomainValidation is the result of checking a single domain's DNS. CNAME is
+// the record the domain should point at, and OK is true when it does.
+type DomainValidation struct {
+	Name  string
+	CNAME string
+	OK    bool
+}
+
+// ValidateDomainInput is used as input to the ValidateDomain function.
+type ValidateDomainInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the domain to check (required).
+	Name string
+}
+
+// ValidateDomain checks whether the DNS of a domain is set up to send its
+// traffic to Fastly.
+func (c *Client) ValidateDomain(i *ValidateDomainInput) (*DomainValidation, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/domain/%s/check", i.Service, i.Version, url.PathEscape(i.Name))
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// The check is returned as a [domain, cname, ok] tuple.
+	var tuple []interface{}
+	if err := decodeJSON(&tuple, resp.Body); err != nil {
+		return nil, err
+	}
+	if len(tuple) != 3 {
+		return nil, fmt.Errorf("Unexpected domain check response for %q", i.Name)
+	}
+
+	v := &DomainValidation{Name: i.Name}
+	v.CNAME, _ = tuple[1].(string)
+	v.OK, _ = tuple[2].(bool)
+	return v, nil
+}
+
+// DomainValidationReport summarizes the checks of every domain of a service
+// version. Each domain appears in exactly one of the lists, which are sorted
+// by name.
+type DomainValidationReport struct {
+	// Valid are the domains whose DNS points at Fastly.
+	Valid []*DomainValidation
+
+	// MisconfiguredCNAME are subdomains which do not have a CNAME to the
+	// expected record.
+	MisconfiguredCNAME []*DomainValidation
+
+	// Apex are apex domains, such as "example.com", which do not point at
+	// Fastly. An apex domain cannot have a CNAME, so it needs A records for
+	// Fastly's anycast addresses or a provider's CNAME flattening instead.
+	Apex []*DomainValidation
+
+	// Errors are the domains which could not be checked, keyed by name.
+	Errors map[string]error
+}
+
+// OK reports whether every domain was checked and points at Fastly.
+func (r *DomainValidationReport) OK() bool {
+	return len(r.MisconfiguredCNAME) == 0 && len(r.Apex) == 0 && len(r.Errors) == 0
+}
+
+// domainValidationsByName is a sortable list of domain validations.
+type domainValidationsByName []*DomainValidation
+
+// Len, Swap, and Less implement the sortable interface.
+func (s domainValidationsByName) Len() int      { return len(s) }
+func (s domainValidationsByName) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s domainValidationsByName) Less(i, j int) bool {
+	return s[i].Name < s[j].Name
+}
+
+// ValidateAllDomainsInput is used as input to the ValidateAllDomains function.
+type ValidateAllDomainsInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Parallelism is the maximum number of domains checked at once. The
+	// default is DefaultFleetParallelism.
+	Parallelism int
+}
+
+// ValidateAllDomains checks every domain of a service version concurrently
+// and returns a summary, suitable as a gate before activating the version.
+// A failed check is recorded in the report's Errors rather than returned; the
+// returned error is only set if the domains could not be listed.
+func (c *Client) ValidateAllDomains(i *ValidateAllDomainsInput) (*DomainValidationReport, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	parallelism := i.Parallelism
+	if parallelism <= 0 {
+		parallelism = DefaultFleetParallelism
+	}
+
+	ds, err := c.ListDomains(&ListDomainsInput{
+		Service: i.Service,
+		Version: i.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	checks := make([]*DomainValidation, len(ds))
+	errs := make([]error, len(ds))
+	RunBounded(parallelism, len(ds), func(n int) {
+		checks[n], errs[n] = c.ValidateDomain(&ValidateDomainInput{
+			Service: i.Service,
+			Version: i.Version,
+			Name:    ds[n].Name,
+		})
+	})
+
+	r := &DomainValidationReport{Errors: make(map[string]error)}
+	for n, d := range ds {
+		switch v := checks[n]; {
+		case errs[n] != nil:
+			r.Errors[d.Name] = errs[n]
+		case v.OK:
+			r.Valid = append(r.Valid, v)
+		case isApexDomain(v.Name):
+			r.Apex = append(r.Apex, v)
+		default:
+			r.MisconfiguredCNAME = append(r.MisconfiguredCNAME, v)
+		}
+	}
+	sort.Stable(domainValidationsByName(r.Valid))
+	sort.Stable(domainValidationsByName(r.MisconfiguredCNAME))
+	sort.Stable(domainValidationsByName(r.Apex))
+	return r, nil
+}
+
+// isApexDomain reports whether a domain is the apex of a zone. A domain with
+// two labels, such as "example.com", is taken to be an apex; this does not
+// account for public suffixes with more than one label, such as "co.uk".
+func isApexDomain(name string) bool {
+	name = normalizeDNSName(name)
+	return !strings.HasPrefix(name, "*.") && strings.Count(name, ".") == 1
+}
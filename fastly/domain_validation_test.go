@@ -0,0 +1,60 @@
+package fastly
+
+import "testing"
+
+func TestClient_ValidateAllDomains(t *testing.T) {
+	t.Parallel()
+
+	var r *DomainValidationReport
+	var err error
+	record(t, "domains/validate_all", func(c *Client) {
+		r, err = c.ValidateAllDomains(&ValidateAllDomainsInput{
+			Service: "7i6HN3TK9wS159v2gPAZ8A",
+			Version: 4,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Valid) != 1 || r.Valid[0].Name != "www.example.com" {
+		t.Errorf("bad valid: %v", r.Valid)
+	}
+	if len(r.MisconfiguredCNAME) != 1 || r.MisconfiguredCNAME[0].CNAME != "cdn.example.com.global.prod.fastly.net" {
+		t.Errorf("bad misconfigured: %v", r.MisconfiguredCNAME)
+	}
+	if len(r.Apex) != 1 || r.Apex[0].Name != "example.com" {
+		t.Errorf("bad apex: %v", r.Apex)
+	}
+	if len(r.Errors) != 1 || r.Errors["static.example.com"] == nil {
+		t.Errorf("bad errors: %v", r.Errors)
+	}
+	if r.OK() {
+		t.Error("expected report not to be OK")
+	}
+}
+
+func TestClient_ValidateAllDomains_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ValidateAllDomains(&ValidateAllDomainsInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ValidateAllDomains(&ValidateAllDomainsInput{
+		Service: "foo",
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_ValidateDomain_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ValidateDomain(&ValidateDomainInput{
+		Service: "foo",
+		Version: 1,
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
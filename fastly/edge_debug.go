@@ -0,0 +1,229 @@
+package fastly
+
+import (
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+)
+
+// EdgeDebug is the debugging information returned by Fastly when a request
+// is sent with the Fastly-Debug header. Nodes are listed in the order the
+// request passed through them, from the shield, if any, to the edge.
+type EdgeDebug struct {
+	StatusCode int
+
+	// ServedBy are the cache nodes which handled the request. POP is the
+	// datacenter of the edge node and Shield the datacenter of the first
+	// node, when the request went through more than one.
+	ServedBy []string
+	POP      string
+	Shield   string
+
+	// Cache and CacheHits are the cache state, such as "HIT" or "MISS", and
+	// hit count on each node. CacheState is the state on the edge node.
+	Cache      []string
+	CacheHits  []int
+	CacheState string
+
+	// Age is the age of the cached object, in seconds.
+	Age int
+
+	// Path are the delivery and fetch steps of the request, and TTL the
+	// cache state and lifetime of the object on each node.
+	Path []*EdgeDebugPath
+	TTL  []*EdgeDebugTTL
+
+	Digest        string
+	SurrogateKeys []string
+	Timer         string
+
+	// Header is the full set of response headers.
+	Header http.Header
+}
+
+// EdgeDebugPath is a single step in the Fastly-Debug-Path header.
+type EdgeDebugPath struct {
+	// Action is "D" for a node which delivered the response and "F" for one
+	// which fetched it.
+	Action string
+	Node   string
+	Time   time.Time
+}
+
+// EdgeDebugTTL is a single node in the Fastly-Debug-TTL header. TTL, Grace
+// and Age are in seconds, and are "-" when the node has no value.
+type EdgeDebugTTL struct {
+	// State is "H" for a hit and "M" for a miss.
+	State string
+	Node  string
+	TTL   string
+	Grace string
+	Age   string
+}
+
+// Hit reports whether the response was served from the edge node's cache.
+func (d *EdgeDebug) Hit() bool {
+	return strings.HasPrefix(d.CacheState, "HIT")
+}
+
+// DebugURLInput is used as input to the DebugURL function.
+type DebugURLInput struct {
+	// URL is the URL to request (required).
+	URL string
+
+	// Method is the HTTP method of the request. Optional, defaults to GET.
+	Method string
+
+	// Header are extra headers to send, such as Host. Optional.
+	Header http.Header
+
+	// HTTPClient is the client used for the request. Optional, defaults to a
+	// client which does not follow redirects.
+	HTTPClient *http.Client
+}
+
+// DebugURL requests a URL served by Fastly with the Fastly-Debug header and
+// returns the debugging information in the response. The request goes to the
+// URL directly rather than through the Fastly API, so it shows the POP and
+// cache state seen from where it is run. The response body is discarded.
+func DebugURL(i *DebugURLInput) (*EdgeDebug, error) {
+	if i.URL == "" {
+		return nil, ErrMissingURL
+	}
+
+	method := i.Method
+	if method == "" {
+		method = "GET"
+	}
+
+	req, err := http.NewRequest(method, i.URL, nil)
+	if err != nil {
+		return nil, err
+	}
+	for k, vs := range i.Header {
+		for _, v := range vs {
+			req.Header.Add(k, v)
+		}
+	}
+	if host := req.Header.Get("Host"); host != "" {
+		req.Host = host
+	}
+	req.Header.Set("Fastly-Debug", "1")
+	req.Header.Set("User-Agent", UserAgent)
+
+	client := i.HTTPClient
+	if client == nil {
+		client = cleanhttp.DefaultClient()
+		client.CheckRedirect = func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return ParseEdgeDebug(resp.StatusCode, resp.Header), nil
+}
+
+// Debug returns the debugging information in the headers of an edge check
+// response. The edge check must have been requested with the Fastly-Debug
+// header for the Fastly-Debug-* fields to be set.
+func (r *EdgeCheckResponse) Debug() *EdgeDebug {
+	var h http.Header
+	if r.Headers != nil {
+		h = *r.Headers
+	}
+	return ParseEdgeDebug(int(r.Status), h)
+}
+
+// edgeDebugGroup matches a parenthesized group of a Fastly-Debug-* header.
+var edgeDebugGroup = regexp.MustCompile(`\(([^)]*)\)`)
+
+// ParseEdgeDebug parses the debugging headers of a response served by Fastly.
+// Headers which are missing or malformed are left empty.
+func ParseEdgeDebug(status int, h http.Header) *EdgeDebug {
+	if h == nil {
+		h = make(http.Header)
+	}
+
+	d := &EdgeDebug{
+		StatusCode: status,
+		ServedBy:   splitHeaderList(h.Get("X-Served-By")),
+		Cache:      splitHeaderList(h.Get("X-Cache")),
+		Digest:     h.Get("Fastly-Debug-Digest"),
+		Timer:      h.Get("X-Timer"),
+		Header:     h,
+	}
+
+	if n := len(d.ServedBy); n > 0 {
+		d.POP = nodeDatacenter(d.ServedBy[n-1])
+		if n > 1 {
+			d.Shield = nodeDatacenter(d.ServedBy[0])
+		}
+	}
+	if n := len(d.Cache); n > 0 {
+		d.CacheState = d.Cache[n-1]
+	}
+	for _, v := range splitHeaderList(h.Get("X-Cache-Hits")) {
+		hits, _ := strconv.Atoi(v)
+		d.CacheHits = append(d.CacheHits, hits)
+	}
+	d.Age, _ = strconv.Atoi(h.Get("Age"))
+	d.SurrogateKeys = strings.Fields(h.Get("Surrogate-Key"))
+
+	for _, m := range edgeDebugGroup.FindAllStringSubmatch(h.Get("Fastly-Debug-Path"), -1) {
+		f := strings.Fields(m[1])
+		if len(f) != 3 {
+			continue
+		}
+		p := &EdgeDebugPath{Action: f[0], Node: f[1]}
+		if sec, err := strconv.ParseInt(f[2], 10, 64); err == nil {
+			p.Time = time.Unix(sec, 0).UTC()
+		}
+		d.Path = append(d.Path, p)
+	}
+
+	for _, m := range edgeDebugGroup.FindAllStringSubmatch(h.Get("Fastly-Debug-TTL"), -1) {
+		f := strings.Fields(m[1])
+		if len(f) != 5 {
+			continue
+		}
+		d.TTL = append(d.TTL, &EdgeDebugTTL{
+			State: f[0],
+			Node:  f[1],
+			TTL:   f[2],
+			Grace: f[3],
+			Age:   f[4],
+		})
+	}
+
+	return d
+}
+
+// splitHeaderList splits a comma-separated header value.
+func splitHeaderList(v string) []string {
+	if strings.TrimSpace(v) == "" {
+		return nil
+	}
+	list := strings.Split(v, ",")
+	for n := range list {
+		list[n] = strings.TrimSpace(list[n])
+	}
+	return list
+}
+
+// nodeDatacenter returns the datacenter code of a cache node name, such as
+// "LHR" for "cache-lhr6324-LHR".
+func nodeDatacenter(node string) string {
+	if n := strings.LastIndex(node, "-"); n >= 0 {
+		return node[n+1:]
+	}
+	return node
+}
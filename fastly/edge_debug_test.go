@@ -0,0 +1,71 @@
+package fastly
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+)
+
+func TestDebugURL(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Fastly-Debug") != "1" || r.Host != "www.example.com" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		h := w.Header()
+		h.Set("X-Served-By", "cache-iad-kiad7000025-IAD, cache-lhr6324-LHR")
+		h.Set("X-Cache", "MISS, HIT")
+		h.Set("X-Cache-Hits", "0, 3")
+		h.Set("Age", "42")
+		h.Set("Fastly-Debug-Path", "(D cache-lhr6324-LHR 1697270000) (F cache-iad-kiad7000025-IAD 1697269958)")
+		h.Set("Fastly-Debug-TTL", "(H cache-lhr6324-LHR 3558.000 0.000 42) (M cache-iad-kiad7000025-IAD - - -)")
+		h.Set("Fastly-Debug-Digest", "a1b2c3")
+		h.Set("Surrogate-Key", "home  product-1")
+		h.Set("X-Timer", "S1697270000.123456,VS0,VE1")
+		w.Write([]byte("ok"))
+	}))
+	defer srv.Close()
+
+	d, err := DebugURL(&DebugURLInput{
+		URL:    srv.URL + "/index.html",
+		Header: http.Header{"Host": {"www.example.com"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.StatusCode != 200 || d.POP != "LHR" || d.Shield != "IAD" {
+		t.Errorf("bad debug: %+v", d)
+	}
+	if !d.Hit() || d.Age != 42 || !reflect.DeepEqual(d.CacheHits, []int{0, 3}) {
+		t.Errorf("bad cache state: %+v", d)
+	}
+	if len(d.Path) != 2 || d.Path[1].Action != "F" || d.Path[1].Time.Unix() != 1697269958 {
+		t.Errorf("bad path: %v", d.Path)
+	}
+	if len(d.TTL) != 2 || d.TTL[0].TTL != "3558.000" || d.TTL[1].State != "M" {
+		t.Errorf("bad ttl: %v", d.TTL)
+	}
+	if !reflect.DeepEqual(d.SurrogateKeys, []string{"home", "product-1"}) || d.Digest != "a1b2c3" {
+		t.Errorf("bad debug: %+v", d)
+	}
+}
+
+func TestDebugURL_validation(t *testing.T) {
+	_, err := DebugURL(&DebugURLInput{})
+	if err != ErrMissingURL {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestEdgeCheckResponse_Debug(t *testing.T) {
+	h := http.Header{"X-Served-By": {"cache-sjc10021-SJC"}, "X-Cache": {"MISS"}}
+	d := (&EdgeCheckResponse{Status: 200, Headers: &h}).Debug()
+	if d.POP != "SJC" || d.Shield != "" || d.Hit() {
+		t.Errorf("bad debug: %+v", d)
+	}
+
+	if d := (&EdgeCheckResponse{Status: 503}).Debug(); d.StatusCode != 503 || d.POP != "" {
+		t.Errorf("bad debug: %+v", d)
+	}
+}
@@ -13,6 +13,14 @@ import (
 // a "Service" key, but one was not set.
 var ErrMissingService = errors.New("Missing required field 'Service'")
 
+// ErrMissingServices is an error that is returned when an input struct
+// requires a "Services" key, but one was not set.
+var ErrMissingServices = errors.New("Missing required field 'Services'")
+
+// ErrMissingOperation is an error that is returned when an input struct
+// requires an "Operation" key, but one was not set.
+var ErrMissingOperation = errors.New("Missing required field 'Operation'")
+
 // ErrMissingStatus is an error that is returned when an input struct requires
 // a "Status" key, but one was not set.
 var ErrMissingStatus = errors.New("Missing required field 'Status'")
@@ -21,6 +29,10 @@ var ErrMissingStatus = errors.New("Missing required field 'Status'")
 // a "Tag" key, but one was not set.
 var ErrMissingTag = errors.New("Missing required field 'Tag'")
 
+// ErrMissingType is an error that is returned when an input struct requires a
+// "Type" key, but one was not set.
+var ErrMissingType = errors.New("Missing required field 'Type'")
+
 // ErrMissingVersion is an error that is returned when an input struct requires
 // a "Version" key, but one was not set.
 var ErrMissingVersion = errors.New("Missing required field 'Version'")
@@ -29,6 +41,10 @@ var ErrMissingVersion = errors.New("Missing required field 'Version'")
 // "Name" key, but one was not set.
 var ErrMissingName = errors.New("Missing required field 'Name'")
 
+// ErrMissingNames is an error that is returned when an input struct requires a
+// "Names" key, but one was not set.
+var ErrMissingNames = errors.New("Missing required field 'Names'")
+
 // ErrMissingKey is an error that is returned when an input struct requires a
 // "Name" key, but one was not set.
 var ErrMissingKey = errors.New("Missing required field 'Key'")
@@ -53,6 +69,10 @@ var ErrMissingItemKey = errors.New("Missing required field 'ItemKey'")
 // requires a "From" key, but one was not set.
 var ErrMissingFrom = errors.New("Missing required field 'From'")
 
+// ErrMissingStart is an error that is returned when an input struct
+// requires a "Start" key, but one was not set.
+var ErrMissingStart = errors.New("Missing required field 'Start'")
+
 // ErrMissingTo is an error that is returned when an input struct
 // requires a "To" key, but one was not set.
 var ErrMissingTo = errors.New("Missing required field 'To'")
@@ -129,6 +149,142 @@ var ErrMissingUser = errors.New("Missing required field 'User'")
 // requires a Secret Key, but one was not set
 var ErrMissingSecretKey = errors.New("Missing required field 'SecretKey'")
 
+// ErrMissingKMSKeyID is an error that is returned when an input struct
+// requests SSE-KMS encryption, but no "ServerSideEncryptionKMSKeyID" was set.
+var ErrMissingKMSKeyID = errors.New("Missing required field 'ServerSideEncryptionKMSKeyID'")
+
+// ErrCompressionCodecGzipLevel is an error that is returned when an input
+// struct sets both "CompressionCodec" and "GzipLevel", which are mutually
+// exclusive.
+var ErrCompressionCodecGzipLevel = errors.New("Fields 'CompressionCodec' and 'GzipLevel' are mutually exclusive")
+
+// ErrInvalidGzipLevel is an error that is returned when an input struct sets
+// a "GzipLevel" above 9.
+var ErrInvalidGzipLevel = errors.New("Field 'GzipLevel' must be between 0 and 9")
+
+// ErrInvalidFileMaxBytes is an error that is returned when an input struct
+// sets a "FileMaxBytes" below the minimum of 1048576 (other than 0).
+var ErrInvalidFileMaxBytes = errors.New("Field 'FileMaxBytes' must be 0 or at least 1048576")
+
+// ErrIAMRoleWithKeys is an error that is returned when an input struct sets
+// an "IAMRole" together with an "AccessKey" or "SecretKey".
+var ErrIAMRoleWithKeys = errors.New("Field 'IAMRole' cannot be combined with 'AccessKey' or 'SecretKey'")
+
+// ErrWaitForVersionActiveTimeout is an error that is returned when a version
+// does not become active before the timeout given to WaitForVersionActive.
+var ErrWaitForVersionActiveTimeout = errors.New("Timed out waiting for version to become active")
+
+// ErrServiceHasActiveVersion is an error that is returned when
+// DeleteServiceSafely is asked to delete a service with an active version
+// without "Force" being set.
+var ErrServiceHasActiveVersion = errors.New("Service has an active version; set 'Force' to deactivate it before deleting")
+
+// ErrBatchModifyMaximumOperationsExceeded is an error that is returned when a
+// batch request contains more than BatchModifyMaximumOperations operations.
+var ErrBatchModifyMaximumOperationsExceeded = errors.New("Batch requests may not contain more than 1000 operations")
+
+// ErrMissingSource is an error that is returned when an input struct requires
+// a "Source" key, but one was not set.
+var ErrMissingSource = errors.New("Missing required field 'Source'")
+
+// ErrMissingDestination is an error that is returned when an input struct
+// requires a "Destination" key, but one was not set.
+var ErrMissingDestination = errors.New("Missing required field 'Destination'")
+
+// ErrMissingRevision is an error that is returned when an input struct
+// requires a "Revision" key, but one was not set.
+var ErrMissingRevision = errors.New("Missing required field 'Revision'")
+
+// ErrMissingWorkspace is an error that is returned when an input struct
+// requires a "Workspace" key, but one was not set.
+var ErrMissingWorkspace = errors.New("Missing required field 'Workspace'")
+
+// ErrMissingField is an error that is returned when an input struct requires a
+// "Field" key, but one was not set.
+var ErrMissingField = errors.New("Missing required field 'Field'")
+
+// ErrMissingWithin is an error that is returned when an input struct requires a
+// "Within" key, but one was not set.
+var ErrMissingWithin = errors.New("Missing required field 'Within'")
+
+// ErrMissingCertBundle is an error that is returned when an input struct
+// requires a "CertBundle" key, but one was not set.
+var ErrMissingCertBundle = errors.New("Missing required field 'CertBundle'")
+
+// ErrMissingCustomerID is an error that is returned when an input struct
+// requires a "CustomerID" key, but one was not set.
+var ErrMissingCustomerID = errors.New("Missing required field 'CustomerID'")
+
+// ErrMissingCIDR is an error that is returned when an input struct requires a
+// "CIDR" key, but one was not set.
+var ErrMissingCIDR = errors.New("Missing required field 'CIDR'")
+
+// ErrMissingFile is an error that is returned when an input struct requires a
+// "File" key, but one was not set.
+var ErrMissingFile = errors.New("Missing required field 'File'")
+
+// ErrMissingFiddle is an error that is returned when an input struct requires
+// a "Fiddle" key, but one was not set.
+var ErrMissingFiddle = errors.New("Missing required field 'Fiddle'")
+
+// ErrMissingSessionID is an error that is returned when an input struct
+// requires a "SessionID" key, but one was not set.
+var ErrMissingSessionID = errors.New("Missing required field 'SessionID'")
+
+// ErrPurgeAllNotConfirmed is an error that is returned when PurgeAll is called
+// without the input's "Confirm" flag or the client's "AllowPurgeAll" option.
+var ErrPurgeAllNotConfirmed = errors.New("PurgeAll purges the entire cache of the service; set 'Confirm' on the input or 'AllowPurgeAll' on the client to proceed")
+
+// ErrMissingProduct is an error that is returned when an input struct requires
+// a "Product" key, but one was not set.
+var ErrMissingProduct = errors.New("Missing required field 'Product'")
+
+// ErrMissingCompressionPolicy is an error that is returned when brotli
+// compression is enabled on a service whose version has no compression
+// policy, so that nothing would be compressed.
+var ErrMissingCompressionPolicy = errors.New("Version has no compression policy; create a Gzip before enabling brotli compression")
+
+// ErrNotVCLService is an error that is returned when a VCL-only call, such as
+// for snippets, custom VCL or conditions, is made against a Compute (Wasm)
+// service.
+var ErrNotVCLService = errors.New("Service is a Compute (wasm) service and does not support VCL")
+
+// ErrUnsupportedTransport is an error that is returned when a client
+// certificate or CA pool is configured on a client whose HTTP transport is not
+// an *http.Transport.
+var ErrUnsupportedTransport = errors.New("HTTPClient.Transport must be an *http.Transport")
+
+// ErrInvalidServiceMetadata is an error that is returned when service metadata
+// has an empty key, a key containing ":", or a key or value containing a line
+// break.
+var ErrInvalidServiceMetadata = errors.New("Service metadata keys must be non-empty without ':' or line breaks, and values must not contain line breaks")
+
+// ErrMissingDomains is an error that is returned when an input struct
+// requires a "Domains" key, but one was not set.
+var ErrMissingDomains = errors.New("Missing required field 'Domains'")
+
+// ErrMissingDomain is an error that is returned when an input struct requires
+// a "Domain" key, but one was not set.
+var ErrMissingDomain = errors.New("Missing required field 'Domain'")
+
+// ErrMissingCertificate is an error that is returned when an input struct
+// requires a "Certificate" key, but one was not set.
+var ErrMissingCertificate = errors.New("Missing required field 'Certificate'")
+
+// ErrNoDefaultTLSConfiguration is an error that is returned when no TLS
+// configuration is given, the client has no DefaultTLSConfigurationID and the
+// account has no default TLS configuration.
+var ErrNoDefaultTLSConfiguration = errors.New("No default TLS configuration")
+
+// ErrWriteOnlyDictionary is an error that is returned when the value of an
+// item is read from a write-only dictionary, whose values the API does not
+// return. VerifyDictionaryItemExists can check that such an item exists.
+var ErrWriteOnlyDictionary = errors.New("Dictionary is write-only; its item values cannot be read")
+
+// ErrMissingPlan is an error that is returned when an input struct requires a
+// "Plan" key, but one was not set.
+var ErrMissingPlan = errors.New("Missing required field 'Plan'")
+
 // Ensure HTTPError is, in fact, an error.
 var _ error = (*HTTPError)(nil)
 
@@ -10,6 +10,8 @@ import (
 	"net/http"
 	"reflect"
 	"strconv"
+	"strings"
+	"time"
 )
 
 // Events represents an event_logs item response from the Fastly API.
@@ -22,6 +24,7 @@ type Event struct {
 	Metadata    map[string]interface{} `jsonapi:"attr,metadata,omitempty"`
 	ServiceID   string                 `jsonapi:"attr,service_id"`
 	UserID      string                 `jsonapi:"attr,user_id"`
+	TokenID     string                 `jsonapi:"attr,token_id,omitempty"`
 	CreatedAt   string                 `jsonapi:"attr,created_at"`
 	Admin       bool                   `jsonapi:"attr,admin"`
 }
@@ -37,9 +40,25 @@ type GetAPIEventsFilterInput struct {
 	// EventType to Limit the returned events to a specific event type. See above for event codes.
 	EventType string
 
+	// EventTypes to Limit the returned events to any of several event types.
+	// It is combined with EventType.
+	EventTypes []string
+
 	// UserID to Limit the returned events to a specific user.
 	UserID string
 
+	// TokenID to Limit the returned events to those made with a specific API
+	// token.
+	TokenID string
+
+	// CreatedAfter to Limit the returned events to those created at or after
+	// the given time.
+	CreatedAfter time.Time
+
+	// CreatedBefore to Limit the returned events to those created before the
+	// given time.
+	CreatedBefore time.Time
+
 	// Number is the Pagination page number.
 	PageNumber int
 
@@ -118,6 +137,93 @@ func (c *Client) GetAPIEvent(i *GetAPIEventInput) (*Event, error) {
 	return &event, nil
 }
 
+// EventIterator iterates over the events matching a filter, fetching one page
+// at a time so large result sets never need to be held in memory. It is
+// created by NewEventIterator.
+type EventIterator struct {
+	c      *Client
+	filter *GetAPIEventsFilterInput
+	next   string
+	events []*Event
+	event  *Event
+	err    error
+	done   bool
+}
+
+// NewEventIterator returns an iterator over every event matching the given
+// filter. Iteration starts at PageNumber, or the first page when it is unset,
+// and continues until the last page.
+func (c *Client) NewEventIterator(i *GetAPIEventsFilterInput) *EventIterator {
+	return &EventIterator{c: c, filter: i}
+}
+
+// Next advances the iterator to the next event, fetching the next page of
+// results if needed. It returns false when there are no more events or an
+// error occurred; check Err to tell the two apart.
+func (it *EventIterator) Next() bool {
+	for len(it.events) == 0 {
+		if it.done || it.err != nil {
+			it.event = nil
+			return false
+		}
+		it.fetch()
+	}
+
+	it.event, it.events = it.events[0], it.events[1:]
+	return true
+}
+
+// Event returns the current event.
+func (it *EventIterator) Event() *Event {
+	return it.event
+}
+
+// Err returns the first error encountered while fetching events.
+func (it *EventIterator) Err() error {
+	return it.err
+}
+
+// fetch loads the next page of events into the iterator.
+func (it *EventIterator) fetch() {
+	var resp *http.Response
+	var err error
+	if it.next == "" {
+		resp, err = it.c.Get("/events", &RequestOptions{Params: it.filter.formatEventFilters()})
+	} else {
+		// NOTE: pages.Next URL includes filters already
+		resp, err = it.c.SimpleGet(it.next)
+	}
+	if err != nil {
+		it.err = err
+		return
+	}
+	defer resp.Body.Close()
+
+	pages, body, err := getEventsPages(resp.Body)
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	data, err := jsonapi.UnmarshalManyPayload(body, reflect.TypeOf(new(Event)))
+	if err != nil {
+		it.err = err
+		return
+	}
+
+	for i := range data {
+		typed, ok := data[i].(*Event)
+		if !ok {
+			it.err = fmt.Errorf("got back response of unexpected type")
+			return
+		}
+		it.events = append(it.events, typed)
+	}
+
+	it.next = pages.Next
+	it.done = pages.Next == ""
+}
+
 // interpretAPIEventsPage accepts a Fastly response for a set of WAF rule statuses
 // and unmarshals the results. If there are more pages of results, it fetches the next
 // page, adds that response to the array of results, and repeats until all results have
@@ -177,13 +283,30 @@ func getEventsPages(body io.Reader) (EventsPaginationInfo, io.Reader, error) {
 // Fastly events.
 func (i *GetAPIEventsFilterInput) formatEventFilters() map[string]string {
 	result := map[string]string{}
+
+	eventTypes := i.EventTypes
+	if i.EventType != "" {
+		eventTypes = append([]string{i.EventType}, eventTypes...)
+	}
+
+	var createdAfter, createdBefore string
+	if !i.CreatedAfter.IsZero() {
+		createdAfter = i.CreatedAfter.UTC().Format(time.RFC3339)
+	}
+	if !i.CreatedBefore.IsZero() {
+		createdBefore = i.CreatedBefore.UTC().Format(time.RFC3339)
+	}
+
 	pairings := map[string]interface{}{
-		"filter[customer_id]": i.CustomerID,
-		"filter[service_id]":  i.ServiceID,
-		"filter[event_type]":  i.EventType,
-		"filter[user_id]":     i.UserID,
-		"page[size]":          i.MaxResults,
-		"page[number]":        i.PageNumber, // starts at 1, not 0
+		"filter[customer_id]":     i.CustomerID,
+		"filter[service_id]":      i.ServiceID,
+		"filter[event_type]":      strings.Join(eventTypes, ","),
+		"filter[user_id]":         i.UserID,
+		"filter[token_id]":        i.TokenID,
+		"filter[created_at][gte]": createdAfter,
+		"filter[created_at][lt]":  createdBefore,
+		"page[size]":              i.MaxResults,
+		"page[number]":            i.PageNumber, // starts at 1, not 0
 	}
 	// NOTE: This setup means we will not be able to send the zero value
 	// of any of these filters. It doesn't appear we would need to at present.
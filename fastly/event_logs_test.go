@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"io/ioutil"
 	"testing"
+	"time"
 )
 
 var testEventID = "3OMewexIMbzrQj77xxxxxx"
@@ -43,6 +44,39 @@ func TestClient_APIEvents(t *testing.T) {
 
 }
 
+func TestClient_EventIterator(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var events []*Event
+	record(t, "events/iterator", func(c *Client) {
+		it := c.NewEventIterator(&GetAPIEventsFilterInput{
+			EventType:     "version.activate",
+			EventTypes:    []string{"service.create"},
+			TokenID:       "5wUpUF1Ct6S5QWKxxxxxx",
+			CreatedAfter:  time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC),
+			CreatedBefore: time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC),
+			MaxResults:    2,
+		})
+		for it.Next() {
+			events = append(events, it.Event())
+		}
+		err = it.Err()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 3 {
+		t.Fatalf("bad events: %v", events)
+	}
+	if events[2].ID != "3cVP7RfHYD7r0CYRxxxxxx" {
+		t.Errorf("bad id: %q", events[2].ID)
+	}
+	if events[0].TokenID != "5wUpUF1Ct6S5QWKxxxxxx" {
+		t.Errorf("bad token_id: %q", events[0].TokenID)
+	}
+}
+
 func TestClient_GetAPIEvent_validation(t *testing.T) {
 	var err error
 	_, err = testClient.GetAPIEvent(&GetAPIEventInput{
@@ -79,6 +113,22 @@ func TestGetAPIEventsFilterInput_formatFilters(t *testing.T) {
 				"page[number]":        "2",
 			},
 		},
+		{
+			description: "combines event types and formats times",
+			filters: GetAPIEventsFilterInput{
+				EventType:     "version.activate",
+				EventTypes:    []string{"service.create", "service.delete"},
+				TokenID:       "5wUpUF1Ct6S5QWKxxxxxx",
+				CreatedAfter:  time.Date(2018, 5, 1, 2, 0, 0, 0, time.FixedZone("CEST", 2*60*60)),
+				CreatedBefore: time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expected: map[string]string{
+				"filter[event_type]":      "version.activate,service.create,service.delete",
+				"filter[token_id]":        "5wUpUF1Ct6S5QWKxxxxxx",
+				"filter[created_at][gte]": "2018-05-01T00:00:00Z",
+				"filter[created_at][lt]":  "2018-06-01T00:00:00Z",
+			},
+		},
 	}
 	for _, testcase := range tests {
 		answer := testcase.filters.formatEventFilters()
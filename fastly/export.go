@@ -0,0 +1,298 @@
+package fastly
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+)
+
+// ExportFormat is a file format used to export events, stats and usage data.
+type ExportFormat string
+
+const (
+	// ExportFormatCSV is a header row followed by one record per line. Values
+	// which are objects, such as event metadata, are written as JSON.
+	ExportFormatCSV ExportFormat = "csv"
+
+	// ExportFormatJSONLines is one JSON object per line.
+	ExportFormatJSONLines ExportFormat = "jsonl"
+)
+
+// ExportEventsInput is used as input to the ExportEvents function.
+type ExportEventsInput struct {
+	// Filter selects the events to export. Optional.
+	Filter *GetAPIEventsFilterInput
+
+	// Destination is where the events are written, in the given Format
+	// (required).
+	Destination io.Writer
+	Format      ExportFormat
+}
+
+// eventExportColumns are the columns written by ExportEvents.
+var eventExportColumns = []string{
+	"id", "created_at", "event_type", "description", "customer_id",
+	"service_id", "user_id", "token_id", "ip", "admin", "metadata",
+}
+
+// ExportEvents writes every event matching the filter to Destination. Events
+// are fetched one page at a time with an EventIterator and written as they
+// arrive, so exports of any size use a constant amount of memory. It returns
+// the number of events written.
+func (c *Client) ExportEvents(i *ExportEventsInput) (int, error) {
+	if i.Destination == nil {
+		return 0, ErrMissingDestination
+	}
+
+	ew, err := newExportWriter(i.Destination, i.Format, eventExportColumns)
+	if err != nil {
+		return 0, err
+	}
+
+	filter := i.Filter
+	if filter == nil {
+		filter = &GetAPIEventsFilterInput{}
+	}
+
+	var n int
+	it := c.NewEventIterator(filter)
+	for it.Next() {
+		e := it.Event()
+		err := ew.write([]interface{}{
+			e.ID, e.CreatedAt, e.EventType, e.Description, e.CustomerID,
+			e.ServiceID, e.UserID, e.TokenID, e.IP, e.Admin, e.Metadata,
+		})
+		if err != nil {
+			return n, err
+		}
+		n++
+	}
+	if err := it.Err(); err != nil {
+		return n, err
+	}
+	return n, ew.flush()
+}
+
+// ExportStatsInput is used as input to the ExportStats function.
+type ExportStatsInput struct {
+	// Stats selects the historical stats to export. Optional.
+	Stats *GetStatsInput
+
+	// Destination is where the stats are written, in the given Format
+	// (required).
+	Destination io.Writer
+	Format      ExportFormat
+}
+
+// ExportStats fetches historical stats and writes one record per stats
+// sample to Destination. Columns are named after the API fields, in the order
+// of the Stats struct. It returns the number of records written.
+func (c *Client) ExportStats(i *ExportStatsInput) (int, error) {
+	if i.Destination == nil {
+		return 0, ErrMissingDestination
+	}
+
+	columns := statsExportColumns()
+	ew, err := newExportWriter(i.Destination, i.Format, columns)
+	if err != nil {
+		return 0, err
+	}
+
+	input := i.Stats
+	if input == nil {
+		input = &GetStatsInput{}
+	}
+
+	resp, err := c.GetStats(input)
+	if err != nil {
+		return 0, err
+	}
+
+	for n, s := range resp.Data {
+		v := reflect.ValueOf(s).Elem()
+		values := make([]interface{}, v.NumField())
+		for f := range values {
+			values[f] = v.Field(f).Interface()
+		}
+		if err := ew.write(values); err != nil {
+			return n, err
+		}
+	}
+	return len(resp.Data), ew.flush()
+}
+
+// statsExportColumns returns the API field names of the Stats struct.
+func statsExportColumns() []string {
+	t := reflect.TypeOf(Stats{})
+	columns := make([]string, t.NumField())
+	for f := range columns {
+		columns[f] = t.Field(f).Tag.Get("mapstructure")
+	}
+	return columns
+}
+
+// ExportUsageInput is used as input to the ExportUsage function.
+type ExportUsageInput struct {
+	// Usage selects the usage data to export. Optional.
+	Usage *GetUsageInput
+
+	// ByService breaks usage down by service within each region, rather than
+	// aggregating across all services.
+	ByService bool
+
+	// Destination is where the usage data is written, in the given Format
+	// (required).
+	Destination io.Writer
+	Format      ExportFormat
+}
+
+// ExportUsage fetches usage data and writes one record per region, or per
+// region and service with ByService, to Destination. Records are sorted by
+// region and then service. It returns the number of records written.
+func (c *Client) ExportUsage(i *ExportUsageInput) (int, error) {
+	if i.Destination == nil {
+		return 0, ErrMissingDestination
+	}
+
+	columns := []string{"region", "requests", "bandwidth"}
+	if i.ByService {
+		columns = []string{"region", "service_id", "requests", "bandwidth"}
+	}
+
+	ew, err := newExportWriter(i.Destination, i.Format, columns)
+	if err != nil {
+		return 0, err
+	}
+
+	input := i.Usage
+	if input == nil {
+		input = &GetUsageInput{}
+	}
+
+	var rows [][]interface{}
+	if i.ByService {
+		resp, err := c.GetUsageByService(input)
+		if err != nil {
+			return 0, err
+		}
+		if resp.Data != nil {
+			for region, services := range *resp.Data {
+				if services == nil {
+					continue
+				}
+				for service, u := range *services {
+					rows = append(rows, []interface{}{region, service, u.Requests, u.Bandwidth})
+				}
+			}
+		}
+	} else {
+		resp, err := c.GetUsage(input)
+		if err != nil {
+			return 0, err
+		}
+		if resp.Data != nil {
+			for region, u := range *resp.Data {
+				rows = append(rows, []interface{}{region, u.Requests, u.Bandwidth})
+			}
+		}
+	}
+
+	sort.Sort(exportRowsByKey{rows: rows, keys: len(columns) - 2})
+
+	for n, row := range rows {
+		if err := ew.write(row); err != nil {
+			return n, err
+		}
+	}
+	return len(rows), ew.flush()
+}
+
+// exportRowsByKey sorts export rows by their leading string columns.
+type exportRowsByKey struct {
+	rows [][]interface{}
+	keys int
+}
+
+// Len, Swap, and Less implement the sortable interface.
+func (s exportRowsByKey) Len() int      { return len(s.rows) }
+func (s exportRowsByKey) Swap(i, j int) { s.rows[i], s.rows[j] = s.rows[j], s.rows[i] }
+func (s exportRowsByKey) Less(i, j int) bool {
+	for k := 0; k < s.keys; k++ {
+		a, b := s.rows[i][k].(string), s.rows[j][k].(string)
+		if a != b {
+			return a < b
+		}
+	}
+	return false
+}
+
+// exportWriter writes records in an ExportFormat.
+type exportWriter struct {
+	columns []string
+	csv     *csv.Writer
+	json    *json.Encoder
+}
+
+// newExportWriter returns a writer for the given format and columns. For CSV,
+// the header row is written immediately.
+func newExportWriter(w io.Writer, f ExportFormat, columns []string) (*exportWriter, error) {
+	ew := &exportWriter{columns: columns}
+	switch f {
+	case ExportFormatCSV:
+		ew.csv = csv.NewWriter(w)
+		if err := ew.csv.Write(columns); err != nil {
+			return nil, err
+		}
+	case ExportFormatJSONLines:
+		ew.json = json.NewEncoder(w)
+	default:
+		return nil, fmt.Errorf("Unknown export format %q", f)
+	}
+	return ew, nil
+}
+
+// write writes a single record, with one value per column.
+func (ew *exportWriter) write(values []interface{}) error {
+	if ew.json != nil {
+		record := make(map[string]interface{}, len(values))
+		for n, v := range values {
+			record[ew.columns[n]] = v
+		}
+		return ew.json.Encode(record)
+	}
+
+	record := make([]string, len(values))
+	for n, v := range values {
+		switch t := v.(type) {
+		case string:
+			record[n] = t
+		case nil:
+		default:
+			if k := reflect.ValueOf(v).Kind(); k == reflect.Map || k == reflect.Slice {
+				if reflect.ValueOf(v).Len() == 0 {
+					continue
+				}
+				b, err := json.Marshal(v)
+				if err != nil {
+					return err
+				}
+				record[n] = string(b)
+				continue
+			}
+			record[n] = fmt.Sprint(v)
+		}
+	}
+	return ew.csv.Write(record)
+}
+
+// flush flushes any buffered CSV output and reports write errors.
+func (ew *exportWriter) flush() error {
+	if ew.csv == nil {
+		return nil
+	}
+	ew.csv.Flush()
+	return ew.csv.Error()
+}
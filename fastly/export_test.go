@@ -0,0 +1,156 @@
+package fastly
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_ExportEvents(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var n int
+	var buf bytes.Buffer
+	record(t, "events/iterator", func(c *Client) {
+		n, err = c.ExportEvents(&ExportEventsInput{
+			Filter: &GetAPIEventsFilterInput{
+				EventType:     "version.activate",
+				EventTypes:    []string{"service.create"},
+				TokenID:       "5wUpUF1Ct6S5QWKxxxxxx",
+				CreatedAfter:  time.Date(2018, 5, 1, 0, 0, 0, 0, time.UTC),
+				CreatedBefore: time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC),
+				MaxResults:    2,
+			},
+			Destination: &buf,
+			Format:      ExportFormatJSONLines,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("bad count: %d", n)
+	}
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("bad lines: %q", lines)
+	}
+	var e map[string]interface{}
+	if err := json.Unmarshal([]byte(lines[1]), &e); err != nil {
+		t.Fatal(err)
+	}
+	if e["id"] != "2bVP7RfHYD7r0CYRxxxxxx" || e["event_type"] != "version.activate" {
+		t.Errorf("bad event: %v", e)
+	}
+}
+
+func TestClient_ExportStats(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var n int
+	var buf bytes.Buffer
+	record(t, "stats/export", func(c *Client) {
+		n, err = c.ExportStats(&ExportStatsInput{
+			Stats: &GetStatsInput{
+				Service: testServiceID,
+				From:    "1501113600",
+				To:      "1501113720",
+				By:      "minute",
+			},
+			Destination: &buf,
+			Format:      ExportFormatCSV,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 2 {
+		t.Errorf("bad count: %d", n)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 3 {
+		t.Fatalf("bad records: %v", records)
+	}
+
+	row := make(map[string]string)
+	for i, col := range records[0] {
+		row[col] = records[1][i]
+	}
+	if row["service_id"] != testServiceID {
+		t.Errorf("bad service_id: %q", row["service_id"])
+	}
+	if row["start_time"] != "1501113600" {
+		t.Errorf("bad start_time: %q", row["start_time"])
+	}
+	if row["requests"] != "120" {
+		t.Errorf("bad requests: %q", row["requests"])
+	}
+	if row["miss_histogram"] != `{"10":20}` {
+		t.Errorf("bad miss_histogram: %q", row["miss_histogram"])
+	}
+}
+
+func TestClient_ExportUsage(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var n int
+	var buf bytes.Buffer
+	record(t, "stats/export_usage_by_service", func(c *Client) {
+		n, err = c.ExportUsage(&ExportUsageInput{
+			Usage: &GetUsageInput{
+				From: "1501113600",
+				To:   "1501200000",
+				By:   "day",
+			},
+			ByService:   true,
+			Destination: &buf,
+			Format:      ExportFormatCSV,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("bad count: %d", n)
+	}
+
+	expected := "region,service_id,requests,bandwidth\n" +
+		"europe,2aBqYv4HSbKDWWrPkRktDH,5,2500\n" +
+		"europe,7i6HN3TK9wS159v2gPAZ8A,40,40000\n" +
+		"usa,7i6HN3TK9wS159v2gPAZ8A,160,160000\n"
+	if buf.String() != expected {
+		t.Errorf("bad export:\n%s", buf.String())
+	}
+}
+
+func TestClient_Export_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ExportEvents(&ExportEventsInput{})
+	if err != ErrMissingDestination {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ExportStats(&ExportStatsInput{
+		Destination: &bytes.Buffer{},
+		Format:      "xml",
+	})
+	if err == nil || !strings.Contains(err.Error(), "Unknown export format") {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ExportUsage(&ExportUsageInput{})
+	if err != ErrMissingDestination {
+		t.Errorf("bad error: %s", err)
+	}
+}
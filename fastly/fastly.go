@@ -3,6 +3,8 @@ package fastly
 import (
 	"bytes"
 	"encoding"
+	"strconv"
+	"strings"
 )
 
 type statusResp struct {
@@ -45,3 +47,60 @@ func (b *Compatibool) UnmarshalText(t []byte) error {
 	}
 	return nil
 }
+
+// Ensure CipherList implements the proper interfaces.
+var _ encoding.TextMarshaler = new(CipherList)
+
+// CipherList is a list of OpenSSL cipher names that marshalls to a single
+// colon-separated string, which is the format Fastly's API expects.
+type CipherList []string
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (l CipherList) MarshalText() ([]byte, error) {
+	return []byte(strings.Join(l, ":")), nil
+}
+
+// BatchOperation is the operation to perform on a single item in a batch
+// request against the ACL entry and dictionary item endpoints.
+type BatchOperation string
+
+const (
+	BatchOperationCreate BatchOperation = "create"
+	BatchOperationUpdate BatchOperation = "update"
+	BatchOperationUpsert BatchOperation = "upsert"
+	BatchOperationDelete BatchOperation = "delete"
+)
+
+// BatchModifyMaximumOperations is the maximum number of operations the API
+// accepts in a single batch request.
+const BatchModifyMaximumOperations = 1000
+
+const (
+	// DirectionAscend and DirectionDescend are the sort directions accepted
+	// by paginated list endpoints.
+	DirectionAscend  = "ascend"
+	DirectionDescend = "descend"
+)
+
+// ListAllPerPage is the page size used by the auto-paginating ListAll
+// functions when no page size is given.
+const ListAllPerPage = 100
+
+// paginationParams returns the query parameters for a paginated list request,
+// omitting any that were not set.
+func paginationParams(page, perPage int, sort, direction string) map[string]string {
+	params := map[string]string{}
+	if page != 0 {
+		params["page"] = strconv.Itoa(page)
+	}
+	if perPage != 0 {
+		params["per_page"] = strconv.Itoa(perPage)
+	}
+	if sort != "" {
+		params["sort"] = sort
+	}
+	if direction != "" {
+		params["direction"] = direction
+	}
+	return params
+}
@@ -67,3 +67,37 @@ func recordRealtimeStats(t *testing.T, fixture string, f func(*RTSClient)) {
 
 	f(client)
 }
+
+func recordFiddle(t *testing.T, fixture string, f func(*FiddleClient)) {
+	r, err := recorder.New("fixtures/" + fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := r.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	client := NewFiddleClient()
+	client.client.HTTPClient.Transport = r
+
+	f(client)
+}
+
+func recordStatus(t *testing.T, fixture string, f func(*StatusClient)) {
+	r, err := recorder.New("fixtures/" + fixture)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := r.Stop(); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	client := NewStatusClient()
+	client.client.HTTPClient.Transport = r
+
+	f(client)
+}
@@ -0,0 +1,62 @@
+package fastlytest
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBuilders(t *testing.T) {
+	// Every field of a built value must be set, so a field added to a
+	// response struct fails here until its builder is updated.
+	cases := []struct {
+		name  string
+		value interface{}
+	}{
+		{"Service", NewTestService()},
+		{"Version", NewTestVersion()},
+		{"Backend", NewTestBackend()},
+		{"BigQuery", NewTestBigQuery()},
+		{"CacheSetting", NewTestCacheSetting()},
+		{"Condition", NewTestCondition()},
+		{"Dictionary", NewTestDictionary()},
+		{"Director", NewTestDirector()},
+		{"Domain", NewTestDomain()},
+		{"Gzip", NewTestGzip()},
+		{"Header", NewTestHeader()},
+		{"HealthCheck", NewTestHealthCheck()},
+		{"RequestSetting", NewTestRequestSetting()},
+		{"S3", NewTestS3()},
+		{"Snippet", NewTestSnippet()},
+		{"Syslog", NewTestSyslog()},
+		{"VCL", NewTestVCL()},
+	}
+	for _, c := range cases {
+		v := reflect.ValueOf(c.value).Elem()
+		for n := 0; n < v.NumField(); n++ {
+			f := v.Type().Field(n)
+			if f.Name == "DeletedAt" {
+				if !v.Field(n).IsNil() {
+					t.Errorf("%s: expected DeletedAt to be nil", c.name)
+				}
+				continue
+			}
+			if reflect.DeepEqual(v.Field(n).Interface(), reflect.Zero(f.Type).Interface()) {
+				t.Errorf("%s: field %s is not set", c.name, f.Name)
+			}
+		}
+	}
+}
+
+func TestBuilders_independent(t *testing.T) {
+	a, b := NewTestBackend(), NewTestBackend()
+	a.SSLCiphers[0] = "changed"
+	if b.SSLCiphers[0] == "changed" {
+		t.Error("expected built values not to share state")
+	}
+
+	s, v := NewTestSnippet(), NewTestSnippet()
+	*s.CreatedAt = UpdatedAt
+	if v.CreatedAt.Equal(UpdatedAt) {
+		t.Error("expected built values not to share timestamps")
+	}
+}
@@ -0,0 +1,239 @@
+package fastly
+
+import (
+	"fmt"
+	"sort"
+)
+
+// Fiddle represents a Fastly Fiddle, a disposable service configuration that
+// can be executed against test requests without touching a real service.
+type Fiddle struct {
+	ID    string `mapstructure:"id" json:"-"`
+	Type  string `mapstructure:"type" json:"type,omitempty"`
+	Title string `mapstructure:"title" json:"title,omitempty"`
+
+	// Origins are the backends available to the fiddle, such as
+	// "https://httpbin.org".
+	Origins []string `mapstructure:"origins" json:"origins"`
+
+	// Src is the VCL of each subroutine, keyed by name without the "vcl_"
+	// prefix, such as "recv" or "deliver".
+	Src map[string]string `mapstructure:"src" json:"src"`
+
+	// Requests are run in order when the fiddle is executed.
+	Requests []*FiddleRequest `mapstructure:"requests" json:"requests"`
+}
+
+// FiddleRequest is a test request made when a fiddle is executed. Headers and
+// Tests are newline-separated lists, such as "Accept: text/html" and
+// "clientFetch.status is 200".
+type FiddleRequest struct {
+	Method  string `mapstructure:"method" json:"method,omitempty"`
+	Path    string `mapstructure:"path" json:"path"`
+	Headers string `mapstructure:"headers" json:"headers,omitempty"`
+	Body    string `mapstructure:"body" json:"body,omitempty"`
+	Tests   string `mapstructure:"tests" json:"tests,omitempty"`
+
+	EnableCluster bool `mapstructure:"enableCluster" json:"enableCluster"`
+	EnableShield  bool `mapstructure:"enableShield" json:"enableShield"`
+}
+
+// fiddleResponse is the envelope the API wraps a fiddle in.
+type fiddleResponse struct {
+	Fiddle *Fiddle `mapstructure:"fiddle"`
+}
+
+// fiddleRequest is the envelope the API expects a fiddle in.
+type fiddleRequest struct {
+	Fiddle *Fiddle `json:"fiddle"`
+}
+
+// CreateFiddleInput is used as input to the CreateFiddle function.
+type CreateFiddleInput struct {
+	// Fiddle is the fiddle to create (required). Its ID is ignored.
+	Fiddle *Fiddle
+}
+
+// CreateFiddle creates a new fiddle and returns it with its assigned ID.
+func (c *FiddleClient) CreateFiddle(i *CreateFiddleInput) (*Fiddle, error) {
+	if i.Fiddle == nil {
+		return nil, ErrMissingFiddle
+	}
+
+	resp, err := c.client.PostJSON("/fiddle", &fiddleRequest{Fiddle: i.Fiddle}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *fiddleResponse
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	return r.Fiddle, nil
+}
+
+// GetFiddleInput is used as input to the GetFiddle function.
+type GetFiddleInput struct {
+	// ID is the ID of the fiddle (required).
+	ID string
+}
+
+// GetFiddle gets the fiddle with the given ID.
+func (c *FiddleClient) GetFiddle(i *GetFiddleInput) (*Fiddle, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/fiddle/%s", i.ID)
+	resp, err := c.client.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *fiddleResponse
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	return r.Fiddle, nil
+}
+
+// UpdateFiddleInput is used as input to the UpdateFiddle function.
+type UpdateFiddleInput struct {
+	// ID is the ID of the fiddle. Fiddle is its new content, which replaces
+	// the existing content. Both fields are required.
+	ID     string
+	Fiddle *Fiddle
+}
+
+// UpdateFiddle replaces the content of an existing fiddle.
+func (c *FiddleClient) UpdateFiddle(i *UpdateFiddleInput) (*Fiddle, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	if i.Fiddle == nil {
+		return nil, ErrMissingFiddle
+	}
+
+	path := fmt.Sprintf("/fiddle/%s", i.ID)
+	resp, err := c.client.PutJSON(path, &fiddleRequest{Fiddle: i.Fiddle}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *fiddleResponse
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	return r.Fiddle, nil
+}
+
+// ExecuteFiddleInput is used as input to the ExecuteFiddle function.
+type ExecuteFiddleInput struct {
+	// ID is the ID of the fiddle (required).
+	ID string
+}
+
+// ExecuteFiddle starts running a fiddle's requests and returns the session ID
+// of the run, which is passed to GetFiddleResult.
+func (c *FiddleClient) ExecuteFiddle(i *ExecuteFiddleInput) (string, error) {
+	if i.ID == "" {
+		return "", ErrMissingID
+	}
+
+	path := fmt.Sprintf("/fiddle/%s/execute", i.ID)
+	resp, err := c.client.Post(path, nil)
+	if err != nil {
+		return "", err
+	}
+
+	var r *struct {
+		SessionID string `mapstructure:"sessionID"`
+	}
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return "", err
+	}
+	return r.SessionID, nil
+}
+
+// FiddleResult is the outcome of executing a fiddle. ClientFetches holds one
+// entry per request of the fiddle once it has completed.
+type FiddleResult struct {
+	ID            string                        `mapstructure:"id"`
+	RequestCount  int                           `mapstructure:"requestCount"`
+	ClientFetches map[string]*FiddleClientFetch `mapstructure:"clientFetches"`
+}
+
+// FiddleClientFetch is the response to a single fiddle request, with the
+// results of its tests.
+type FiddleClientFetch struct {
+	Request  string              `mapstructure:"req"`
+	Response string              `mapstructure:"resp"`
+	Status   int                 `mapstructure:"status"`
+	Tests    []*FiddleTestResult `mapstructure:"tests"`
+}
+
+// FiddleTestResult is the result of a single test expression.
+type FiddleTestResult struct {
+	Expression string `mapstructure:"testExpr"`
+	Pass       bool   `mapstructure:"pass"`
+	Detail     string `mapstructure:"detail"`
+}
+
+// Complete reports whether every request of the run has finished.
+func (r *FiddleResult) Complete() bool {
+	return r.RequestCount > 0 && len(r.ClientFetches) >= r.RequestCount
+}
+
+// Passed reports whether every test of every completed request passed.
+func (r *FiddleResult) Passed() bool {
+	for _, f := range r.ClientFetches {
+		for _, t := range f.Tests {
+			if !t.Pass {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// Fetches returns the completed client fetches, sorted by request ID.
+func (r *FiddleResult) Fetches() []*FiddleClientFetch {
+	ids := make([]string, 0, len(r.ClientFetches))
+	for id := range r.ClientFetches {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	fetches := make([]*FiddleClientFetch, len(ids))
+	for n, id := range ids {
+		fetches[n] = r.ClientFetches[id]
+	}
+	return fetches
+}
+
+// GetFiddleResultInput is used as input to the GetFiddleResult function.
+type GetFiddleResultInput struct {
+	// SessionID is the ID returned by ExecuteFiddle (required).
+	SessionID string
+}
+
+// GetFiddleResult gets the results of a fiddle run so far. A run takes a few
+// seconds to complete; callers should poll until Complete reports true.
+func (c *FiddleClient) GetFiddleResult(i *GetFiddleResultInput) (*FiddleResult, error) {
+	if i.SessionID == "" {
+		return nil, ErrMissingSessionID
+	}
+
+	path := fmt.Sprintf("/results/%s", i.SessionID)
+	resp, err := c.client.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *FiddleResult
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
@@ -0,0 +1,117 @@
+package fastly
+
+import "testing"
+
+func TestFiddleClient_Fiddles(t *testing.T) {
+	t.Parallel()
+
+	fiddle := &Fiddle{
+		Type:    "vcl",
+		Title:   "go-fastly test",
+		Origins: []string{"https://httpbin.org"},
+		Src: map[string]string{
+			"recv":    `set req.http.X-Fiddle = "1";`,
+			"deliver": `set resp.http.X-Served-By-Fiddle = "yes";`,
+		},
+		Requests: []*FiddleRequest{{
+			Method:        "GET",
+			Path:          "/status/200",
+			Tests:         "clientFetch.status is 200",
+			EnableCluster: true,
+		}},
+	}
+
+	var err error
+
+	// Create
+	var f *Fiddle
+	recordFiddle(t, "fiddles/create", func(c *FiddleClient) {
+		f, err = c.CreateFiddle(&CreateFiddleInput{Fiddle: fiddle})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.ID != "0a1b2c3d" {
+		t.Errorf("bad id: %q", f.ID)
+	}
+	if len(f.Requests) != 1 || f.Requests[0].Path != "/status/200" {
+		t.Errorf("bad requests: %v", f.Requests)
+	}
+
+	// Get
+	var nf *Fiddle
+	recordFiddle(t, "fiddles/get", func(c *FiddleClient) {
+		nf, err = c.GetFiddle(&GetFiddleInput{ID: f.ID})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nf.Src["deliver"] != fiddle.Src["deliver"] {
+		t.Errorf("bad src: %q", nf.Src["deliver"])
+	}
+
+	// Update
+	fiddle.Title = "go-fastly test updated"
+	var uf *Fiddle
+	recordFiddle(t, "fiddles/update", func(c *FiddleClient) {
+		uf, err = c.UpdateFiddle(&UpdateFiddleInput{ID: f.ID, Fiddle: fiddle})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uf.Title != "go-fastly test updated" {
+		t.Errorf("bad title: %q", uf.Title)
+	}
+
+	// Execute
+	var session string
+	recordFiddle(t, "fiddles/execute", func(c *FiddleClient) {
+		session, err = c.ExecuteFiddle(&ExecuteFiddleInput{ID: f.ID})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session != "5f3e2d1c0b" {
+		t.Errorf("bad session: %q", session)
+	}
+
+	// Result
+	var r *FiddleResult
+	recordFiddle(t, "fiddles/result", func(c *FiddleClient) {
+		r, err = c.GetFiddleResult(&GetFiddleResultInput{SessionID: session})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Complete() || !r.Passed() {
+		t.Errorf("bad result: complete %t, passed %t", r.Complete(), r.Passed())
+	}
+	if fs := r.Fetches(); len(fs) != 1 || fs[0].Status != 200 {
+		t.Errorf("bad fetches: %v", fs)
+	}
+}
+
+func TestFiddleClient_validation(t *testing.T) {
+	c := NewFiddleClient()
+
+	var err error
+	_, err = c.CreateFiddle(&CreateFiddleInput{})
+	if err != ErrMissingFiddle {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = c.UpdateFiddle(&UpdateFiddleInput{ID: "foo"})
+	if err != ErrMissingFiddle {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = c.ExecuteFiddle(&ExecuteFiddleInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = c.GetFiddleResult(&GetFiddleResultInput{})
+	if err != ErrMissingSessionID {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -0,0 +1,309 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// flattenTags are the struct tags a flattened key is read from, in order of
+// preference: response types use mapstructure, inputs use form or json, and
+// the JSON API types use jsonapi.
+var flattenTags = []string{"mapstructure", "form", "json", "jsonapi"}
+
+// Flatten converts v, a struct or a pointer to one such as a *Backend or a
+// *CreateBackendInput, into the nested map[string]interface{} form used by
+// schema-driven tools such as Terraform providers. Keys are the snake_case
+// names the API uses, taken from the field's struct tags. Values are limited
+// to string, int, float64, bool, []interface{} and map[string]interface{}:
+// timestamps are RFC 3339 strings, unsigned integers are ints and nested
+// structs are maps. Fields which are nil are left out, and sensitive fields
+// are kept, so the result should be stored as carefully as v. Expand converts
+// the result back.
+func Flatten(v interface{}) (map[string]interface{}, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr && !rv.IsNil() {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct || rv.Type() == reflect.TypeOf(time.Time{}) {
+		return nil, fmt.Errorf("cannot flatten %T: not a struct", v)
+	}
+
+	m := make(map[string]interface{})
+	flattenStruct(m, rv)
+	return m, nil
+}
+
+// flattenStruct adds the fields of the struct v to m. Untagged embedded
+// structs are merged into m, like the API returns them.
+func flattenStruct(m map[string]interface{}, v reflect.Value) {
+	t := v.Type()
+	for n := 0; n < t.NumField(); n++ {
+		f := t.Field(n)
+		if f.PkgPath != "" {
+			continue
+		}
+		key, ok := flattenKey(f)
+		if !ok {
+			continue
+		}
+
+		fv := v.Field(n)
+		if f.Anonymous && !hasFlattenTag(f) {
+			if fv.Kind() == reflect.Ptr {
+				if fv.IsNil() {
+					continue
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				flattenStruct(m, fv)
+				continue
+			}
+		}
+
+		if fl := flattenValue(fv); fl != nil {
+			m[key] = fl
+		}
+	}
+}
+
+// flattenValue converts v into its flattened form, or nil if it is unset.
+func flattenValue(v reflect.Value) interface{} {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if v.IsNil() {
+			return nil
+		}
+		return flattenValue(v.Elem())
+	case reflect.Struct:
+		if t, ok := v.Interface().(time.Time); ok {
+			return t.Format(time.RFC3339)
+		}
+		m := make(map[string]interface{})
+		flattenStruct(m, v)
+		return m
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		l := make([]interface{}, 0, v.Len())
+		for n := 0; n < v.Len(); n++ {
+			l = append(l, flattenValue(v.Index(n)))
+		}
+		return l
+	case reflect.Map:
+		if v.IsNil() {
+			return nil
+		}
+		m := make(map[string]interface{}, v.Len())
+		for _, k := range v.MapKeys() {
+			m[fmt.Sprint(k.Interface())] = flattenValue(v.MapIndex(k))
+		}
+		return m
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return int(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return int(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.String:
+		return v.String()
+	}
+	return nil
+}
+
+// Expand is the reverse of Flatten: it decodes m, a map such as the one
+// returned by Flatten or read from a Terraform schema, into out, which must be
+// a pointer to a struct. Keys are matched to fields in the same way Flatten
+// names them, and values are converted weakly, so "8080" can be expanded into
+// an int field and an RFC 3339 string into a *time.Time. Keys without a
+// matching field are ignored.
+func Expand(m map[string]interface{}, out interface{}) error {
+	rv := reflect.ValueOf(out)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("cannot expand into %T: not a pointer to a struct", out)
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		DecodeHook: mapstructure.ComposeDecodeHookFunc(
+			mapToHTTPHeaderHookFunc(),
+			stringToTimeHookFunc(),
+			stringToCipherListHookFunc(),
+		),
+		// Keys are renamed to field names by expandValue, so no tag is read.
+		TagName:          "-",
+		WeaklyTypedInput: true,
+		Result:           out,
+	})
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(expandValue(m, rv.Elem().Type()))
+}
+
+// expandValue renames the keys of the flattened maps within data to the
+// names of the fields of t they belong to, so mapstructure can decode them.
+func expandValue(data interface{}, t reflect.Type) interface{} {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+
+	switch d := data.(type) {
+	case map[string]interface{}:
+		switch {
+		case t.Kind() == reflect.Struct && t != reflect.TypeOf(time.Time{}):
+			return expandStruct(d, t)
+		case t.Kind() == reflect.Map:
+			m := make(map[string]interface{}, len(d))
+			for k, v := range d {
+				m[k] = expandValue(v, t.Elem())
+			}
+			return m
+		}
+	case []interface{}:
+		if t.Kind() == reflect.Slice || t.Kind() == reflect.Array {
+			l := make([]interface{}, len(d))
+			for n, v := range d {
+				l[n] = expandValue(v, t.Elem())
+			}
+			return l
+		}
+	}
+	return data
+}
+
+// expandStruct returns the values of m keyed by the names of the fields of
+// the struct type t. Untagged embedded structs are expanded from m itself.
+func expandStruct(m map[string]interface{}, t reflect.Type) map[string]interface{} {
+	out := make(map[string]interface{})
+	for n := 0; n < t.NumField(); n++ {
+		f := t.Field(n)
+		if f.PkgPath != "" {
+			continue
+		}
+		key, ok := flattenKey(f)
+		if !ok {
+			continue
+		}
+
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && !hasFlattenTag(f) && ft.Kind() == reflect.Struct {
+			out[f.Name] = expandStruct(m, ft)
+			continue
+		}
+
+		if v, ok := m[key]; ok {
+			out[f.Name] = expandValue(v, f.Type)
+		}
+	}
+	return out
+}
+
+// flattenKey returns the flattened key of the struct field f, and false if
+// the field is excluded with a "-" tag. Untagged fields, such as the Service
+// and Version of inputs, are named by converting the field name to snake
+// case.
+func flattenKey(f reflect.StructField) (string, bool) {
+	for _, tag := range flattenTags {
+		v, ok := f.Tag.Lookup(tag)
+		if !ok {
+			continue
+		}
+		parts := strings.Split(v, ",")
+		if tag == "jsonapi" {
+			switch {
+			case parts[0] == "primary":
+				return "id", true
+			case len(parts) > 1 && parts[0] == "attr":
+				return parts[1], true
+			}
+			continue
+		}
+		switch parts[0] {
+		case "-":
+			return "", false
+		case "":
+			continue
+		}
+		return parts[0], true
+	}
+	return snakeCase(f.Name), true
+}
+
+// hasFlattenTag reports whether the struct field f names its key with one of
+// the flattenTags.
+func hasFlattenTag(f reflect.StructField) bool {
+	for _, tag := range flattenTags {
+		if strings.Split(f.Tag.Get(tag), ",")[0] != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// snakeCase converts a Go field name, such as "ServiceID", to snake case, as
+// in "service_id".
+func snakeCase(s string) string {
+	r := []rune(s)
+	var b []rune
+	for n, c := range r {
+		if n > 0 && unicode.IsUpper(c) &&
+			(unicode.IsLower(r[n-1]) || (n+1 < len(r) && unicode.IsLower(r[n+1]))) {
+			b = append(b, '_')
+		}
+		b = append(b, unicode.ToLower(c))
+	}
+	return string(b)
+}
+
+// FlattenKeys returns the sorted keys Flatten can produce for v, a struct or
+// a pointer to one, whether or not they are set. It is meant for checking a
+// provider schema covers every field of a type.
+func FlattenKeys(v interface{}) []string {
+	t := reflect.TypeOf(v)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var keys []string
+	flattenStructKeys(&keys, t)
+	sort.Strings(keys)
+	return keys
+}
+
+// flattenStructKeys appends the flattened keys of the struct type t to keys.
+func flattenStructKeys(keys *[]string, t reflect.Type) {
+	for n := 0; n < t.NumField(); n++ {
+		f := t.Field(n)
+		if f.PkgPath != "" {
+			continue
+		}
+		key, ok := flattenKey(f)
+		if !ok {
+			continue
+		}
+		ft := f.Type
+		for ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		if f.Anonymous && !hasFlattenTag(f) && ft.Kind() == reflect.Struct {
+			flattenStructKeys(keys, ft)
+			continue
+		}
+		*keys = append(*keys, key)
+	}
+}
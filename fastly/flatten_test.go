@@ -0,0 +1,126 @@
+package fastly
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestFlatten_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2018, time.January, 2, 15, 4, 5, 0, time.UTC)
+	s := &Service{
+		ID:            testServiceID,
+		Name:          "test-service",
+		Type:          ServiceTypeVCL,
+		CreatedAt:     "2018-01-02T15:04:05Z",
+		ActiveVersion: 2,
+		Versions: []*Version{
+			{Number: 1, ServiceID: testServiceID},
+			{Number: 2, ServiceID: testServiceID, Active: true, CreatedAt: &created},
+		},
+	}
+
+	m, err := Flatten(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m["id"] != testServiceID || m["type"] != "vcl" || m["version"] != 2 {
+		t.Errorf("bad flattened service: %v", m)
+	}
+	if m["created_at"] != "2018-01-02T15:04:05Z" {
+		t.Errorf("bad created_at: %v", m["created_at"])
+	}
+	if _, ok := m["deleted_at"]; ok {
+		t.Errorf("expected nil deleted_at to be left out")
+	}
+	versions, ok := m["versions"].([]interface{})
+	if !ok || len(versions) != 2 {
+		t.Fatalf("bad versions: %#v", m["versions"])
+	}
+	if v := versions[1].(map[string]interface{}); v["number"] != 2 || v["active"] != true || v["created_at"] != "2018-01-02T15:04:05Z" {
+		t.Errorf("bad version: %v", v)
+	}
+
+	var out Service
+	if err := Expand(m, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&out, s) {
+		t.Errorf("expected %+v, got %+v", s, &out)
+	}
+}
+
+func TestFlatten_input(t *testing.T) {
+	t.Parallel()
+
+	i := &CreateBackendInput{
+		Service:    testServiceID,
+		Version:    1,
+		Name:       "origin",
+		Port:       443,
+		UseSSL:     CBool(true),
+		SSLCiphers: CipherList{"ECDHE-RSA-AES128-GCM-SHA256"},
+	}
+
+	m, err := Flatten(i)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m["service"] != testServiceID || m["version"] != 1 || m["port"] != 443 || m["use_ssl"] != true {
+		t.Errorf("bad flattened input: %v", m)
+	}
+
+	// Terraform reads numbers as int and may hand back strings for them.
+	m["port"] = "8443"
+	var out CreateBackendInput
+	if err := Expand(m, &out); err != nil {
+		t.Fatal(err)
+	}
+	i.Port = 8443
+	if !reflect.DeepEqual(&out, i) {
+		t.Errorf("expected %+v, got %+v", i, &out)
+	}
+}
+
+func TestFlatten_embedded(t *testing.T) {
+	t.Parallel()
+
+	d := &VersionDetail{Version: &Version{Number: 3, ServiceID: testServiceID}, Backends: 2}
+	m, err := Flatten(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m["number"] != 3 || m["backends"] != 2 {
+		t.Errorf("bad flattened detail: %v", m)
+	}
+
+	var out VersionDetail
+	if err := Expand(m, &out); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(&out, d) {
+		t.Errorf("expected %+v, got %+v", d, &out)
+	}
+}
+
+func TestFlattenKeys(t *testing.T) {
+	t.Parallel()
+
+	keys := FlattenKeys(&GetVersionInput{})
+	if !reflect.DeepEqual(keys, []string{"service", "version"}) {
+		t.Errorf("bad keys: %v", keys)
+	}
+}
+
+func TestFlatten_invalid(t *testing.T) {
+	t.Parallel()
+
+	if _, err := Flatten("backend"); err == nil {
+		t.Error("expected an error flattening a string")
+	}
+	if err := Expand(map[string]interface{}{}, Backend{}); err == nil {
+		t.Error("expected an error expanding into a non-pointer")
+	}
+}
@@ -0,0 +1,98 @@
+package fastly
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+)
+
+// DefaultFleetParallelism is the number of services RunFleet operates on at
+// once when no parallelism is given.
+const DefaultFleetParallelism = 4
+
+// FleetOperation is an operation run against a single service by RunFleet.
+// The returned value is recorded in that service's FleetResult.
+type FleetOperation func(c *Client, serviceID string) (interface{}, error)
+
+// FleetResult is the outcome of a FleetOperation for a single service.
+type FleetResult struct {
+	ServiceID string
+	Result    interface{}
+	Err       error
+}
+
+// FleetError is returned by RunFleet when the operation failed for one or
+// more services. Errors is keyed by service ID.
+type FleetError struct {
+	Errors map[string]error
+}
+
+// Error implements the error interface.
+func (e *FleetError) Error() string {
+	ids := make([]string, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "%d service(s) failed:", len(ids))
+	for _, id := range ids {
+		fmt.Fprintf(&b, "\n    %s: %s", id, e.Errors[id])
+	}
+	return b.String()
+}
+
+// RunFleetInput is used as input to the RunFleet function.
+type RunFleetInput struct {
+	// Services is the list of service IDs to operate on (required).
+	Services []string
+
+	// Operation is run once for each service (required).
+	Operation FleetOperation
+
+	// Parallelism is the maximum number of services operated on at once. The
+	// default is DefaultFleetParallelism.
+	Parallelism int
+}
+
+// RunFleet runs the given operation across a list of services concurrently,
+// with at most Parallelism operations in flight. A result is returned for
+// every service, in the same order as the input. If any operation failed, the
+// returned error is a *FleetError describing each failure.
+func (c *Client) RunFleet(i *RunFleetInput) ([]*FleetResult, error) {
+	if len(i.Services) == 0 {
+		return nil, ErrMissingServices
+	}
+
+	if i.Operation == nil {
+		return nil, ErrMissingOperation
+	}
+
+	parallelism := i.Parallelism
+	if parallelism <= 0 {
+		parallelism = DefaultFleetParallelism
+	}
+
+	results := make([]*FleetResult, len(i.Services))
+	RunBounded(parallelism, len(i.Services), func(n int) {
+		id := i.Services[n]
+		r, err := i.Operation(c, id)
+		results[n] = &FleetResult{ServiceID: id, Result: r, Err: err}
+	})
+
+	var ferr *FleetError
+	for _, r := range results {
+		if r.Err == nil {
+			continue
+		}
+		if ferr == nil {
+			ferr = &FleetError{Errors: make(map[string]error)}
+		}
+		ferr.Errors[r.ServiceID] = r.Err
+	}
+	if ferr != nil {
+		return results, ferr
+	}
+	return results, nil
+}
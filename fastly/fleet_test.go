@@ -0,0 +1,81 @@
+package fastly
+
+import (
+	"errors"
+	"sync"
+	"testing"
+)
+
+func TestClient_RunFleet(t *testing.T) {
+	t.Parallel()
+
+	errBroken := errors.New("broken")
+
+	var mu sync.Mutex
+	var inFlight, maxInFlight int
+	results, err := testClient.RunFleet(&RunFleetInput{
+		Services:    []string{"a", "b", "c", "d", "e"},
+		Parallelism: 2,
+		Operation: func(c *Client, id string) (interface{}, error) {
+			mu.Lock()
+			inFlight++
+			if inFlight > maxInFlight {
+				maxInFlight = inFlight
+			}
+			mu.Unlock()
+
+			defer func() {
+				mu.Lock()
+				inFlight--
+				mu.Unlock()
+			}()
+
+			if id == "c" {
+				return nil, errBroken
+			}
+			return id + "-done", nil
+		},
+	})
+
+	ferr, ok := err.(*FleetError)
+	if !ok {
+		t.Fatalf("bad error: %#v", err)
+	}
+	if len(ferr.Errors) != 1 || ferr.Errors["c"] != errBroken {
+		t.Errorf("bad errors: %v", ferr.Errors)
+	}
+
+	if maxInFlight > 2 {
+		t.Errorf("bad parallelism: %d", maxInFlight)
+	}
+
+	if len(results) != 5 {
+		t.Fatalf("bad results: %v", results)
+	}
+	for i, id := range []string{"a", "b", "c", "d", "e"} {
+		if results[i].ServiceID != id {
+			t.Errorf("bad service %d: %q", i, results[i].ServiceID)
+		}
+	}
+	if results[0].Result != "a-done" {
+		t.Errorf("bad result: %v", results[0].Result)
+	}
+	if results[2].Err != errBroken {
+		t.Errorf("bad error: %s", results[2].Err)
+	}
+}
+
+func TestClient_RunFleet_validation(t *testing.T) {
+	var err error
+	_, err = testClient.RunFleet(&RunFleetInput{})
+	if err != ErrMissingServices {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.RunFleet(&RunFleetInput{
+		Services: []string{"foo"},
+	})
+	if err != ErrMissingOperation {
+		t.Errorf("bad error: %s", err)
+	}
+}
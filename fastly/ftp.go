@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"net/url"
 	"sort"
 	"time"
 )
@@ -11,20 +12,33 @@ type FTP struct {
 	ServiceID string `mapstructure:"service_id"`
 	Version   int    `mapstructure:"version"`
 
-	Name              string     `mapstructure:"name"`
-	Address           string     `mapstructure:"address"`
-	Port              uint       `mapstructure:"port"`
-	Username          string     `mapstructure:"user"`
-	Password          string     `mapstructure:"password"`
-	Path              string     `mapstructure:"path"`
-	Period            uint       `mapstructure:"period"`
-	GzipLevel         uint8      `mapstructure:"gzip_level"`
-	Format            string     `mapstructure:"format"`
-	ResponseCondition string     `mapstructure:"response_condition"`
-	TimestampFormat   string     `mapstructure:"timestamp_format"`
-	CreatedAt         *time.Time `mapstructure:"created_at"`
-	UpdatedAt         *time.Time `mapstructure:"updated_at"`
-	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+	Name              string           `mapstructure:"name"`
+	Address           string           `mapstructure:"address"`
+	Port              uint             `mapstructure:"port"`
+	Username          string           `mapstructure:"user"`
+	Password          string           `mapstructure:"password" sensitive:"true"`
+	Path              string           `mapstructure:"path"`
+	Period            uint             `mapstructure:"period"`
+	GzipLevel         uint8            `mapstructure:"gzip_level"`
+	FileMaxBytes      uint             `mapstructure:"file_max_bytes"`
+	CompressionCodec  CompressionCodec `mapstructure:"compression_codec"`
+	Format            string           `mapstructure:"format"`
+	ResponseCondition string           `mapstructure:"response_condition"`
+	TimestampFormat   string           `mapstructure:"timestamp_format"`
+	PublicKey         string           `mapstructure:"public_key"`
+	CreatedAt         *time.Time       `mapstructure:"created_at"`
+	UpdatedAt         *time.Time       `mapstructure:"updated_at"`
+	DeletedAt         *time.Time       `mapstructure:"deleted_at"`
+}
+
+// String formats the FTP endpoint with its password redacted.
+func (f *FTP) String() string {
+	return redactedString(f)
+}
+
+// GoString is like String, for the %#v verb.
+func (f *FTP) GoString() string {
+	return redactedGoString(f)
 }
 
 // ftpsByName is a sortable list of ftps.
@@ -77,17 +91,20 @@ type CreateFTPInput struct {
 	Service string
 	Version int
 
-	Name              string `form:"name,omitempty"`
-	Address           string `form:"address,omitempty"`
-	Port              uint   `form:"port,omitempty"`
-	Username          string `form:"user,omitempty"`
-	Password          string `form:"password,omitempty"`
-	Path              string `form:"path,omitempty"`
-	Period            uint   `form:"period,omitempty"`
-	GzipLevel         uint8  `form:"gzip_level,omitempty"`
-	Format            string `form:"format,omitempty"`
-	ResponseCondition string `form:"response_condition,omitempty"`
-	TimestampFormat   string `form:"timestamp_format,omitempty"`
+	Name              string           `form:"name,omitempty"`
+	Address           string           `form:"address,omitempty"`
+	Port              uint             `form:"port,omitempty"`
+	Username          string           `form:"user,omitempty"`
+	Password          string           `form:"password,omitempty" sensitive:"true"`
+	Path              string           `form:"path,omitempty"`
+	Period            uint             `form:"period,omitempty"`
+	GzipLevel         uint8            `form:"gzip_level,omitempty"`
+	FileMaxBytes      uint             `form:"file_max_bytes,omitempty"`
+	CompressionCodec  CompressionCodec `form:"compression_codec,omitempty"`
+	Format            string           `form:"format,omitempty"`
+	ResponseCondition string           `form:"response_condition,omitempty"`
+	TimestampFormat   string           `form:"timestamp_format,omitempty"`
+	PublicKey         string           `form:"public_key,omitempty"`
 }
 
 // CreateFTP creates a new Fastly FTP.
@@ -100,8 +117,16 @@ func (c *Client) CreateFTP(i *CreateFTPInput) (*FTP, error) {
 		return nil, ErrMissingVersion
 	}
 
+	if i.CompressionCodec != "" && i.GzipLevel != 0 {
+		return nil, ErrCompressionCodecGzipLevel
+	}
+
+	if err := validateLoggingBatching(uint(i.GzipLevel), i.FileMaxBytes); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/ftp", i.Service, i.Version)
-	resp, err := c.PostForm(path, i, nil)
+	resp, err := c.PostForm(path, c.withLoggingDefaults(i), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -138,7 +163,7 @@ func (c *Client) GetFTP(i *GetFTPInput) (*FTP, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/ftp/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/logging/ftp/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Get(path, nil)
 	if err != nil {
 		return nil, err
@@ -161,17 +186,20 @@ type UpdateFTPInput struct {
 	// Name is the name of the FTP to update.
 	Name string
 
-	NewName           string `form:"name,omitempty"`
-	Address           string `form:"address,omitempty"`
-	Port              uint   `form:"port,omitempty"`
-	Username          string `form:"user,omitempty"`
-	Password          string `form:"password,omitempty"`
-	Path              string `form:"path,omitempty"`
-	Period            uint   `form:"period,omitempty"`
-	GzipLevel         uint8  `form:"gzip_level,omitempty"`
-	Format            string `form:"format,omitempty"`
-	ResponseCondition string `form:"response_condition,omitempty"`
-	TimestampFormat   string `form:"timestamp_format,omitempty"`
+	NewName           string           `form:"name,omitempty"`
+	Address           string           `form:"address,omitempty"`
+	Port              uint             `form:"port,omitempty"`
+	Username          string           `form:"user,omitempty"`
+	Password          string           `form:"password,omitempty" sensitive:"true"`
+	Path              string           `form:"path,omitempty"`
+	Period            uint             `form:"period,omitempty"`
+	GzipLevel         uint8            `form:"gzip_level,omitempty"`
+	FileMaxBytes      uint             `form:"file_max_bytes,omitempty"`
+	CompressionCodec  CompressionCodec `form:"compression_codec,omitempty"`
+	Format            string           `form:"format,omitempty"`
+	ResponseCondition string           `form:"response_condition,omitempty"`
+	TimestampFormat   string           `form:"timestamp_format,omitempty"`
+	PublicKey         string           `form:"public_key,omitempty"`
 }
 
 // UpdateFTP updates a specific FTP.
@@ -188,7 +216,15 @@ func (c *Client) UpdateFTP(i *UpdateFTPInput) (*FTP, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/ftp/%s", i.Service, i.Version, i.Name)
+	if i.CompressionCodec != "" && i.GzipLevel != 0 {
+		return nil, ErrCompressionCodecGzipLevel
+	}
+
+	if err := validateLoggingBatching(uint(i.GzipLevel), i.FileMaxBytes); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/ftp/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
 		return nil, err
@@ -226,7 +262,7 @@ func (c *Client) DeleteFTP(i *DeleteFTPInput) error {
 		return ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/ftp/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/logging/ftp/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Delete(path, nil)
 	if err != nil {
 		return err
@@ -203,6 +203,34 @@ func TestClient_CreateFTP_validation(t *testing.T) {
 	if err != ErrMissingVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateFTP(&CreateFTPInput{
+		Service:          "foo",
+		Version:          1,
+		GzipLevel:        9,
+		CompressionCodec: CompressionCodecZstd,
+	})
+	if err != ErrCompressionCodecGzipLevel {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateFTP(&CreateFTPInput{
+		Service:   "foo",
+		Version:   1,
+		GzipLevel: 10,
+	})
+	if err != ErrInvalidGzipLevel {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateFTP(&CreateFTPInput{
+		Service:      "foo",
+		Version:      1,
+		FileMaxBytes: 1024,
+	})
+	if err != ErrInvalidFileMaxBytes {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetFTP_validation(t *testing.T) {
@@ -257,6 +285,37 @@ func TestClient_UpdateFTP_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateFTP(&UpdateFTPInput{
+		Service:          "foo",
+		Version:          1,
+		Name:             "bar",
+		GzipLevel:        9,
+		CompressionCodec: CompressionCodecSnappy,
+	})
+	if err != ErrCompressionCodecGzipLevel {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateFTP(&UpdateFTPInput{
+		Service:   "foo",
+		Version:   1,
+		Name:      "bar",
+		GzipLevel: 10,
+	})
+	if err != ErrInvalidGzipLevel {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateFTP(&UpdateFTPInput{
+		Service:      "foo",
+		Version:      1,
+		Name:         "bar",
+		FileMaxBytes: 1024,
+	})
+	if err != ErrInvalidFileMaxBytes {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteFTP_validation(t *testing.T) {
@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"net/url"
 	"sort"
 )
 
@@ -10,17 +11,30 @@ type GCS struct {
 	ServiceID string `mapstructure:"service_id"`
 	Version   int    `mapstructure:"version"`
 
-	Name              string `mapstructure:"name"`
-	Bucket            string `mapstructure:"bucket_name"`
-	User              string `mapstructure:"user"`
-	SecretKey         string `mapstructure:"secret_key"`
-	Path              string `mapstructure:"path"`
-	Period            uint   `mapstructure:"period"`
-	GzipLevel         uint8  `mapstructure:"gzip_level"`
-	Format            string `mapstructure:"format"`
-	MessageType       string `mapstructure:"message_type"`
-	ResponseCondition string `mapstructure:"response_condition"`
-	TimestampFormat   string `mapstructure:"timestamp_format"`
+	Name              string           `mapstructure:"name"`
+	Bucket            string           `mapstructure:"bucket_name"`
+	User              string           `mapstructure:"user"`
+	SecretKey         string           `mapstructure:"secret_key" sensitive:"true"`
+	Path              string           `mapstructure:"path"`
+	Period            uint             `mapstructure:"period"`
+	GzipLevel         uint8            `mapstructure:"gzip_level"`
+	FileMaxBytes      uint             `mapstructure:"file_max_bytes"`
+	CompressionCodec  CompressionCodec `mapstructure:"compression_codec"`
+	Format            string           `mapstructure:"format"`
+	MessageType       string           `mapstructure:"message_type"`
+	ResponseCondition string           `mapstructure:"response_condition"`
+	TimestampFormat   string           `mapstructure:"timestamp_format"`
+	PublicKey         string           `mapstructure:"public_key"`
+}
+
+// String formats the GCS endpoint with its secret key redacted.
+func (g *GCS) String() string {
+	return redactedString(g)
+}
+
+// GoString is like String, for the %#v verb.
+func (g *GCS) GoString() string {
+	return redactedGoString(g)
 }
 
 // gcsesByName is a sortable list of gcses.
@@ -73,17 +87,20 @@ type CreateGCSInput struct {
 	Service string
 	Version int
 
-	Name              string `form:"name,omitempty"`
-	Bucket            string `form:"bucket_name,omitempty"`
-	User              string `form:"user,omitempty"`
-	SecretKey         string `form:"secret_key,omitempty"`
-	Path              string `form:"path,omitempty"`
-	Period            uint   `form:"period,omitempty"`
-	GzipLevel         uint8  `form:"gzip_level,omitempty"`
-	Format            string `form:"format,omitempty"`
-	MessageType       string `form:"message_type,omitempty"`
-	ResponseCondition string `form:"response_condition,omitempty"`
-	TimestampFormat   string `form:"timestamp_format,omitempty"`
+	Name              string           `form:"name,omitempty"`
+	Bucket            string           `form:"bucket_name,omitempty"`
+	User              string           `form:"user,omitempty"`
+	SecretKey         string           `form:"secret_key,omitempty" sensitive:"true"`
+	Path              string           `form:"path,omitempty"`
+	Period            uint             `form:"period,omitempty"`
+	GzipLevel         uint8            `form:"gzip_level,omitempty"`
+	FileMaxBytes      uint             `form:"file_max_bytes,omitempty"`
+	CompressionCodec  CompressionCodec `form:"compression_codec,omitempty"`
+	Format            string           `form:"format,omitempty"`
+	MessageType       string           `form:"message_type,omitempty"`
+	ResponseCondition string           `form:"response_condition,omitempty"`
+	TimestampFormat   string           `form:"timestamp_format,omitempty"`
+	PublicKey         string           `form:"public_key,omitempty"`
 }
 
 // CreateGCS creates a new Fastly GCS.
@@ -96,8 +113,16 @@ func (c *Client) CreateGCS(i *CreateGCSInput) (*GCS, error) {
 		return nil, ErrMissingVersion
 	}
 
+	if i.CompressionCodec != "" && i.GzipLevel != 0 {
+		return nil, ErrCompressionCodecGzipLevel
+	}
+
+	if err := validateLoggingBatching(uint(i.GzipLevel), i.FileMaxBytes); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/gcs", i.Service, i.Version)
-	resp, err := c.PostForm(path, i, nil)
+	resp, err := c.PostForm(path, c.withLoggingDefaults(i), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -134,7 +159,7 @@ func (c *Client) GetGCS(i *GetGCSInput) (*GCS, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/gcs/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/logging/gcs/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Get(path, nil)
 	if err != nil {
 		return nil, err
@@ -157,16 +182,19 @@ type UpdateGCSInput struct {
 	// Name is the name of the GCS to update.
 	Name string
 
-	NewName           string `form:"name,omitempty"`
-	Bucket            string `form:"bucket_name,omitempty"`
-	User              string `form:"user,omitempty"`
-	SecretKey         string `form:"secret_key,omitempty"`
-	Path              string `form:"path,omitempty"`
-	Period            uint   `form:"period,omitempty"`
-	GzipLevel         uint8  `form:"gzip_level,omitempty"`
-	Format            string `form:"format,omitempty"`
-	ResponseCondition string `form:"response_condition,omitempty"`
-	TimestampFormat   string `form:"timestamp_format,omitempty"`
+	NewName           string           `form:"name,omitempty"`
+	Bucket            string           `form:"bucket_name,omitempty"`
+	User              string           `form:"user,omitempty"`
+	SecretKey         string           `form:"secret_key,omitempty" sensitive:"true"`
+	Path              string           `form:"path,omitempty"`
+	Period            uint             `form:"period,omitempty"`
+	GzipLevel         uint8            `form:"gzip_level,omitempty"`
+	FileMaxBytes      uint             `form:"file_max_bytes,omitempty"`
+	CompressionCodec  CompressionCodec `form:"compression_codec,omitempty"`
+	Format            string           `form:"format,omitempty"`
+	ResponseCondition string           `form:"response_condition,omitempty"`
+	TimestampFormat   string           `form:"timestamp_format,omitempty"`
+	PublicKey         string           `form:"public_key,omitempty"`
 }
 
 // UpdateGCS updates a specific GCS.
@@ -183,7 +211,15 @@ func (c *Client) UpdateGCS(i *UpdateGCSInput) (*GCS, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/gcs/%s", i.Service, i.Version, i.Name)
+	if i.CompressionCodec != "" && i.GzipLevel != 0 {
+		return nil, ErrCompressionCodecGzipLevel
+	}
+
+	if err := validateLoggingBatching(uint(i.GzipLevel), i.FileMaxBytes); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/gcs/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
 		return nil, err
@@ -221,7 +257,7 @@ func (c *Client) DeleteGCS(i *DeleteGCSInput) error {
 		return ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/gcs/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/logging/gcs/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Delete(path, nil)
 	if err != nil {
 		return err
@@ -203,6 +203,34 @@ func TestClient_CreateGCS_validation(t *testing.T) {
 	if err != ErrMissingVersion {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.CreateGCS(&CreateGCSInput{
+		Service:          "foo",
+		Version:          1,
+		GzipLevel:        9,
+		CompressionCodec: CompressionCodecZstd,
+	})
+	if err != ErrCompressionCodecGzipLevel {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateGCS(&CreateGCSInput{
+		Service:   "foo",
+		Version:   1,
+		GzipLevel: 10,
+	})
+	if err != ErrInvalidGzipLevel {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateGCS(&CreateGCSInput{
+		Service:      "foo",
+		Version:      1,
+		FileMaxBytes: 1024,
+	})
+	if err != ErrInvalidFileMaxBytes {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_GetGCS_validation(t *testing.T) {
@@ -257,6 +285,27 @@ func TestClient_UpdateGCS_validation(t *testing.T) {
 	if err != ErrMissingName {
 		t.Errorf("bad error: %s", err)
 	}
+
+	_, err = testClient.UpdateGCS(&UpdateGCSInput{
+		Service:          "foo",
+		Version:          1,
+		Name:             "bar",
+		GzipLevel:        9,
+		CompressionCodec: CompressionCodecSnappy,
+	})
+	if err != ErrCompressionCodecGzipLevel {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateGCS(&UpdateGCSInput{
+		Service:      "foo",
+		Version:      1,
+		Name:         "bar",
+		FileMaxBytes: 1024,
+	})
+	if err != ErrInvalidFileMaxBytes {
+		t.Errorf("bad error: %s", err)
+	}
 }
 
 func TestClient_DeleteGCS_validation(t *testing.T) {
@@ -0,0 +1,247 @@
+package fastly
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// GrafanaCloudLogs represents a Grafana Cloud Logs logging response from the Fastly API.
+type GrafanaCloudLogs struct {
+	ServiceID string `mapstructure:"service_id"`
+	Version   int    `mapstructure:"version"`
+
+	Name              string     `mapstructure:"name"`
+	URL               string     `mapstructure:"url"`
+	User              string     `mapstructure:"user"`
+	Token             string     `mapstructure:"token" sensitive:"true"`
+	Index             string     `mapstructure:"index"`
+	Format            string     `mapstructure:"format"`
+	FormatVersion     uint       `mapstructure:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition"`
+	CreatedAt         *time.Time `mapstructure:"created_at"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+}
+
+// String formats the Grafana Cloud Logs endpoint with its token redacted.
+func (g *GrafanaCloudLogs) String() string {
+	return redactedString(g)
+}
+
+// GoString is like String, for the %#v verb.
+func (g *GrafanaCloudLogs) GoString() string {
+	return redactedGoString(g)
+}
+
+// grafanaCloudLogsByName is a sortable list of Grafana Cloud Logs endpoints.
+type grafanaCloudLogsByName []*GrafanaCloudLogs
+
+// Len, Swap, and Less implement the sortable interface.
+func (s grafanaCloudLogsByName) Len() int      { return len(s) }
+func (s grafanaCloudLogsByName) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s grafanaCloudLogsByName) Less(i, j int) bool {
+	return s[i].Name < s[j].Name
+}
+
+// ListGrafanaCloudLogsInput is used as input to the ListGrafanaCloudLogs function.
+type ListGrafanaCloudLogsInput struct {
+	// Service is the ID of the service (required).
+	Service string
+
+	// Version is the specific configuration version (required).
+	Version int
+}
+
+// ListGrafanaCloudLogs returns the list of Grafana Cloud Logs endpoints for the configuration
+// version.
+func (c *Client) ListGrafanaCloudLogs(i *ListGrafanaCloudLogsInput) ([]*GrafanaCloudLogs, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/grafanacloudlogs", i.Service, i.Version)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var gs []*GrafanaCloudLogs
+	if err := decodeJSON(&gs, resp.Body); err != nil {
+		return nil, err
+	}
+	sort.Stable(grafanaCloudLogsByName(gs))
+	return gs, nil
+}
+
+// CreateGrafanaCloudLogsInput is used as input to the CreateGrafanaCloudLogs function.
+type CreateGrafanaCloudLogsInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	Name              string `form:"name,omitempty"`
+	URL               string `form:"url,omitempty"`
+	User              string `form:"user,omitempty"`
+	Token             string `form:"token,omitempty" sensitive:"true"`
+	Index             string `form:"index,omitempty"`
+	Format            string `form:"format,omitempty"`
+	FormatVersion     uint   `form:"format_version,omitempty"`
+	ResponseCondition string `form:"response_condition,omitempty"`
+	Placement         string `form:"placement,omitempty"`
+}
+
+// CreateGrafanaCloudLogs creates a new Fastly Grafana Cloud Logs logging endpoint.
+func (c *Client) CreateGrafanaCloudLogs(i *CreateGrafanaCloudLogsInput) (*GrafanaCloudLogs, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/grafanacloudlogs", i.Service, i.Version)
+	resp, err := c.PostForm(path, c.withLoggingDefaults(i), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var g *GrafanaCloudLogs
+	if err := decodeJSON(&g, resp.Body); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// GetGrafanaCloudLogsInput is used as input to the GetGrafanaCloudLogs function.
+type GetGrafanaCloudLogsInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the Grafana Cloud Logs endpoint to fetch.
+	Name string
+}
+
+// GetGrafanaCloudLogs gets the Grafana Cloud Logs logging configuration with the given parameters.
+func (c *Client) GetGrafanaCloudLogs(i *GetGrafanaCloudLogsInput) (*GrafanaCloudLogs, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/grafanacloudlogs/%s", i.Service, i.Version, url.PathEscape(i.Name))
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var g *GrafanaCloudLogs
+	if err := decodeJSON(&g, resp.Body); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// UpdateGrafanaCloudLogsInput is used as input to the UpdateGrafanaCloudLogs function.
+type UpdateGrafanaCloudLogsInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the Grafana Cloud Logs endpoint to update.
+	Name string
+
+	NewName           string `form:"name,omitempty"`
+	URL               string `form:"url,omitempty"`
+	User              string `form:"user,omitempty"`
+	Token             string `form:"token,omitempty" sensitive:"true"`
+	Index             string `form:"index,omitempty"`
+	Format            string `form:"format,omitempty"`
+	FormatVersion     uint   `form:"format_version,omitempty"`
+	ResponseCondition string `form:"response_condition,omitempty"`
+}
+
+// UpdateGrafanaCloudLogs updates a specific Grafana Cloud Logs logging endpoint.
+func (c *Client) UpdateGrafanaCloudLogs(i *UpdateGrafanaCloudLogsInput) (*GrafanaCloudLogs, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/grafanacloudlogs/%s", i.Service, i.Version, url.PathEscape(i.Name))
+	resp, err := c.PutForm(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var g *GrafanaCloudLogs
+	if err := decodeJSON(&g, resp.Body); err != nil {
+		return nil, err
+	}
+	return g, nil
+}
+
+// DeleteGrafanaCloudLogsInput is the input parameter to DeleteGrafanaCloudLogs.
+type DeleteGrafanaCloudLogsInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the Grafana Cloud Logs endpoint to delete (required).
+	Name string
+}
+
+// DeleteGrafanaCloudLogs deletes the given Grafana Cloud Logs logging endpoint.
+func (c *Client) DeleteGrafanaCloudLogs(i *DeleteGrafanaCloudLogsInput) error {
+	if i.Service == "" {
+		return ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return ErrMissingName
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/grafanacloudlogs/%s", i.Service, i.Version, url.PathEscape(i.Name))
+	resp, err := c.Delete(path, nil)
+	if err != nil {
+		return err
+	}
+
+	var r *statusResp
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return err
+	}
+	if !r.Ok() {
+		return fmt.Errorf("Not Ok")
+	}
+	return nil
+}
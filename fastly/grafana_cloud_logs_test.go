@@ -0,0 +1,266 @@
+package fastly
+
+import "testing"
+
+func TestClient_GrafanaCloudLogs(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var tv *Version
+	record(t, "grafana_cloud_logs/version", func(c *Client) {
+		tv = testVersion(t, c)
+	})
+
+	// Create
+	var g *GrafanaCloudLogs
+	record(t, "grafana_cloud_logs/create", func(c *Client) {
+		g, err = c.CreateGrafanaCloudLogs(&CreateGrafanaCloudLogsInput{
+			Service:       testServiceID,
+			Version:       tv.Number,
+			Name:          "test-grafana",
+			URL:           "https://logs-prod-us-central1.grafana.net",
+			User:          "123456",
+			Token:         "abcd1234",
+			Index:         "{job=\"fastly\"}",
+			Format:        "format",
+			FormatVersion: 2,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure deleted
+	defer func() {
+		record(t, "grafana_cloud_logs/cleanup", func(c *Client) {
+			c.DeleteGrafanaCloudLogs(&DeleteGrafanaCloudLogsInput{
+				Service: testServiceID,
+				Version: tv.Number,
+				Name:    "test-grafana",
+			})
+
+			c.DeleteGrafanaCloudLogs(&DeleteGrafanaCloudLogsInput{
+				Service: testServiceID,
+				Version: tv.Number,
+				Name:    "new-test-grafana",
+			})
+		})
+	}()
+
+	if g.Name != "test-grafana" {
+		t.Errorf("bad name: %q", g.Name)
+	}
+	if g.URL != "https://logs-prod-us-central1.grafana.net" {
+		t.Errorf("bad url: %q", g.URL)
+	}
+	if g.User != "123456" {
+		t.Errorf("bad user: %q", g.User)
+	}
+	if g.Token != "abcd1234" {
+		t.Errorf("bad token: %q", g.Token)
+	}
+	if g.Index != "{job=\"fastly\"}" {
+		t.Errorf("bad index: %q", g.Index)
+	}
+	if g.Format != "format" {
+		t.Errorf("bad format: %q", g.Format)
+	}
+	if g.FormatVersion != 2 {
+		t.Errorf("bad format_version: %d", g.FormatVersion)
+	}
+
+	// List
+	var gs []*GrafanaCloudLogs
+	record(t, "grafana_cloud_logs/list", func(c *Client) {
+		gs, err = c.ListGrafanaCloudLogs(&ListGrafanaCloudLogsInput{
+			Service: testServiceID,
+			Version: tv.Number,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(gs) < 1 {
+		t.Errorf("bad grafana_cloud_logs: %v", gs)
+	}
+
+	// Get
+	var ng *GrafanaCloudLogs
+	record(t, "grafana_cloud_logs/get", func(c *Client) {
+		ng, err = c.GetGrafanaCloudLogs(&GetGrafanaCloudLogsInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Name:    "test-grafana",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if g.Name != ng.Name {
+		t.Errorf("bad name: %q", g.Name)
+	}
+	if g.URL != ng.URL {
+		t.Errorf("bad url: %q", g.URL)
+	}
+	if g.User != ng.User {
+		t.Errorf("bad user: %q", g.User)
+	}
+	if g.Token != ng.Token {
+		t.Errorf("bad token: %q", g.Token)
+	}
+	if g.Index != ng.Index {
+		t.Errorf("bad index: %q", g.Index)
+	}
+	if g.Format != ng.Format {
+		t.Errorf("bad format: %q", g.Format)
+	}
+	if g.FormatVersion != ng.FormatVersion {
+		t.Errorf("bad format_version: %d", g.FormatVersion)
+	}
+
+	// Update
+	var ug *GrafanaCloudLogs
+	record(t, "grafana_cloud_logs/update", func(c *Client) {
+		ug, err = c.UpdateGrafanaCloudLogs(&UpdateGrafanaCloudLogsInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Name:    "test-grafana",
+			NewName: "new-test-grafana",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ug.Name != "new-test-grafana" {
+		t.Errorf("bad name: %q", ug.Name)
+	}
+
+	// Delete
+	record(t, "grafana_cloud_logs/delete", func(c *Client) {
+		err = c.DeleteGrafanaCloudLogs(&DeleteGrafanaCloudLogsInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Name:    "new-test-grafana",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_ListGrafanaCloudLogs_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListGrafanaCloudLogs(&ListGrafanaCloudLogsInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ListGrafanaCloudLogs(&ListGrafanaCloudLogsInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_CreateGrafanaCloudLogs_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateGrafanaCloudLogs(&CreateGrafanaCloudLogsInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateGrafanaCloudLogs(&CreateGrafanaCloudLogsInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetGrafanaCloudLogs_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetGrafanaCloudLogs(&GetGrafanaCloudLogsInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetGrafanaCloudLogs(&GetGrafanaCloudLogsInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetGrafanaCloudLogs(&GetGrafanaCloudLogsInput{
+		Service: "foo",
+		Version: 1,
+		Name:    "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdateGrafanaCloudLogs_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateGrafanaCloudLogs(&UpdateGrafanaCloudLogsInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateGrafanaCloudLogs(&UpdateGrafanaCloudLogsInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateGrafanaCloudLogs(&UpdateGrafanaCloudLogsInput{
+		Service: "foo",
+		Version: 1,
+		Name:    "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_DeleteGrafanaCloudLogs_validation(t *testing.T) {
+	var err error
+	err = testClient.DeleteGrafanaCloudLogs(&DeleteGrafanaCloudLogsInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteGrafanaCloudLogs(&DeleteGrafanaCloudLogsInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteGrafanaCloudLogs(&DeleteGrafanaCloudLogsInput{
+		Service: "foo",
+		Version: 1,
+		Name:    "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -0,0 +1,114 @@
+package fastly
+
+import (
+	"sync"
+	"time"
+)
+
+// DefaultGroupParallelism is the number of functions a Group runs at once
+// when no parallelism is given.
+const DefaultGroupParallelism = 4
+
+// Group runs functions concurrently with at most Parallelism running at
+// once, and, when Interval is set, at most one started per Interval, so a
+// workflow of many API calls stays clear of the API's rate limits. Like an
+// errgroup, Wait waits for every function and returns the first error. A
+// Group is the mechanism behind RunFleet and the other bulk helpers; its
+// zero value is ready to use, and its fields must not change once Go has
+// been called.
+type Group struct {
+	// Parallelism is the maximum number of functions running at once. The
+	// default is DefaultGroupParallelism.
+	Parallelism int
+
+	// Interval is the minimum time between the starts of two functions.
+	// Optional; by default functions start as soon as there is room.
+	Interval time.Duration
+
+	// StopOnError skips the functions which have not started yet once one
+	// has returned an error.
+	StopOnError bool
+
+	once sync.Once
+	sem  chan struct{}
+	wg   sync.WaitGroup
+
+	// next is the earliest time the next function may start.
+	rateMu sync.Mutex
+	next   time.Time
+
+	errMu sync.Mutex
+	err   error
+}
+
+// Go runs fn in a new goroutine. It blocks until there is room for it under
+// Parallelism and Interval.
+func (g *Group) Go(fn func() error) {
+	g.once.Do(func() {
+		limit := g.Parallelism
+		if limit <= 0 {
+			limit = DefaultGroupParallelism
+		}
+		g.sem = make(chan struct{}, limit)
+	})
+
+	g.sem <- struct{}{}
+	if g.Interval > 0 {
+		g.rateMu.Lock()
+		now := time.Now()
+		if wait := g.next.Sub(now); wait > 0 {
+			time.Sleep(wait)
+			now = g.next
+		}
+		g.next = now.Add(g.Interval)
+		g.rateMu.Unlock()
+	}
+
+	g.wg.Add(1)
+	go func() {
+		defer g.wg.Done()
+		defer func() { <-g.sem }()
+
+		if g.StopOnError && g.Err() != nil {
+			return
+		}
+		if err := fn(); err != nil {
+			g.errMu.Lock()
+			if g.err == nil {
+				g.err = err
+			}
+			g.errMu.Unlock()
+		}
+	}()
+}
+
+// Wait waits for every function started with Go to return, and returns the
+// first error any of them returned.
+func (g *Group) Wait() error {
+	g.wg.Wait()
+	return g.Err()
+}
+
+// Err returns the first error returned by a function so far, without
+// waiting for the others.
+func (g *Group) Err() error {
+	g.errMu.Lock()
+	defer g.errMu.Unlock()
+	return g.err
+}
+
+// RunBounded calls fn for each index in [0, n) with a Group of the given
+// parallelism, and returns once every call has finished. It is a shorthand
+// for operations which record their own results, such as into a slice
+// indexed by n.
+func RunBounded(parallelism, n int, fn func(n int)) {
+	g := &Group{Parallelism: parallelism}
+	for i := 0; i < n; i++ {
+		i := i
+		g.Go(func() error {
+			fn(i)
+			return nil
+		})
+	}
+	g.Wait()
+}
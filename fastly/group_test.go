@@ -0,0 +1,109 @@
+package fastly
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestGroup_parallelism(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	var running, peak int
+	g := &Group{Parallelism: 2}
+	for i := 0; i < 8; i++ {
+		g.Go(func() error {
+			mu.Lock()
+			running++
+			if running > peak {
+				peak = running
+			}
+			mu.Unlock()
+
+			time.Sleep(5 * time.Millisecond)
+
+			mu.Lock()
+			running--
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := g.Wait(); err != nil {
+		t.Fatal(err)
+	}
+	if peak != 2 {
+		t.Errorf("expected 2 running at once, got %d", peak)
+	}
+}
+
+func TestGroup_interval(t *testing.T) {
+	t.Parallel()
+
+	start := time.Now()
+	g := &Group{Parallelism: 4, Interval: 20 * time.Millisecond}
+	for i := 0; i < 3; i++ {
+		g.Go(func() error { return nil })
+	}
+	g.Wait()
+
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("expected starts 20ms apart, took %s", elapsed)
+	}
+}
+
+func TestGroup_errors(t *testing.T) {
+	t.Parallel()
+
+	first := errors.New("first")
+	var mu sync.Mutex
+	var ran int
+	g := &Group{Parallelism: 1, StopOnError: true}
+	g.Go(func() error { return first })
+	for i := 0; i < 3; i++ {
+		g.Go(func() error {
+			mu.Lock()
+			ran++
+			mu.Unlock()
+			return errors.New("later")
+		})
+	}
+	if err := g.Wait(); err != first {
+		t.Errorf("bad error: %v", err)
+	}
+	if ran != 0 {
+		t.Errorf("expected later functions to be skipped, %d ran", ran)
+	}
+
+	// The zero value runs every function and keeps going after an error.
+	var z Group
+	ran = 0
+	z.Go(func() error { return first })
+	z.Go(func() error {
+		mu.Lock()
+		ran++
+		mu.Unlock()
+		return nil
+	})
+	if err := z.Wait(); err != first {
+		t.Errorf("bad error: %v", err)
+	}
+	if ran != 1 {
+		t.Errorf("expected the second function to run, %d ran", ran)
+	}
+}
+
+func TestRunBounded(t *testing.T) {
+	t.Parallel()
+
+	results := make([]int, 10)
+	RunBounded(3, len(results), func(n int) {
+		results[n] = n * n
+	})
+	for n, r := range results {
+		if r != n*n {
+			t.Errorf("bad result %d: %d", n, r)
+		}
+	}
+}
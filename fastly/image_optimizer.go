@@ -0,0 +1,246 @@
+package fastly
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// ImageOptimizerHeader is the request header which sends a request to
+	// Image Optimizer.
+	ImageOptimizerHeader = "x-fastly-imageopto-api"
+
+	// ImageOptimizerDefaultName is the name given to the condition and header
+	// created by EnableImageOptimizer when no name is set.
+	ImageOptimizerDefaultName = "Image Optimizer"
+)
+
+// ImageOptimizerDefaultExtensions are the file extensions sent to Image
+// Optimizer when no extensions or condition are set.
+var ImageOptimizerDefaultExtensions = []string{"gif", "png", "jpg", "jpeg", "webp"}
+
+// ImageOptimizerConfig is the configuration which sends requests to Image
+// Optimizer: a request condition selecting image requests and a header object
+// setting ImageOptimizerHeader on them.
+type ImageOptimizerConfig struct {
+	Condition *Condition
+	Header    *Header
+
+	// Conflicts are the request settings which pass every request, and so
+	// images, to the origin. Image Optimizer only transforms images fetched
+	// through the cache, so these must be removed or given a condition
+	// excluding images.
+	Conflicts []*RequestSetting
+}
+
+// EnableImageOptimizerInput is used as input to the EnableImageOptimizer
+// function.
+type EnableImageOptimizerInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the condition and header. Optional, defaults to
+	// ImageOptimizerDefaultName.
+	Name string
+
+	// Extensions are the file extensions of the images to optimize, matched
+	// case-insensitively. Statement replaces the generated condition with a
+	// custom one. Both are optional; by default requests for
+	// ImageOptimizerDefaultExtensions are optimized.
+	Extensions []string
+	Statement  string
+
+	// PassQueryParameters sends the query parameters which are not Image
+	// Optimizer parameters on to the origin. By default they are removed.
+	PassQueryParameters bool
+}
+
+// EnableImageOptimizer creates or updates the condition and header which send
+// image requests on a service version to Image Optimizer, and reports any
+// request settings which stop it working. The image_optimizer product must
+// also be enabled on the service.
+func (c *Client) EnableImageOptimizer(i *EnableImageOptimizerInput) (*ImageOptimizerConfig, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	name := i.Name
+	if name == "" {
+		name = ImageOptimizerDefaultName
+	}
+
+	statement := i.Statement
+	if statement == "" {
+		extensions := i.Extensions
+		if len(extensions) == 0 {
+			extensions = ImageOptimizerDefaultExtensions
+		}
+		statement = imageOptimizerStatement(extensions)
+	}
+
+	value := "fastly"
+	if i.PassQueryParameters {
+		value += "; qp=*"
+	}
+
+	cfg := &ImageOptimizerConfig{}
+
+	var err error
+	cfg.Condition, err = c.GetCondition(&GetConditionInput{
+		Service: i.Service,
+		Version: i.Version,
+		Name:    name,
+	})
+	switch {
+	case err == nil:
+		if cfg.Condition.Type != "REQUEST" {
+			return nil, fmt.Errorf("condition %q is a %s condition, not a REQUEST condition", name, cfg.Condition.Type)
+		}
+		if cfg.Condition.Statement != statement {
+			cfg.Condition, err = c.UpdateCondition(&UpdateConditionInput{
+				Service:   i.Service,
+				Version:   i.Version,
+				Name:      name,
+				Statement: statement,
+			})
+		}
+	case isNotFound(err):
+		cfg.Condition, err = c.CreateCondition(&CreateConditionInput{
+			Service:   i.Service,
+			Version:   i.Version,
+			Name:      name,
+			Statement: statement,
+			Type:      "REQUEST",
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	source := fmt.Sprintf("%q", value)
+	dst := "http." + ImageOptimizerHeader
+	cfg.Header, err = c.GetHeader(&GetHeaderInput{
+		Service: i.Service,
+		Version: i.Version,
+		Name:    name,
+	})
+	switch {
+	case err == nil:
+		h := cfg.Header
+		if h.Action != HeaderActionSet || h.Type != HeaderTypeRequest || h.Destination != dst ||
+			h.Source != source || h.RequestCondition != name {
+			cfg.Header, err = c.UpdateHeader(&UpdateHeaderInput{
+				Service:          i.Service,
+				Version:          i.Version,
+				Name:             name,
+				Action:           HeaderActionSet,
+				Type:             HeaderTypeRequest,
+				Destination:      dst,
+				Source:           source,
+				RequestCondition: name,
+			})
+		}
+	case isNotFound(err):
+		cfg.Header, err = c.CreateHeader(&CreateHeaderInput{
+			Service:          i.Service,
+			Version:          i.Version,
+			Name:             name,
+			Action:           HeaderActionSet,
+			Type:             HeaderTypeRequest,
+			Destination:      dst,
+			Source:           source,
+			RequestCondition: name,
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := c.ListRequestSettings(&ListRequestSettingsInput{
+		Service: i.Service,
+		Version: i.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range settings {
+		if s.Action == RequestSettingActionPass && s.RequestCondition == "" {
+			cfg.Conflicts = append(cfg.Conflicts, s)
+		}
+	}
+
+	return cfg, nil
+}
+
+// DisableImageOptimizerInput is used as input to the DisableImageOptimizer
+// function.
+type DisableImageOptimizerInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the condition and header. Optional, defaults to
+	// ImageOptimizerDefaultName.
+	Name string
+}
+
+// DisableImageOptimizer deletes the header and condition created by
+// EnableImageOptimizer. Objects which do not exist are ignored.
+func (c *Client) DisableImageOptimizer(i *DisableImageOptimizerInput) error {
+	if i.Service == "" {
+		return ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return ErrMissingVersion
+	}
+
+	name := i.Name
+	if name == "" {
+		name = ImageOptimizerDefaultName
+	}
+
+	// The header refers to the condition, so it is deleted first.
+	err := c.DeleteHeader(&DeleteHeaderInput{
+		Service: i.Service,
+		Version: i.Version,
+		Name:    name,
+	})
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+
+	err = c.DeleteCondition(&DeleteConditionInput{
+		Service: i.Service,
+		Version: i.Version,
+		Name:    name,
+	})
+	if err != nil && !isNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// imageOptimizerStatement returns a request condition matching requests for
+// files with the given extensions.
+func imageOptimizerStatement(extensions []string) string {
+	quoted := make([]string, len(extensions))
+	for n, ext := range extensions {
+		quoted[n] = regexp.QuoteMeta(strings.TrimPrefix(ext, "."))
+	}
+	return fmt.Sprintf(`req.url.ext ~ "(?i)^(%s)$"`, strings.Join(quoted, "|"))
+}
+
+// isNotFound reports whether err is an HTTP 404 from the API.
+func isNotFound(err error) bool {
+	herr, ok := err.(*HTTPError)
+	return ok && herr.IsNotFound()
+}
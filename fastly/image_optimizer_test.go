@@ -0,0 +1,66 @@
+package fastly
+
+import "testing"
+
+func TestClient_EnableImageOptimizer(t *testing.T) {
+	t.Parallel()
+
+	var cfg *ImageOptimizerConfig
+	var err error
+	record(t, "image_optimizer/enable", func(c *Client) {
+		cfg, err = c.EnableImageOptimizer(&EnableImageOptimizerInput{
+			Service:             testServiceID,
+			Version:             6,
+			Extensions:          []string{"png", ".jpg"},
+			PassQueryParameters: true,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Condition.Statement != `req.url.ext ~ "(?i)^(png|jpg)$"` || cfg.Condition.Type != "REQUEST" {
+		t.Errorf("bad condition: %+v", cfg.Condition)
+	}
+	if cfg.Header.Destination != "http.x-fastly-imageopto-api" || cfg.Header.Source != `"fastly; qp=*"` {
+		t.Errorf("bad header: %+v", cfg.Header)
+	}
+	if cfg.Header.RequestCondition != ImageOptimizerDefaultName {
+		t.Errorf("bad request_condition: %q", cfg.Header.RequestCondition)
+	}
+	if len(cfg.Conflicts) != 1 || cfg.Conflicts[0].Name != "pass-all" {
+		t.Errorf("bad conflicts: %v", cfg.Conflicts)
+	}
+}
+
+func TestClient_DisableImageOptimizer(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	record(t, "image_optimizer/disable", func(c *Client) {
+		err = c.DisableImageOptimizer(&DisableImageOptimizerInput{
+			Service: testServiceID,
+			Version: 6,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_EnableImageOptimizer_validation(t *testing.T) {
+	var err error
+	_, err = testClient.EnableImageOptimizer(&EnableImageOptimizerInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.EnableImageOptimizer(&EnableImageOptimizerInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
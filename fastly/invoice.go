@@ -0,0 +1,140 @@
+package fastly
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// Invoice is a monthly invoice from the Fastly billing API.
+type Invoice struct {
+	CustomerID               string             `mapstructure:"customer_id"`
+	InvoiceID                string             `mapstructure:"invoice_id"`
+	StatementNumber          string             `mapstructure:"statement_number"`
+	CurrencyCode             string             `mapstructure:"currency_code"`
+	MonthlyTransactionAmount float64            `mapstructure:"monthly_transaction_amount"`
+	InvoicePostedOn          *time.Time         `mapstructure:"invoice_posted_on"`
+	BillingStartDate         *time.Time         `mapstructure:"billing_start_date"`
+	BillingEndDate           *time.Time         `mapstructure:"billing_end_date"`
+	LineItems                []*InvoiceLineItem `mapstructure:"transaction_line_items"`
+}
+
+// InvoiceLineItem is a single charge or credit on an invoice.
+type InvoiceLineItem struct {
+	Description      string  `mapstructure:"description"`
+	Amount           float64 `mapstructure:"amount"`
+	CreditCouponCode string  `mapstructure:"credit_coupon_code"`
+	Rate             float64 `mapstructure:"rate"`
+	Units            float64 `mapstructure:"units"`
+	ProductName      string  `mapstructure:"product_name"`
+	ProductGroup     string  `mapstructure:"product_group"`
+	ProductLine      string  `mapstructure:"product_line"`
+	Region           string  `mapstructure:"region"`
+	UsageType        string  `mapstructure:"usage_type"`
+}
+
+// invoicesResponse is a single page of invoices.
+type invoicesResponse struct {
+	Data []*Invoice `mapstructure:"data"`
+	Meta struct {
+		NextCursor string `mapstructure:"next_cursor"`
+	} `mapstructure:"meta"`
+}
+
+// ListInvoicesInput is used as input to the ListInvoices function.
+type ListInvoicesInput struct {
+	// StartDate and EndDate limit the returned invoices to billing periods
+	// within the range, as dates such as "2020-05-01". Optional.
+	StartDate string
+	EndDate   string
+
+	// Limit is the number of invoices to return per request. Optional.
+	Limit int
+}
+
+// ListInvoices returns every invoice for the account, following every page of
+// results.
+func (c *Client) ListInvoices(i *ListInvoicesInput) ([]*Invoice, error) {
+	params := map[string]string{}
+	if i.StartDate != "" {
+		params["billing_start_date"] = i.StartDate
+	}
+	if i.EndDate != "" {
+		params["billing_end_date"] = i.EndDate
+	}
+	if i.Limit != 0 {
+		params["limit"] = strconv.Itoa(i.Limit)
+	}
+
+	var invoices []*Invoice
+	for {
+		resp, err := c.Get("/billing/v3/invoices", &RequestOptions{Params: params})
+		if err != nil {
+			return nil, err
+		}
+
+		var page *invoicesResponse
+		if err := decodeJSON(&page, resp.Body); err != nil {
+			return nil, err
+		}
+		invoices = append(invoices, page.Data...)
+
+		if page.Meta.NextCursor == "" {
+			return invoices, nil
+		}
+		params["cursor"] = page.Meta.NextCursor
+	}
+}
+
+// GetInvoiceInput is used as input to the GetInvoice function. Either ID, or
+// both Year and Month, must be set.
+type GetInvoiceInput struct {
+	// ID is the ID of the invoice.
+	ID string
+
+	// Year and Month select the invoice for a billing month.
+	Year  uint16
+	Month uint8
+}
+
+// GetInvoice gets a single invoice, by ID or by billing month.
+func (c *Client) GetInvoice(i *GetInvoiceInput) (*Invoice, error) {
+	if i.ID != "" {
+		path := fmt.Sprintf("/billing/v3/invoices/%s", i.ID)
+		resp, err := c.Get(path, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var inv *Invoice
+		if err := decodeJSON(&inv, resp.Body); err != nil {
+			return nil, err
+		}
+		return inv, nil
+	}
+
+	if i.Year == 0 {
+		return nil, ErrMissingYear
+	}
+
+	if i.Month == 0 {
+		return nil, ErrMissingMonth
+	}
+
+	start := time.Date(int(i.Year), time.Month(i.Month), 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 1, -1)
+	invoices, err := c.ListInvoices(&ListInvoicesInput{
+		StartDate: start.Format("2006-01-02"),
+		EndDate:   end.Format("2006-01-02"),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, inv := range invoices {
+		if inv.BillingStartDate != nil && inv.BillingStartDate.Year() == start.Year() && inv.BillingStartDate.Month() == start.Month() {
+			return inv, nil
+		}
+	}
+	return nil, fmt.Errorf("No invoice for %d-%02d", i.Year, i.Month)
+}
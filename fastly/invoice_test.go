@@ -0,0 +1,73 @@
+package fastly
+
+import "testing"
+
+func TestClient_Invoices(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var invoices []*Invoice
+	record(t, "invoices/list", func(c *Client) {
+		invoices, err = c.ListInvoices(&ListInvoicesInput{
+			StartDate: "2020-03-01",
+			EndDate:   "2020-04-30",
+			Limit:     1,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(invoices) != 2 {
+		t.Fatalf("bad invoices: %v", invoices)
+	}
+	if invoices[1].InvoiceID != "12399" {
+		t.Errorf("bad invoice_id: %q", invoices[1].InvoiceID)
+	}
+
+	var inv *Invoice
+	record(t, "invoices/get", func(c *Client) {
+		inv, err = c.GetInvoice(&GetInvoiceInput{
+			ID: "12345",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.MonthlyTransactionAmount != 1060.5 {
+		t.Errorf("bad amount: %v", inv.MonthlyTransactionAmount)
+	}
+	if len(inv.LineItems) != 2 {
+		t.Fatalf("bad line items: %v", inv.LineItems)
+	}
+	if li := inv.LineItems[1]; li.UsageType != "Requests" || li.Rate != 0.0075 || li.Amount != 100 {
+		t.Errorf("bad line item: %#v", li)
+	}
+
+	record(t, "invoices/get_month", func(c *Client) {
+		inv, err = c.GetInvoice(&GetInvoiceInput{
+			Year:  2020,
+			Month: 4,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inv.InvoiceID != "12399" {
+		t.Errorf("bad invoice_id: %q", inv.InvoiceID)
+	}
+}
+
+func TestClient_GetInvoice_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetInvoice(&GetInvoiceInput{})
+	if err != ErrMissingYear {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetInvoice(&GetInvoiceInput{
+		Year: 2020,
+	})
+	if err != ErrMissingMonth {
+		t.Errorf("bad error: %s", err)
+	}
+}
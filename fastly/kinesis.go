@@ -0,0 +1,259 @@
+package fastly
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// Kinesis represents a Kinesis logging response from the Fastly API.
+type Kinesis struct {
+	ServiceID string `mapstructure:"service_id"`
+	Version   int    `mapstructure:"version"`
+
+	Name              string     `mapstructure:"name"`
+	StreamName        string     `mapstructure:"topic"`
+	Region            string     `mapstructure:"region"`
+	AccessKey         string     `mapstructure:"access_key"`
+	SecretKey         string     `mapstructure:"secret_key" sensitive:"true"`
+	IAMRole           string     `mapstructure:"iam_role"`
+	Format            string     `mapstructure:"format"`
+	FormatVersion     uint       `mapstructure:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition"`
+	CreatedAt         *time.Time `mapstructure:"created_at"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+}
+
+// String formats the Kinesis endpoint with its secret key redacted.
+func (k *Kinesis) String() string {
+	return redactedString(k)
+}
+
+// GoString is like String, for the %#v verb.
+func (k *Kinesis) GoString() string {
+	return redactedGoString(k)
+}
+
+// kinesisByName is a sortable list of Kinesis endpoints.
+type kinesisByName []*Kinesis
+
+// Len, Swap, and Less implement the sortable interface.
+func (s kinesisByName) Len() int      { return len(s) }
+func (s kinesisByName) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s kinesisByName) Less(i, j int) bool {
+	return s[i].Name < s[j].Name
+}
+
+// ListKinesisInput is used as input to the ListKinesis function.
+type ListKinesisInput struct {
+	// Service is the ID of the service (required).
+	Service string
+
+	// Version is the specific configuration version (required).
+	Version int
+}
+
+// ListKinesis returns the list of Kinesis endpoints for the configuration
+// version.
+func (c *Client) ListKinesis(i *ListKinesisInput) ([]*Kinesis, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/kinesis", i.Service, i.Version)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ks []*Kinesis
+	if err := decodeJSON(&ks, resp.Body); err != nil {
+		return nil, err
+	}
+	sort.Stable(kinesisByName(ks))
+	return ks, nil
+}
+
+// CreateKinesisInput is used as input to the CreateKinesis function.
+type CreateKinesisInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	Name              string `form:"name,omitempty"`
+	StreamName        string `form:"topic,omitempty"`
+	Region            string `form:"region,omitempty"`
+	AccessKey         string `form:"access_key,omitempty"`
+	SecretKey         string `form:"secret_key,omitempty" sensitive:"true"`
+	IAMRole           string `form:"iam_role,omitempty"`
+	Format            string `form:"format,omitempty"`
+	FormatVersion     uint   `form:"format_version,omitempty"`
+	ResponseCondition string `form:"response_condition,omitempty"`
+	Placement         string `form:"placement,omitempty"`
+}
+
+// CreateKinesis creates a new Fastly Kinesis logging endpoint.
+func (c *Client) CreateKinesis(i *CreateKinesisInput) (*Kinesis, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.IAMRole != "" && (i.AccessKey != "" || i.SecretKey != "") {
+		return nil, ErrIAMRoleWithKeys
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/kinesis", i.Service, i.Version)
+	resp, err := c.PostForm(path, c.withLoggingDefaults(i), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var k *Kinesis
+	if err := decodeJSON(&k, resp.Body); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// GetKinesisInput is used as input to the GetKinesis function.
+type GetKinesisInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the Kinesis endpoint to fetch.
+	Name string
+}
+
+// GetKinesis gets the Kinesis logging configuration with the given parameters.
+func (c *Client) GetKinesis(i *GetKinesisInput) (*Kinesis, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/kinesis/%s", i.Service, i.Version, url.PathEscape(i.Name))
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var k *Kinesis
+	if err := decodeJSON(&k, resp.Body); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// UpdateKinesisInput is used as input to the UpdateKinesis function.
+type UpdateKinesisInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the Kinesis endpoint to update.
+	Name string
+
+	NewName           string `form:"name,omitempty"`
+	StreamName        string `form:"topic,omitempty"`
+	Region            string `form:"region,omitempty"`
+	AccessKey         string `form:"access_key,omitempty"`
+	SecretKey         string `form:"secret_key,omitempty" sensitive:"true"`
+	IAMRole           string `form:"iam_role,omitempty"`
+	Format            string `form:"format,omitempty"`
+	FormatVersion     uint   `form:"format_version,omitempty"`
+	ResponseCondition string `form:"response_condition,omitempty"`
+	Placement         string `form:"placement,omitempty"`
+}
+
+// UpdateKinesis updates a specific Kinesis logging endpoint.
+func (c *Client) UpdateKinesis(i *UpdateKinesisInput) (*Kinesis, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if i.IAMRole != "" && (i.AccessKey != "" || i.SecretKey != "") {
+		return nil, ErrIAMRoleWithKeys
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/kinesis/%s", i.Service, i.Version, url.PathEscape(i.Name))
+	resp, err := c.PutForm(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var k *Kinesis
+	if err := decodeJSON(&k, resp.Body); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// DeleteKinesisInput is the input parameter to DeleteKinesis.
+type DeleteKinesisInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the Kinesis endpoint to delete (required).
+	Name string
+}
+
+// DeleteKinesis deletes the given Kinesis logging endpoint.
+func (c *Client) DeleteKinesis(i *DeleteKinesisInput) error {
+	if i.Service == "" {
+		return ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return ErrMissingName
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/kinesis/%s", i.Service, i.Version, url.PathEscape(i.Name))
+	resp, err := c.Delete(path, nil)
+	if err != nil {
+		return err
+	}
+
+	var r *statusResp
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return err
+	}
+	if !r.Ok() {
+		return fmt.Errorf("Not Ok")
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+package fastly
+
+import "testing"
+
+func TestClient_Kinesis(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var tv *Version
+	record(t, "kinesis/version", func(c *Client) {
+		tv = testVersion(t, c)
+	})
+
+	// Create
+	var k *Kinesis
+	record(t, "kinesis/create", func(c *Client) {
+		k, err = c.CreateKinesis(&CreateKinesisInput{
+			Service:       testServiceID,
+			Version:       tv.Number,
+			Name:          "test-kinesis",
+			StreamName:    "stream-name",
+			Region:        "us-east-1",
+			IAMRole:       "arn:aws:iam::123456789012:role/fastly-logging",
+			Format:        "format",
+			FormatVersion: 2,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure deleted
+	defer func() {
+		record(t, "kinesis/cleanup", func(c *Client) {
+			c.DeleteKinesis(&DeleteKinesisInput{
+				Service: testServiceID,
+				Version: tv.Number,
+				Name:    "test-kinesis",
+			})
+
+			c.DeleteKinesis(&DeleteKinesisInput{
+				Service: testServiceID,
+				Version: tv.Number,
+				Name:    "new-test-kinesis",
+			})
+		})
+	}()
+
+	if k.Name != "test-kinesis" {
+		t.Errorf("bad name: %q", k.Name)
+	}
+	if k.StreamName != "stream-name" {
+		t.Errorf("bad topic: %q", k.StreamName)
+	}
+	if k.Region != "us-east-1" {
+		t.Errorf("bad region: %q", k.Region)
+	}
+	if k.IAMRole != "arn:aws:iam::123456789012:role/fastly-logging" {
+		t.Errorf("bad iam_role: %q", k.IAMRole)
+	}
+	if k.Format != "format" {
+		t.Errorf("bad format: %q", k.Format)
+	}
+	if k.FormatVersion != 2 {
+		t.Errorf("bad format_version: %d", k.FormatVersion)
+	}
+
+	// List
+	var ks []*Kinesis
+	record(t, "kinesis/list", func(c *Client) {
+		ks, err = c.ListKinesis(&ListKinesisInput{
+			Service: testServiceID,
+			Version: tv.Number,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ks) < 1 {
+		t.Errorf("bad kinesis: %v", ks)
+	}
+
+	// Get
+	var nk *Kinesis
+	record(t, "kinesis/get", func(c *Client) {
+		nk, err = c.GetKinesis(&GetKinesisInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Name:    "test-kinesis",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if k.Name != nk.Name {
+		t.Errorf("bad name: %q", k.Name)
+	}
+	if k.StreamName != nk.StreamName {
+		t.Errorf("bad topic: %q", k.StreamName)
+	}
+	if k.Region != nk.Region {
+		t.Errorf("bad region: %q", k.Region)
+	}
+	if k.IAMRole != nk.IAMRole {
+		t.Errorf("bad iam_role: %q", k.IAMRole)
+	}
+	if k.Format != nk.Format {
+		t.Errorf("bad format: %q", k.Format)
+	}
+	if k.FormatVersion != nk.FormatVersion {
+		t.Errorf("bad format_version: %d", k.FormatVersion)
+	}
+
+	// Update
+	var uk *Kinesis
+	record(t, "kinesis/update", func(c *Client) {
+		uk, err = c.UpdateKinesis(&UpdateKinesisInput{
+			Service:   testServiceID,
+			Version:   tv.Number,
+			Name:      "test-kinesis",
+			NewName:   "new-test-kinesis",
+			Placement: "none",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uk.Name != "new-test-kinesis" {
+		t.Errorf("bad name: %q", uk.Name)
+	}
+
+	// Delete
+	record(t, "kinesis/delete", func(c *Client) {
+		err = c.DeleteKinesis(&DeleteKinesisInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Name:    "new-test-kinesis",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_ListKinesis_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListKinesis(&ListKinesisInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ListKinesis(&ListKinesisInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_CreateKinesis_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateKinesis(&CreateKinesisInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateKinesis(&CreateKinesisInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateKinesis(&CreateKinesisInput{
+		Service:   "foo",
+		Version:   1,
+		SecretKey: "secret",
+		IAMRole:   "arn:aws:iam::123456789012:role/fastly-logging",
+	})
+	if err != ErrIAMRoleWithKeys {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetKinesis_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetKinesis(&GetKinesisInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetKinesis(&GetKinesisInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetKinesis(&GetKinesisInput{
+		Service: "foo",
+		Version: 1,
+		Name:    "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdateKinesis_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateKinesis(&UpdateKinesisInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateKinesis(&UpdateKinesisInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateKinesis(&UpdateKinesisInput{
+		Service: "foo",
+		Version: 1,
+		Name:    "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_DeleteKinesis_validation(t *testing.T) {
+	var err error
+	err = testClient.DeleteKinesis(&DeleteKinesisInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteKinesis(&DeleteKinesisInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteKinesis(&DeleteKinesisInput{
+		Service: "foo",
+		Version: 1,
+		Name:    "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -0,0 +1,146 @@
+package fastly
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// logFormatDirectives are the directives which take no argument, such as %h.
+const logFormatDirectives = "aAbBDfhHIlmOpqrsTtuUvV"
+
+// logFormatArgDirectives are the directives which take an argument in braces,
+// such as %{Host}i or %{req.http.Host}V.
+const logFormatArgDirectives = "CeinoptTVx"
+
+// LogFormatError is a problem found in a log format by FormatValidator.
+type LogFormatError struct {
+	// Offset is the byte offset of the problem in the format, or -1 if it
+	// applies to the format as a whole.
+	Offset int
+	Reason string
+}
+
+// Error implements the error interface.
+func (e *LogFormatError) Error() string {
+	if e.Offset < 0 {
+		return fmt.Sprintf("invalid log format: %s", e.Reason)
+	}
+	return fmt.Sprintf("invalid log format at offset %d: %s", e.Offset, e.Reason)
+}
+
+// FormatValidator checks logging endpoint format strings before they are
+// sent to the API, which accepts formats that produce broken log lines at the
+// edge.
+type FormatValidator struct {
+	// JSON requires the format to produce a JSON value once its directives
+	// are expanded. Formats starting with "{" or "[" are always checked as
+	// JSON.
+	JSON bool
+}
+
+// Validate checks that every % directive in the format is known and
+// complete, such as %>s or %{req.http.Host}V, and that JSON formats are
+// valid JSON, with balanced braces and quotes. It returns a *LogFormatError.
+func (v *FormatValidator) Validate(format string) error {
+	// Each directive is replaced with a placeholder which is valid JSON both
+	// inside a string and as a bare value.
+	var expanded bytes.Buffer
+	for i := 0; i < len(format); {
+		if format[i] != '%' {
+			expanded.WriteByte(format[i])
+			i++
+			continue
+		}
+
+		start := i
+		i++
+		if i < len(format) && format[i] == '%' {
+			expanded.WriteByte('%')
+			i++
+			continue
+		}
+		if i < len(format) && (format[i] == '>' || format[i] == '<') {
+			i++
+		}
+
+		directives := logFormatDirectives
+		if i < len(format) && format[i] == '{' {
+			end := logFormatArgEnd(format, i)
+			if end < 0 {
+				return &LogFormatError{Offset: start, Reason: "unterminated %{"}
+			}
+			if end == i+1 {
+				return &LogFormatError{Offset: start, Reason: "empty %{}"}
+			}
+			i = end + 1
+			directives = logFormatArgDirectives
+		}
+
+		if i >= len(format) {
+			return &LogFormatError{Offset: start, Reason: fmt.Sprintf("incomplete directive %q", format[start:])}
+		}
+		if !strings.ContainsRune(directives, rune(format[i])) {
+			return &LogFormatError{Offset: start, Reason: fmt.Sprintf("unknown directive %q", format[start:i+1])}
+		}
+		i++
+		expanded.WriteByte('1')
+	}
+
+	trimmed := strings.TrimSpace(format)
+	if v.JSON || strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		var out interface{}
+		if err := json.Unmarshal(expanded.Bytes(), &out); err != nil {
+			return &LogFormatError{Offset: -1, Reason: fmt.Sprintf("not valid JSON: %s", err)}
+		}
+	}
+	return nil
+}
+
+// ValidateLogFormat checks a format string with a default FormatValidator.
+func ValidateLogFormat(format string) error {
+	return (&FormatValidator{}).Validate(format)
+}
+
+// logFormatArgEnd returns the index of the brace closing the argument which
+// opens at format[open], or -1. Braces in VCL strings, and nested braces, are
+// skipped.
+func logFormatArgEnd(format string, open int) int {
+	depth := 0
+	quoted := false
+	for i := open; i < len(format); i++ {
+		switch c := format[i]; {
+		case c == '"':
+			quoted = !quoted
+		case quoted:
+		case c == '{':
+			depth++
+		case c == '}':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// checkLogFormat validates the Format of a logging endpoint input sent to a
+// /logging/ path when ValidateLogFormats is set.
+func (c *Client) checkLogFormat(p string, i interface{}) error {
+	if !c.ValidateLogFormats || !strings.Contains(p, "/logging/") {
+		return nil
+	}
+
+	v := reflect.Indirect(reflect.ValueOf(i))
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+	f := v.FieldByName("Format")
+	if f.Kind() != reflect.String || f.String() == "" {
+		return nil
+	}
+	return ValidateLogFormat(f.String())
+}
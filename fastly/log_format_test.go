@@ -0,0 +1,73 @@
+package fastly
+
+import "testing"
+
+func TestFormatValidator(t *testing.T) {
+	cases := []struct {
+		format string
+		json   bool
+		offset int
+		ok     bool
+	}{
+		{format: `%h %l %u %t "%r" %>s %b`, ok: true},
+		{format: `%{Host}i %{begin:%Y-%m-%dT%H:%M:%S}t 100%%`, ok: true},
+		{format: `%{if(req.http.X, "}", "-")}V %v`, ok: true},
+		{format: `{"host":"%{req.http.Host}V","status":%>s,"bytes":%B}`, ok: true},
+		{format: `%{ms}T %{us}T %{s}T %D`, ok: true},
+		{format: `  [%>s, "%h"]`, ok: true},
+		{format: `%h %z`, offset: 3},
+		{format: `%{req.http.Host}`, offset: 0},
+		{format: `%{req.http.Host}Q`, offset: 0},
+		{format: `x %{req.http.Host V`, offset: 2},
+		{format: `%{}V`, offset: 0},
+		{format: `%h %`, offset: 3},
+		{format: `{"host":"%{req.http.Host}V"`, offset: -1},
+		{format: `{"status":%>s,}`, offset: -1},
+		{format: `"status": %>s`, json: true, offset: -1},
+	}
+	for _, c := range cases {
+		err := (&FormatValidator{JSON: c.json}).Validate(c.format)
+		if c.ok {
+			if err != nil {
+				t.Errorf("%q: %s", c.format, err)
+			}
+			continue
+		}
+		ferr, ok := err.(*LogFormatError)
+		if !ok {
+			t.Errorf("%q: expected a LogFormatError, got %v", c.format, err)
+			continue
+		}
+		if ferr.Offset != c.offset {
+			t.Errorf("%q: expected offset %d: %s", c.format, c.offset, ferr)
+		}
+	}
+}
+
+func TestClient_ValidateLogFormats(t *testing.T) {
+	c, err := NewClient("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.ValidateLogFormats = true
+
+	_, err = c.CreateSyslog(&CreateSyslogInput{
+		Service: "foo",
+		Version: 1,
+		Name:    "bad-format",
+		Format:  `{"host":"%{req.http.Host}V"`,
+	})
+	if _, ok := err.(*LogFormatError); !ok {
+		t.Errorf("bad error: %v", err)
+	}
+
+	c.DefaultLogFormat = "%h %z"
+	_, err = c.CreatePapertrail(&CreatePapertrailInput{
+		Service: "foo",
+		Version: 1,
+		Name:    "bad-default",
+	})
+	if _, ok := err.(*LogFormatError); !ok {
+		t.Errorf("bad error: %v", err)
+	}
+}
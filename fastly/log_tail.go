@@ -0,0 +1,378 @@
+package fastly
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// LogTailSession is a log tailing session for the output of a Compute
+// service. Logs are read by polling URL until the session expires.
+type LogTailSession struct {
+	URL string `mapstructure:"url"`
+}
+
+// LogLine is a single line written to stdout or stderr by a Compute service.
+type LogLine struct {
+	// SequenceNum increases with each line of the service, and is used as the
+	// cursor of a LogTail.
+	SequenceNum int64 `mapstructure:"SequenceNum"`
+
+	// RequestTime is when the request which logged the line started, in
+	// microseconds since the Unix epoch.
+	RequestTime int64 `mapstructure:"RequestTime"`
+
+	RequestID string `mapstructure:"RequestID"`
+	Version   int    `mapstructure:"ServiceVersion"`
+	Stream    string `mapstructure:"Stream"`
+	Message   string `mapstructure:"Message"`
+}
+
+// logTailBatch is a single response from a log tailing session.
+type logTailBatch struct {
+	ID   string     `mapstructure:"ID"`
+	Logs []*LogLine `mapstructure:"Logs"`
+}
+
+// CreateLogTailSessionInput is used as input to the CreateLogTailSession
+// function.
+type CreateLogTailSessionInput struct {
+	// Service is the ID of the service (required).
+	Service string
+}
+
+// CreateLogTailSession starts a session for tailing the output of a Compute
+// service. Most callers should use TailLogs, which renews sessions as they
+// expire.
+func (c *Client) CreateLogTailSession(i *CreateLogTailSessionInput) (*LogTailSession, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	path := fmt.Sprintf("/service/%s/log_stream/managed/instance_output", i.Service)
+	resp, err := c.Post(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var s *LogTailSession
+	if err := decodeJSON(&s, resp.Body); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// TailLogsInput is used as input to the TailLogs function.
+type TailLogsInput struct {
+	// Service is the ID of the service (required).
+	Service string
+
+	// From is the sequence number after which to start tailing, such as the
+	// Cursor of an earlier LogTail. Optional, defaults to new lines only.
+	From int64
+
+	// Buffer is the number of lines buffered for a slow consumer. Optional,
+	// defaults to 100.
+	Buffer int
+
+	// DropWhenFull drops lines, counting them in Dropped, when the buffer is
+	// full. By default polling pauses until the consumer catches up, which
+	// loses no lines unless the consumer falls behind by more than the
+	// session retains.
+	DropWhenFull bool
+
+	// PollInterval is how long to wait after a poll which returned no lines.
+	// Optional, defaults to one second.
+	PollInterval time.Duration
+
+	// MaxRetries is the number of consecutive failed requests, from network
+	// errors, 5xx and 429 responses or expired sessions, after which tailing
+	// stops. Failed polls and session renewals are retried, and expired
+	// sessions renewed, with a backoff of up to 30 seconds. Optional, defaults
+	// to 10.
+	MaxRetries int
+}
+
+// LogTail tails the output of a Compute service. Lines are delivered in order
+// on Lines, which is closed when tailing stops.
+type LogTail struct {
+	// cursor and dropped are updated atomically, so they come first to be
+	// 64-bit aligned on 32-bit platforms.
+	cursor  int64
+	dropped uint64
+
+	client  *Client
+	input   TailLogsInput
+	session *LogTailSession
+
+	lines chan *LogLine
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	once   sync.Once
+	done   chan struct{}
+	err    error
+}
+
+// TailLogs starts tailing the output of a Compute service. The first session
+// is created before it returns, so invalid services and keys are reported
+// immediately. Expired sessions are renewed automatically, resuming after the
+// last line delivered, and lines repeated by a new session are skipped.
+//
+// The session is polled over HTTP until Close is called.
+func (c *Client) TailLogs(i *TailLogsInput) (*LogTail, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	s, err := c.CreateLogTailSession(&CreateLogTailSessionInput{Service: i.Service})
+	if err != nil {
+		return nil, err
+	}
+
+	input := *i
+	if input.Buffer <= 0 {
+		input.Buffer = 100
+	}
+	if input.PollInterval <= 0 {
+		input.PollInterval = time.Second
+	}
+	if input.MaxRetries <= 0 {
+		input.MaxRetries = 10
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t := &LogTail{
+		client:  c,
+		input:   input,
+		session: s,
+		lines:   make(chan *LogLine, input.Buffer),
+		cursor:  input.From,
+		ctx:     ctx,
+		cancel:  cancel,
+		done:    make(chan struct{}),
+	}
+	go t.run()
+	return t, nil
+}
+
+// Lines returns the channel on which lines are delivered.
+func (t *LogTail) Lines() <-chan *LogLine {
+	return t.lines
+}
+
+// Cursor returns the sequence number of the last line delivered or dropped.
+// It can be passed as From to resume tailing later.
+func (t *LogTail) Cursor() int64 {
+	return atomic.LoadInt64(&t.cursor)
+}
+
+// Dropped returns the number of lines dropped with DropWhenFull.
+func (t *LogTail) Dropped() uint64 {
+	return atomic.LoadUint64(&t.dropped)
+}
+
+// Err returns the error which stopped tailing, once Lines is closed. It is
+// nil if tailing was stopped by Close.
+func (t *LogTail) Err() error {
+	select {
+	case <-t.done:
+		return t.err
+	default:
+		return nil
+	}
+}
+
+// Close stops tailing and waits for Lines to be closed. Lines still buffered
+// are discarded.
+func (t *LogTail) Close() {
+	t.once.Do(t.cancel)
+	<-t.done
+}
+
+// run polls the session until tailing stops.
+func (t *LogTail) run() {
+	defer close(t.done)
+	defer close(t.lines)
+
+	retries := 0
+	for {
+		batch, err := t.poll()
+		if t.ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			herr, ok := err.(*HTTPError)
+			switch {
+			case ok && (herr.StatusCode == http.StatusNotFound || herr.StatusCode == http.StatusGone):
+				// The session expired, so start a new one. The cursor is kept,
+				// so it resumes where this one stopped. A session which
+				// expires before returning anything counts as a failed poll,
+				// so an endpoint which is always gone is not polled in a loop.
+				if !t.retry(&retries, err) || !t.renew(&retries) {
+					return
+				}
+				continue
+			case isRetryableLogTailError(err):
+				if !t.retry(&retries, err) {
+					return
+				}
+				continue
+			default:
+				t.err = err
+				return
+			}
+		}
+		retries = 0
+
+		delivered := 0
+		for _, l := range batch.Logs {
+			if l.SequenceNum <= t.Cursor() {
+				continue
+			}
+			if !t.deliver(l) {
+				return
+			}
+			atomic.StoreInt64(&t.cursor, l.SequenceNum)
+			delivered++
+		}
+
+		if delivered == 0 && !t.sleep(t.input.PollInterval) {
+			return
+		}
+	}
+}
+
+// retry counts a failed request and backs off before the next one. It
+// returns false, setting the error to err if MaxRetries is exceeded, when
+// tailing should stop.
+func (t *LogTail) retry(retries *int, err error) bool {
+	*retries++
+	if *retries > t.input.MaxRetries {
+		t.err = err
+		return false
+	}
+	return t.sleep(t.backoff(*retries))
+}
+
+// renew replaces the expired session. Failures to create the new session are
+// retried like failed polls. It returns false when tailing should stop.
+func (t *LogTail) renew(retries *int) bool {
+	for {
+		s, err := t.client.CreateLogTailSession(&CreateLogTailSessionInput{Service: t.input.Service})
+		if t.ctx.Err() != nil {
+			return false
+		}
+		if err == nil {
+			t.session = s
+			return true
+		}
+		if !isRetryableLogTailError(err) {
+			t.err = err
+			return false
+		}
+		if !t.retry(retries, err) {
+			return false
+		}
+	}
+}
+
+// isRetryableLogTailError reports whether a failed request may succeed if
+// retried: a network error, a 5xx or a 429.
+func isRetryableLogTailError(err error) bool {
+	herr, ok := err.(*HTTPError)
+	return !ok || herr.StatusCode >= 500 || herr.StatusCode == http.StatusTooManyRequests
+}
+
+// poll fetches the lines after the cursor from the current session.
+func (t *LogTail) poll() (*logTailBatch, error) {
+	u, err := url.Parse(t.session.URL)
+	if err != nil {
+		return nil, err
+	}
+	if cursor := t.Cursor(); cursor > 0 {
+		q := u.Query()
+		q.Set("from", strconv.FormatInt(cursor+1, 10))
+		u.RawQuery = q.Encode()
+	}
+
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(t.client.apiKey) > 0 {
+		req.Header.Set(APIKeyHeader, t.client.apiKey)
+	}
+	req.Header.Set("User-Agent", UserAgent)
+	req = req.WithContext(t.ctx)
+
+	resp, err := checkResp(t.client.HTTPClient.Do(req))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	var b *logTailBatch
+	if err := decodeJSON(&b, resp.Body); err != nil {
+		return nil, err
+	}
+	if b == nil {
+		b = &logTailBatch{}
+	}
+	return b, nil
+}
+
+// deliver sends a line to the consumer, blocking or dropping it when the
+// buffer is full. It returns false if tailing was stopped while blocked.
+func (t *LogTail) deliver(l *LogLine) bool {
+	if t.input.DropWhenFull {
+		select {
+		case t.lines <- l:
+		default:
+			atomic.AddUint64(&t.dropped, 1)
+		}
+		return true
+	}
+
+	select {
+	case t.lines <- l:
+		return true
+	case <-t.ctx.Done():
+		return false
+	}
+}
+
+// maxLogTailBackoff caps the backoff between retries of a LogTail.
+const maxLogTailBackoff = 30 * time.Second
+
+// backoff returns how long to wait before the given retry: the poll interval,
+// doubled for each consecutive retry, up to 30 seconds. The interval stops
+// doubling at the cap, so a large MaxRetries cannot overflow it.
+func (t *LogTail) backoff(retries int) time.Duration {
+	backoff := t.input.PollInterval
+	for n := 1; n < retries && backoff < maxLogTailBackoff; n++ {
+		backoff *= 2
+	}
+	if backoff > maxLogTailBackoff {
+		backoff = maxLogTailBackoff
+	}
+	return backoff
+}
+
+// sleep waits for d, returning false if tailing was stopped first.
+func (t *LogTail) sleep(d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-t.ctx.Done():
+		return false
+	}
+}
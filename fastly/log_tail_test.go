@@ -0,0 +1,307 @@
+package fastly
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+// logTailServer serves log tailing sessions, each of which returns the given
+// batches of sequence numbers in turn and then either expires or returns no
+// more lines.
+func logTailServer(sessions [][][]int64, expire bool) (*httptest.Server, chan string) {
+	var mu sync.Mutex
+	var created int
+	polls := make(map[int]int)
+	froms := make(chan string, 100)
+
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Method == "POST" && r.URL.Path == "/service/foo/log_stream/managed/instance_output" {
+			created++
+			fmt.Fprintf(w, `{"url":"%s/tail/%d"}`, srv.URL, created)
+			return
+		}
+
+		var s int
+		if _, err := fmt.Sscanf(r.URL.Path, "/tail/%d", &s); err != nil || s > len(sessions) {
+			http.NotFound(w, r)
+			return
+		}
+		select {
+		case froms <- r.URL.Query().Get("from"):
+		default:
+		}
+
+		batches := sessions[s-1]
+		n := polls[s]
+		polls[s]++
+		if n >= len(batches) {
+			if expire && s < len(sessions) {
+				w.WriteHeader(http.StatusGone)
+				return
+			}
+			w.Write([]byte(`{"ID":"empty","Logs":[]}`))
+			return
+		}
+
+		w.Write([]byte(`{"ID":"batch","Logs":[`))
+		for i, seq := range batches[n] {
+			if i > 0 {
+				w.Write([]byte(","))
+			}
+			fmt.Fprintf(w, `{"SequenceNum":%d,"RequestID":"r%d","Stream":"stdout","Message":"line %d"}`, seq, seq, seq)
+		}
+		w.Write([]byte(`]}`))
+	}))
+	return srv, froms
+}
+
+func TestClient_TailLogs_renewal(t *testing.T) {
+	srv, froms := logTailServer([][][]int64{
+		{{1, 2}},
+		{{2, 3, 4}},
+	}, true)
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tail, err := c.TailLogs(&TailLogsInput{
+		Service:      "foo",
+		Buffer:       1,
+		PollInterval: 10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tail.Close()
+
+	for want := int64(1); want <= 4; want++ {
+		select {
+		case l := <-tail.Lines():
+			if l.SequenceNum != want || l.Message != fmt.Sprintf("line %d", want) {
+				t.Fatalf("bad line: %+v, expected %d", l, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for line %d", want)
+		}
+		// Read slowly, so delivery blocks on the full buffer.
+		time.Sleep(5 * time.Millisecond)
+	}
+	if tail.Cursor() != 4 || tail.Dropped() != 0 {
+		t.Errorf("bad cursor %d or dropped %d", tail.Cursor(), tail.Dropped())
+	}
+
+	// The renewed session resumes after the last line of the expired one.
+	var resumed bool
+	for len(froms) > 0 {
+		if <-froms == "3" {
+			resumed = true
+		}
+	}
+	if !resumed {
+		t.Errorf("expected the new session to be polled from 3")
+	}
+
+	tail.Close()
+	if _, ok := <-tail.Lines(); ok {
+		t.Errorf("expected lines to be closed")
+	}
+	if err := tail.Err(); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestClient_TailLogs_dropWhenFull(t *testing.T) {
+	srv, froms := logTailServer([][][]int64{
+		{{1, 2, 3}},
+	}, false)
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tail, err := c.TailLogs(&TailLogsInput{
+		Service:      "foo",
+		Buffer:       1,
+		DropWhenFull: true,
+		PollInterval: 10 * time.Millisecond,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tail.Close()
+
+	// Wait for the poll after the batch, which starts once it is handled.
+	for polls := 0; polls < 2; polls++ {
+		select {
+		case <-froms:
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for polls")
+		}
+	}
+
+	if tail.Dropped() != 2 || tail.Cursor() != 3 {
+		t.Errorf("bad dropped %d or cursor %d", tail.Dropped(), tail.Cursor())
+	}
+	if l := <-tail.Lines(); l.SequenceNum != 1 {
+		t.Errorf("bad line: %+v", l)
+	}
+}
+
+func TestClient_TailLogs_alwaysGone(t *testing.T) {
+	var mu sync.Mutex
+	var created, polls int
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Method == "POST" {
+			created++
+			fmt.Fprintf(w, `{"url":"%s/tail/%d"}`, srv.URL, created)
+			return
+		}
+		polls++
+		http.NotFound(w, r)
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	start := time.Now()
+	tail, err := c.TailLogs(&TailLogsInput{
+		Service:      "foo",
+		PollInterval: time.Millisecond,
+		MaxRetries:   3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tail.Close()
+
+	select {
+	case _, ok := <-tail.Lines():
+		if ok {
+			t.Fatal("expected no lines")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected tailing to stop")
+	}
+
+	if herr, ok := tail.Err().(*HTTPError); !ok || !herr.IsNotFound() {
+		t.Errorf("bad error: %v", tail.Err())
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if polls != 4 || created != 4 {
+		t.Errorf("expected 4 polls of 4 sessions, got %d polls of %d", polls, created)
+	}
+	// The renewals back off 1ms, 2ms and 4ms.
+	if elapsed := time.Since(start); elapsed < 7*time.Millisecond {
+		t.Errorf("expected renewals to back off, took %s", elapsed)
+	}
+}
+
+func TestClient_TailLogs_renewalRetried(t *testing.T) {
+	var mu sync.Mutex
+	var created, polls int
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Method == "POST" {
+			created++
+			// The first renewal fails, and is retried rather than stopping
+			// tailing.
+			if created == 2 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+			fmt.Fprintf(w, `{"url":"%s/tail/%d"}`, srv.URL, created)
+			return
+		}
+		polls++
+		if r.URL.Path == "/tail/1" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Write([]byte(`{"ID":"b","Logs":[{"SequenceNum":1,"Message":"hello"}]}`))
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tail, err := c.TailLogs(&TailLogsInput{
+		Service:      "foo",
+		PollInterval: time.Millisecond,
+		MaxRetries:   3,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer tail.Close()
+
+	select {
+	case l, ok := <-tail.Lines():
+		if !ok {
+			t.Fatalf("expected a line, tailing stopped: %v", tail.Err())
+		}
+		if l.Message != "hello" {
+			t.Errorf("bad line: %#v", l)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected a line")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if created != 3 {
+		t.Errorf("expected 3 sessions to be created, got %d", created)
+	}
+}
+
+func TestLogTail_backoff(t *testing.T) {
+	tail := &LogTail{input: TailLogsInput{PollInterval: time.Second}}
+	for retries, want := range map[int]time.Duration{
+		1:    time.Second,
+		2:    2 * time.Second,
+		5:    16 * time.Second,
+		6:    30 * time.Second,
+		40:   30 * time.Second,
+		1000: 30 * time.Second,
+	} {
+		if got := tail.backoff(retries); got != want {
+			t.Errorf("bad backoff for %d retries: %s", retries, got)
+		}
+	}
+}
+
+func TestClient_TailLogs_validation(t *testing.T) {
+	var err error
+	_, err = testClient.TailLogs(&TailLogsInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"net/url"
 	"sort"
 	"time"
 )
@@ -14,7 +15,7 @@ type Logentries struct {
 	Name              string     `mapstructure:"name"`
 	Port              uint       `mapstructure:"port"`
 	UseTLS            bool       `mapstructure:"use_tls"`
-	Token             string     `mapstructure:"token"`
+	Token             string     `mapstructure:"token" sensitive:"true"`
 	Format            string     `mapstructure:"format"`
 	FormatVersion     uint       `mapstructure:"format_version"`
 	ResponseCondition string     `mapstructure:"response_condition"`
@@ -23,6 +24,16 @@ type Logentries struct {
 	DeletedAt         *time.Time `mapstructure:"deleted_at"`
 }
 
+// String formats the Logentries endpoint with its token redacted.
+func (l *Logentries) String() string {
+	return redactedString(l)
+}
+
+// GoString is like String, for the %#v verb.
+func (l *Logentries) GoString() string {
+	return redactedGoString(l)
+}
+
 // logentriesByName is a sortable list of logentries.
 type logentriesByName []*Logentries
 
@@ -76,7 +87,7 @@ type CreateLogentriesInput struct {
 	Name              string       `form:"name,omitempty"`
 	Port              uint         `form:"port,omitempty"`
 	UseTLS            *Compatibool `form:"use_tls,omitempty"`
-	Token             string       `form:"token,omitempty"`
+	Token             string       `form:"token,omitempty" sensitive:"true"`
 	Format            string       `form:"format,omitempty"`
 	FormatVersion     uint         `form:"format_version,omitempty"`
 	ResponseCondition string       `form:"response_condition,omitempty"`
@@ -94,7 +105,7 @@ func (c *Client) CreateLogentries(i *CreateLogentriesInput) (*Logentries, error)
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/logentries", i.Service, i.Version)
-	resp, err := c.PostForm(path, i, nil)
+	resp, err := c.PostForm(path, c.withLoggingDefaults(i), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -131,7 +142,7 @@ func (c *Client) GetLogentries(i *GetLogentriesInput) (*Logentries, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/logentries/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/logging/logentries/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Get(path, nil)
 	if err != nil {
 		return nil, err
@@ -157,7 +168,7 @@ type UpdateLogentriesInput struct {
 	NewName           string       `form:"name,omitempty"`
 	Port              uint         `form:"port,omitempty"`
 	UseTLS            *Compatibool `form:"use_tls,omitempty"`
-	Token             string       `form:"token,omitempty"`
+	Token             string       `form:"token,omitempty" sensitive:"true"`
 	Format            string       `form:"format,omitempty"`
 	FormatVersion     uint         `form:"format_version,omitempty"`
 	ResponseCondition string       `form:"response_condition,omitempty"`
@@ -177,7 +188,7 @@ func (c *Client) UpdateLogentries(i *UpdateLogentriesInput) (*Logentries, error)
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/logentries/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/logging/logentries/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
 		return nil, err
@@ -215,7 +226,7 @@ func (c *Client) DeleteLogentries(i *DeleteLogentriesInput) error {
 		return ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/logentries/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/logging/logentries/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Delete(path, nil)
 	if err != nil {
 		return err
@@ -0,0 +1,818 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/mitchellh/mapstructure"
+)
+
+// LoggingType is the type of a logging endpoint, as it appears in the
+// /logging/<type> path of the Fastly API.
+type LoggingType string
+
+const (
+	LoggingTypeBigQuery         LoggingType = "bigquery"
+	LoggingTypeFTP              LoggingType = "ftp"
+	LoggingTypeGCS              LoggingType = "gcs"
+	LoggingTypeGrafanaCloudLogs LoggingType = "grafanacloudlogs"
+	LoggingTypeKinesis          LoggingType = "kinesis"
+	LoggingTypeLogentries       LoggingType = "logentries"
+	LoggingTypeNewRelicOTLP     LoggingType = "newrelicotlp"
+	LoggingTypePapertrail       LoggingType = "papertrail"
+	LoggingTypeS3               LoggingType = "s3"
+	LoggingTypeSumologic        LoggingType = "sumologic"
+	LoggingTypeSyslog           LoggingType = "syslog"
+)
+
+const (
+	// DefaultLoggingPeriod is the interval, in seconds, at which log files are
+	// delivered to object-storage endpoints. CreateS3, CreateGCS and CreateFTP
+	// send it when their input has no Period.
+	DefaultLoggingPeriod uint = 3600
+
+	// MaxLoggingGzipLevel is the highest gzip compression level.
+	MaxLoggingGzipLevel uint = 9
+
+	// MinLoggingFileMaxBytes is the smallest file size limit accepted by the
+	// API. A file_max_bytes of 0 means there is no limit.
+	MinLoggingFileMaxBytes uint = 1048576
+)
+
+// CompressionCodec is the codec used to compress log files on file-based
+// logging endpoints. Setting a codec is mutually exclusive with GzipLevel.
+type CompressionCodec string
+
+const (
+	CompressionCodecGzip   CompressionCodec = "gzip"
+	CompressionCodecSnappy CompressionCodec = "snappy"
+	CompressionCodecZstd   CompressionCodec = "zstd"
+)
+
+// LoggingEndpoint is a normalized view of a logging endpoint of any type. It
+// only carries the fields that are common to every logging provider.
+type LoggingEndpoint struct {
+	Type      LoggingType
+	ServiceID string
+	Version   int
+
+	Name              string
+	Format            string
+	FormatVersion     uint
+	MessageType       string
+	ResponseCondition string
+}
+
+// loggingEndpointsByType is a sortable list of logging endpoints.
+type loggingEndpointsByType []*LoggingEndpoint
+
+// Len, Swap, and Less implement the sortable interface.
+func (s loggingEndpointsByType) Len() int      { return len(s) }
+func (s loggingEndpointsByType) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s loggingEndpointsByType) Less(i, j int) bool {
+	if s[i].Type != s[j].Type {
+		return s[i].Type < s[j].Type
+	}
+	return s[i].Name < s[j].Name
+}
+
+// loggingListers maps each logging type to a function which lists the
+// endpoints of that type and converts them to LoggingEndpoints.
+var loggingListers = map[LoggingType]func(*Client, string, int) ([]*LoggingEndpoint, error){
+	LoggingTypeBigQuery: func(c *Client, s string, v int) ([]*LoggingEndpoint, error) {
+		list, err := c.GetBigQuery(&GetBigQueryInput{Service: s, Version: v})
+		if err != nil {
+			return nil, err
+		}
+		es := make([]*LoggingEndpoint, len(list))
+		for i, l := range list {
+			es[i] = &LoggingEndpoint{
+				Type:              LoggingTypeBigQuery,
+				ServiceID:         l.ServiceID,
+				Version:           v,
+				Name:              l.Name,
+				Format:            l.Format,
+				ResponseCondition: l.ResponseCondition,
+			}
+		}
+		return es, nil
+	},
+	LoggingTypeFTP: func(c *Client, s string, v int) ([]*LoggingEndpoint, error) {
+		list, err := c.ListFTPs(&ListFTPsInput{Service: s, Version: v})
+		if err != nil {
+			return nil, err
+		}
+		es := make([]*LoggingEndpoint, len(list))
+		for i, l := range list {
+			es[i] = &LoggingEndpoint{
+				Type:              LoggingTypeFTP,
+				ServiceID:         l.ServiceID,
+				Version:           l.Version,
+				Name:              l.Name,
+				Format:            l.Format,
+				ResponseCondition: l.ResponseCondition,
+			}
+		}
+		return es, nil
+	},
+	LoggingTypeGCS: func(c *Client, s string, v int) ([]*LoggingEndpoint, error) {
+		list, err := c.ListGCSs(&ListGCSsInput{Service: s, Version: v})
+		if err != nil {
+			return nil, err
+		}
+		es := make([]*LoggingEndpoint, len(list))
+		for i, l := range list {
+			es[i] = &LoggingEndpoint{
+				Type:              LoggingTypeGCS,
+				ServiceID:         l.ServiceID,
+				Version:           l.Version,
+				Name:              l.Name,
+				Format:            l.Format,
+				MessageType:       l.MessageType,
+				ResponseCondition: l.ResponseCondition,
+			}
+		}
+		return es, nil
+	},
+	LoggingTypeGrafanaCloudLogs: func(c *Client, s string, v int) ([]*LoggingEndpoint, error) {
+		list, err := c.ListGrafanaCloudLogs(&ListGrafanaCloudLogsInput{Service: s, Version: v})
+		if err != nil {
+			return nil, err
+		}
+		es := make([]*LoggingEndpoint, len(list))
+		for i, l := range list {
+			es[i] = &LoggingEndpoint{
+				Type:              LoggingTypeGrafanaCloudLogs,
+				ServiceID:         l.ServiceID,
+				Version:           l.Version,
+				Name:              l.Name,
+				Format:            l.Format,
+				FormatVersion:     l.FormatVersion,
+				ResponseCondition: l.ResponseCondition,
+			}
+		}
+		return es, nil
+	},
+	LoggingTypeKinesis: func(c *Client, s string, v int) ([]*LoggingEndpoint, error) {
+		list, err := c.ListKinesis(&ListKinesisInput{Service: s, Version: v})
+		if err != nil {
+			return nil, err
+		}
+		es := make([]*LoggingEndpoint, len(list))
+		for i, l := range list {
+			es[i] = &LoggingEndpoint{
+				Type:              LoggingTypeKinesis,
+				ServiceID:         l.ServiceID,
+				Version:           l.Version,
+				Name:              l.Name,
+				Format:            l.Format,
+				FormatVersion:     l.FormatVersion,
+				ResponseCondition: l.ResponseCondition,
+			}
+		}
+		return es, nil
+	},
+	LoggingTypeLogentries: func(c *Client, s string, v int) ([]*LoggingEndpoint, error) {
+		list, err := c.ListLogentries(&ListLogentriesInput{Service: s, Version: v})
+		if err != nil {
+			return nil, err
+		}
+		es := make([]*LoggingEndpoint, len(list))
+		for i, l := range list {
+			es[i] = &LoggingEndpoint{
+				Type:              LoggingTypeLogentries,
+				ServiceID:         l.ServiceID,
+				Version:           l.Version,
+				Name:              l.Name,
+				Format:            l.Format,
+				FormatVersion:     l.FormatVersion,
+				ResponseCondition: l.ResponseCondition,
+			}
+		}
+		return es, nil
+	},
+	LoggingTypeNewRelicOTLP: func(c *Client, s string, v int) ([]*LoggingEndpoint, error) {
+		list, err := c.ListNewRelicOTLP(&ListNewRelicOTLPInput{Service: s, Version: v})
+		if err != nil {
+			return nil, err
+		}
+		es := make([]*LoggingEndpoint, len(list))
+		for i, l := range list {
+			es[i] = &LoggingEndpoint{
+				Type:              LoggingTypeNewRelicOTLP,
+				ServiceID:         l.ServiceID,
+				Version:           l.Version,
+				Name:              l.Name,
+				Format:            l.Format,
+				FormatVersion:     l.FormatVersion,
+				ResponseCondition: l.ResponseCondition,
+			}
+		}
+		return es, nil
+	},
+	LoggingTypePapertrail: func(c *Client, s string, v int) ([]*LoggingEndpoint, error) {
+		list, err := c.ListPapertrails(&ListPapertrailsInput{Service: s, Version: v})
+		if err != nil {
+			return nil, err
+		}
+		es := make([]*LoggingEndpoint, len(list))
+		for i, l := range list {
+			es[i] = &LoggingEndpoint{
+				Type:              LoggingTypePapertrail,
+				ServiceID:         l.ServiceID,
+				Version:           l.Version,
+				Name:              l.Name,
+				Format:            l.Format,
+				ResponseCondition: l.ResponseCondition,
+			}
+		}
+		return es, nil
+	},
+	LoggingTypeS3: func(c *Client, s string, v int) ([]*LoggingEndpoint, error) {
+		list, err := c.ListS3s(&ListS3sInput{Service: s, Version: v})
+		if err != nil {
+			return nil, err
+		}
+		es := make([]*LoggingEndpoint, len(list))
+		for i, l := range list {
+			es[i] = &LoggingEndpoint{
+				Type:              LoggingTypeS3,
+				ServiceID:         l.ServiceID,
+				Version:           l.Version,
+				Name:              l.Name,
+				Format:            l.Format,
+				FormatVersion:     l.FormatVersion,
+				MessageType:       l.MessageType,
+				ResponseCondition: l.ResponseCondition,
+			}
+		}
+		return es, nil
+	},
+	LoggingTypeSumologic: func(c *Client, s string, v int) ([]*LoggingEndpoint, error) {
+		list, err := c.ListSumologics(&ListSumologicsInput{Service: s, Version: v})
+		if err != nil {
+			return nil, err
+		}
+		es := make([]*LoggingEndpoint, len(list))
+		for i, l := range list {
+			es[i] = &LoggingEndpoint{
+				Type:              LoggingTypeSumologic,
+				ServiceID:         l.ServiceID,
+				Version:           l.Version,
+				Name:              l.Name,
+				Format:            l.Format,
+				FormatVersion:     uint(l.FormatVersion),
+				MessageType:       l.MessageType,
+				ResponseCondition: l.ResponseCondition,
+			}
+		}
+		return es, nil
+	},
+	LoggingTypeSyslog: func(c *Client, s string, v int) ([]*LoggingEndpoint, error) {
+		list, err := c.ListSyslogs(&ListSyslogsInput{Service: s, Version: v})
+		if err != nil {
+			return nil, err
+		}
+		es := make([]*LoggingEndpoint, len(list))
+		for i, l := range list {
+			es[i] = &LoggingEndpoint{
+				Type:              LoggingTypeSyslog,
+				ServiceID:         l.ServiceID,
+				Version:           l.Version,
+				Name:              l.Name,
+				Format:            l.Format,
+				FormatVersion:     l.FormatVersion,
+				MessageType:       l.MessageType,
+				ResponseCondition: l.ResponseCondition,
+			}
+		}
+		return es, nil
+	},
+}
+
+// ListAllLoggingEndpointsInput is used as input to the ListAllLoggingEndpoints
+// function.
+type ListAllLoggingEndpointsInput struct {
+	// Service is the ID of the service (required).
+	Service string
+
+	// Version is the specific configuration version (required).
+	Version int
+}
+
+// ListAllLoggingEndpoints returns every logging endpoint of every type for the
+// configuration version. The logging families are queried concurrently and the
+// result is sorted by type and then by name. If any of the underlying list
+// calls fails, the first error is returned.
+func (c *Client) ListAllLoggingEndpoints(i *ListAllLoggingEndpointsInput) ([]*LoggingEndpoint, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+		all      []*LoggingEndpoint
+	)
+
+	for _, list := range loggingListers {
+		wg.Add(1)
+		go func(list func(*Client, string, int) ([]*LoggingEndpoint, error)) {
+			defer wg.Done()
+
+			es, err := list(c, i.Service, i.Version)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				return
+			}
+			all = append(all, es...)
+		}(list)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+
+	sort.Stable(loggingEndpointsByType(all))
+	return all, nil
+}
+
+// CreateLoggingEndpointInput is used as input to the CreateLoggingEndpoint
+// function.
+type CreateLoggingEndpointInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Type is the type of logging endpoint to create (required).
+	Type LoggingType
+
+	// Params are the API fields of the logging endpoint, keyed by their API
+	// names (e.g. "bucket_name" or "format_version").
+	Params map[string]interface{}
+}
+
+// CreateLoggingEndpoint creates a logging endpoint of the given type from a
+// map of API fields. This is useful for tools driven by configuration files,
+// which cannot know the concrete input struct at compile time. The returned
+// value is the concrete endpoint type (e.g. *S3 or *Syslog). Unknown fields
+// in Params result in an error.
+func (c *Client) CreateLoggingEndpoint(i *CreateLoggingEndpointInput) (interface{}, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Type == "" {
+		return nil, ErrMissingType
+	}
+
+	switch i.Type {
+	case LoggingTypeBigQuery:
+		var in CreateBigQueryInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version = i.Service, i.Version
+		l, err := c.CreateBigQuery(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeFTP:
+		var in CreateFTPInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version = i.Service, i.Version
+		l, err := c.CreateFTP(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeGCS:
+		var in CreateGCSInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version = i.Service, i.Version
+		l, err := c.CreateGCS(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeGrafanaCloudLogs:
+		var in CreateGrafanaCloudLogsInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version = i.Service, i.Version
+		l, err := c.CreateGrafanaCloudLogs(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeKinesis:
+		var in CreateKinesisInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version = i.Service, i.Version
+		l, err := c.CreateKinesis(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeLogentries:
+		var in CreateLogentriesInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version = i.Service, i.Version
+		l, err := c.CreateLogentries(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeNewRelicOTLP:
+		var in CreateNewRelicOTLPInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version = i.Service, i.Version
+		l, err := c.CreateNewRelicOTLP(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypePapertrail:
+		var in CreatePapertrailInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version = i.Service, i.Version
+		l, err := c.CreatePapertrail(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeS3:
+		var in CreateS3Input
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version = i.Service, i.Version
+		l, err := c.CreateS3(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeSumologic:
+		var in CreateSumologicInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version = i.Service, i.Version
+		l, err := c.CreateSumologic(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeSyslog:
+		var in CreateSyslogInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version = i.Service, i.Version
+		l, err := c.CreateSyslog(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	default:
+		return nil, fmt.Errorf("Unknown logging type %q", i.Type)
+	}
+}
+
+// UpdateLoggingEndpointInput is used as input to the UpdateLoggingEndpoint
+// function.
+type UpdateLoggingEndpointInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Type is the type of logging endpoint to update (required).
+	Type LoggingType
+
+	// Name is the name of the logging endpoint to update (required).
+	Name string
+
+	// Params are the API fields to change, keyed by their API names. To rename
+	// the endpoint, set the "name" field.
+	Params map[string]interface{}
+}
+
+// UpdateLoggingEndpoint updates a logging endpoint of the given type from a
+// map of API fields. The returned value is the concrete endpoint type.
+func (c *Client) UpdateLoggingEndpoint(i *UpdateLoggingEndpointInput) (interface{}, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Type == "" {
+		return nil, ErrMissingType
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	switch i.Type {
+	case LoggingTypeBigQuery:
+		var in UpdateBigQueryInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version, in.Name = i.Service, i.Version, i.Name
+		l, err := c.UpdateBigQuery(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeFTP:
+		var in UpdateFTPInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version, in.Name = i.Service, i.Version, i.Name
+		l, err := c.UpdateFTP(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeGCS:
+		var in UpdateGCSInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version, in.Name = i.Service, i.Version, i.Name
+		l, err := c.UpdateGCS(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeGrafanaCloudLogs:
+		var in UpdateGrafanaCloudLogsInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version, in.Name = i.Service, i.Version, i.Name
+		l, err := c.UpdateGrafanaCloudLogs(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeKinesis:
+		var in UpdateKinesisInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version, in.Name = i.Service, i.Version, i.Name
+		l, err := c.UpdateKinesis(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeLogentries:
+		var in UpdateLogentriesInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version, in.Name = i.Service, i.Version, i.Name
+		l, err := c.UpdateLogentries(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeNewRelicOTLP:
+		var in UpdateNewRelicOTLPInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version, in.Name = i.Service, i.Version, i.Name
+		l, err := c.UpdateNewRelicOTLP(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypePapertrail:
+		var in UpdatePapertrailInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version, in.Name = i.Service, i.Version, i.Name
+		l, err := c.UpdatePapertrail(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeS3:
+		var in UpdateS3Input
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version, in.Name = i.Service, i.Version, i.Name
+		l, err := c.UpdateS3(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeSumologic:
+		var in UpdateSumologicInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version, in.Name = i.Service, i.Version, i.Name
+		l, err := c.UpdateSumologic(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	case LoggingTypeSyslog:
+		var in UpdateSyslogInput
+		if err := decodeLoggingParams(i.Type, i.Params, &in); err != nil {
+			return nil, err
+		}
+		in.Service, in.Version, in.Name = i.Service, i.Version, i.Name
+		l, err := c.UpdateSyslog(&in)
+		if err != nil {
+			return nil, err
+		}
+		return l, nil
+	default:
+		return nil, fmt.Errorf("Unknown logging type %q", i.Type)
+	}
+}
+
+// DeleteLoggingEndpointInput is the input parameter to DeleteLoggingEndpoint.
+type DeleteLoggingEndpointInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Type is the type of logging endpoint to delete (required).
+	Type LoggingType
+
+	// Name is the name of the logging endpoint to delete (required).
+	Name string
+}
+
+// DeleteLoggingEndpoint deletes a logging endpoint of the given type.
+func (c *Client) DeleteLoggingEndpoint(i *DeleteLoggingEndpointInput) error {
+	if i.Service == "" {
+		return ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return ErrMissingVersion
+	}
+
+	if i.Type == "" {
+		return ErrMissingType
+	}
+
+	if i.Name == "" {
+		return ErrMissingName
+	}
+
+	switch i.Type {
+	case LoggingTypeBigQuery:
+		return c.DeleteBigQuery(&DeleteBigQueryInput{Service: i.Service, Version: i.Version, Name: i.Name})
+	case LoggingTypeFTP:
+		return c.DeleteFTP(&DeleteFTPInput{Service: i.Service, Version: i.Version, Name: i.Name})
+	case LoggingTypeGCS:
+		return c.DeleteGCS(&DeleteGCSInput{Service: i.Service, Version: i.Version, Name: i.Name})
+	case LoggingTypeGrafanaCloudLogs:
+		return c.DeleteGrafanaCloudLogs(&DeleteGrafanaCloudLogsInput{Service: i.Service, Version: i.Version, Name: i.Name})
+	case LoggingTypeKinesis:
+		return c.DeleteKinesis(&DeleteKinesisInput{Service: i.Service, Version: i.Version, Name: i.Name})
+	case LoggingTypeLogentries:
+		return c.DeleteLogentries(&DeleteLogentriesInput{Service: i.Service, Version: i.Version, Name: i.Name})
+	case LoggingTypeNewRelicOTLP:
+		return c.DeleteNewRelicOTLP(&DeleteNewRelicOTLPInput{Service: i.Service, Version: i.Version, Name: i.Name})
+	case LoggingTypePapertrail:
+		return c.DeletePapertrail(&DeletePapertrailInput{Service: i.Service, Version: i.Version, Name: i.Name})
+	case LoggingTypeS3:
+		return c.DeleteS3(&DeleteS3Input{Service: i.Service, Version: i.Version, Name: i.Name})
+	case LoggingTypeSumologic:
+		return c.DeleteSumologic(&DeleteSumologicInput{Service: i.Service, Version: i.Version, Name: i.Name})
+	case LoggingTypeSyslog:
+		return c.DeleteSyslog(&DeleteSyslogInput{Service: i.Service, Version: i.Version, Name: i.Name})
+	default:
+		return fmt.Errorf("Unknown logging type %q", i.Type)
+	}
+}
+
+// decodeLoggingParams decodes a map of API fields into the given logging input
+// struct, matching keys against the struct's form tags. The BigQuery inputs do
+// not carry form tags, so their keys are matched against the field names with
+// the underscores removed (e.g. "project_id" becomes "ProjectID").
+func decodeLoggingParams(t LoggingType, params map[string]interface{}, out interface{}) error {
+	if t == LoggingTypeBigQuery {
+		stripped := make(map[string]interface{}, len(params))
+		for k, v := range params {
+			stripped[strings.Replace(k, "_", "", -1)] = v
+		}
+		params = stripped
+	}
+
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		ErrorUnused:      true,
+		TagName:          "form",
+		WeaklyTypedInput: true,
+		Result:           out,
+	})
+	if err != nil {
+		return err
+	}
+	return decoder.Decode(params)
+}
+
+// validateLoggingBatching checks the batching controls shared by the
+// object-storage logging inputs before they are sent to the API.
+func validateLoggingBatching(gzipLevel, fileMaxBytes uint) error {
+	if gzipLevel > MaxLoggingGzipLevel {
+		return ErrInvalidGzipLevel
+	}
+
+	if fileMaxBytes != 0 && fileMaxBytes < MinLoggingFileMaxBytes {
+		return ErrInvalidFileMaxBytes
+	}
+	return nil
+}
+
+// withLoggingDefaults returns the input of a logging endpoint create, a
+// pointer to a struct, with the client's DefaultLogFormat and
+// DefaultLogFormatVersion applied to its empty Format and FormatVersion
+// fields, and DefaultLoggingPeriod to its empty Period field. The input is
+// copied rather than modified.
+func (c *Client) withLoggingDefaults(i interface{}) interface{} {
+	in := reflect.ValueOf(i)
+	if in.Kind() != reflect.Ptr || in.IsNil() || in.Elem().Kind() != reflect.Struct {
+		return i
+	}
+
+	period := in.Elem().FieldByName("Period")
+	needsPeriod := period.IsValid() && period.Kind() == reflect.Uint && period.Uint() == 0
+	if c.DefaultLogFormat == "" && c.DefaultLogFormatVersion == 0 && !needsPeriod {
+		return i
+	}
+
+	out := reflect.New(in.Elem().Type())
+	out.Elem().Set(in.Elem())
+
+	if needsPeriod {
+		out.Elem().FieldByName("Period").SetUint(uint64(DefaultLoggingPeriod))
+	}
+
+	if f := out.Elem().FieldByName("Format"); f.IsValid() && f.Kind() == reflect.String && f.String() == "" {
+		f.SetString(c.DefaultLogFormat)
+	}
+	if f := out.Elem().FieldByName("FormatVersion"); f.IsValid() {
+		switch f.Kind() {
+		case reflect.Uint:
+			if f.Uint() == 0 {
+				f.SetUint(uint64(c.DefaultLogFormatVersion))
+			}
+		case reflect.Int:
+			if f.Int() == 0 {
+				f.SetInt(int64(c.DefaultLogFormatVersion))
+			}
+		}
+	}
+	return out.Interface()
+}
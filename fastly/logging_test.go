@@ -0,0 +1,366 @@
+package fastly
+
+import "testing"
+
+func TestClient_ListAllLoggingEndpoints(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var tv *Version
+	record(t, "logging/version", func(c *Client) {
+		tv = testVersion(t, c)
+	})
+
+	var es []*LoggingEndpoint
+	record(t, "logging/list_all", func(c *Client) {
+		es, err = c.ListAllLoggingEndpoints(&ListAllLoggingEndpointsInput{
+			Service: testServiceID,
+			Version: tv.Number,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(es) != 3 {
+		t.Fatalf("bad endpoints: %v", es)
+	}
+
+	if es[0].Type != LoggingTypeGCS || es[0].Name != "test-gcs" {
+		t.Errorf("bad endpoint: %v", es[0])
+	}
+	if es[1].Type != LoggingTypeS3 || es[1].Name != "a-test-s3" {
+		t.Errorf("bad endpoint: %v", es[1])
+	}
+	if es[2].Type != LoggingTypeS3 || es[2].Name != "b-test-s3" {
+		t.Errorf("bad endpoint: %v", es[2])
+	}
+	if es[1].Format != "format" {
+		t.Errorf("bad format: %q", es[1].Format)
+	}
+	if es[1].FormatVersion != 2 {
+		t.Errorf("bad format_version: %d", es[1].FormatVersion)
+	}
+	if es[1].Version != tv.Number {
+		t.Errorf("bad version: %d", es[1].Version)
+	}
+}
+
+func TestClient_ListAllLoggingEndpoints_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListAllLoggingEndpoints(&ListAllLoggingEndpointsInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ListAllLoggingEndpoints(&ListAllLoggingEndpointsInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_LoggingEndpoints_generic(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var tv *Version
+	record(t, "logging/generic/version", func(c *Client) {
+		tv = testVersion(t, c)
+	})
+
+	// Create
+	var created interface{}
+	record(t, "logging/generic/create", func(c *Client) {
+		created, err = c.CreateLoggingEndpoint(&CreateLoggingEndpointInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Type:    "s3",
+			Params: map[string]interface{}{
+				"name":           "test-s3",
+				"bucket_name":    "bucket-name",
+				"period":         "12",
+				"gzip_level":     9,
+				"format":         "format",
+				"format_version": 2,
+			},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure deleted
+	defer func() {
+		record(t, "logging/generic/cleanup", func(c *Client) {
+			c.DeleteLoggingEndpoint(&DeleteLoggingEndpointInput{
+				Service: testServiceID,
+				Version: tv.Number,
+				Type:    LoggingTypeS3,
+				Name:    "test-s3",
+			})
+
+			c.DeleteLoggingEndpoint(&DeleteLoggingEndpointInput{
+				Service: testServiceID,
+				Version: tv.Number,
+				Type:    LoggingTypeS3,
+				Name:    "new-test-s3",
+			})
+		})
+	}()
+
+	s3, ok := created.(*S3)
+	if !ok {
+		t.Fatalf("bad type: %T", created)
+	}
+	if s3.Name != "test-s3" {
+		t.Errorf("bad name: %q", s3.Name)
+	}
+	if s3.BucketName != "bucket-name" {
+		t.Errorf("bad bucket_name: %q", s3.BucketName)
+	}
+	if s3.Period != 12 {
+		t.Errorf("bad period: %d", s3.Period)
+	}
+
+	// Update
+	var updated interface{}
+	record(t, "logging/generic/update", func(c *Client) {
+		updated, err = c.UpdateLoggingEndpoint(&UpdateLoggingEndpointInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Type:    LoggingTypeS3,
+			Name:    "test-s3",
+			Params: map[string]interface{}{
+				"name": "new-test-s3",
+			},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if us3 := updated.(*S3); us3.Name != "new-test-s3" {
+		t.Errorf("bad name: %q", us3.Name)
+	}
+
+	// Delete
+	record(t, "logging/generic/delete", func(c *Client) {
+		err = c.DeleteLoggingEndpoint(&DeleteLoggingEndpointInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Type:    LoggingTypeS3,
+			Name:    "new-test-s3",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_CreateLoggingEndpoint_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateLoggingEndpoint(&CreateLoggingEndpointInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateLoggingEndpoint(&CreateLoggingEndpointInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateLoggingEndpoint(&CreateLoggingEndpointInput{
+		Service: "foo",
+		Version: 1,
+		Type:    "",
+	})
+	if err != ErrMissingType {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateLoggingEndpoint(&CreateLoggingEndpointInput{
+		Service: "foo",
+		Version: 1,
+		Type:    "carrier-pigeon",
+	})
+	if err == nil {
+		t.Error("expected an error for an unknown type")
+	}
+
+	_, err = testClient.CreateLoggingEndpoint(&CreateLoggingEndpointInput{
+		Service: "foo",
+		Version: 1,
+		Type:    LoggingTypeS3,
+		Params: map[string]interface{}{
+			"bucket_nmae": "typo",
+		},
+	})
+	if err == nil {
+		t.Error("expected an error for an unknown field")
+	}
+}
+
+func TestClient_UpdateLoggingEndpoint_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateLoggingEndpoint(&UpdateLoggingEndpointInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateLoggingEndpoint(&UpdateLoggingEndpointInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateLoggingEndpoint(&UpdateLoggingEndpointInput{
+		Service: "foo",
+		Version: 1,
+		Type:    "",
+	})
+	if err != ErrMissingType {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateLoggingEndpoint(&UpdateLoggingEndpointInput{
+		Service: "foo",
+		Version: 1,
+		Type:    LoggingTypeS3,
+		Name:    "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_DeleteLoggingEndpoint_validation(t *testing.T) {
+	var err error
+	err = testClient.DeleteLoggingEndpoint(&DeleteLoggingEndpointInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteLoggingEndpoint(&DeleteLoggingEndpointInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteLoggingEndpoint(&DeleteLoggingEndpointInput{
+		Service: "foo",
+		Version: 1,
+		Type:    "",
+	})
+	if err != ErrMissingType {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteLoggingEndpoint(&DeleteLoggingEndpointInput{
+		Service: "foo",
+		Version: 1,
+		Type:    LoggingTypeS3,
+		Name:    "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestDecodeLoggingParams_bigquery(t *testing.T) {
+	var in CreateBigQueryInput
+	err := decodeLoggingParams(LoggingTypeBigQuery, map[string]interface{}{
+		"name":       "test-bigquery",
+		"project_id": "project",
+		"secret_key": "key",
+	}, &in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if in.Name != "test-bigquery" {
+		t.Errorf("bad name: %q", in.Name)
+	}
+	if in.ProjectID != "project" {
+		t.Errorf("bad project_id: %q", in.ProjectID)
+	}
+	if in.SecretKey != "key" {
+		t.Errorf("bad secret_key: %q", in.SecretKey)
+	}
+}
+
+func TestClient_DefaultLogFormat(t *testing.T) {
+	t.Parallel()
+
+	format := `{"time":"%{begin:%Y-%m-%dT%H:%M:%S%z}t","host":"%{req.http.Host}V","status":"%>s"}`
+
+	var s *Syslog
+	var err error
+	record(t, "logging/create_default_format", func(c *Client) {
+		c.DefaultLogFormat = format
+		c.DefaultLogFormatVersion = 2
+		s, err = c.CreateSyslog(&CreateSyslogInput{
+			Service: testServiceID,
+			Version: 7,
+			Name:    "default-format",
+			Address: "example.com",
+			Port:    514,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Format != format || s.FormatVersion != 2 {
+		t.Errorf("bad format: %q (version %d)", s.Format, s.FormatVersion)
+	}
+}
+
+func TestClient_withLoggingDefaults(t *testing.T) {
+	c := &Client{DefaultLogFormat: "default", DefaultLogFormatVersion: 2}
+
+	in := &CreateSumologicInput{Service: "foo", Version: 1}
+	out := c.withLoggingDefaults(in).(*CreateSumologicInput)
+	if out.Format != "default" || out.FormatVersion != 2 {
+		t.Errorf("bad defaults: %+v", out)
+	}
+	if in.Format != "" || in.FormatVersion != 0 {
+		t.Errorf("expected the input to be copied: %+v", in)
+	}
+
+	set := &CreateS3Input{Format: "custom", FormatVersion: 1}
+	if out := c.withLoggingDefaults(set).(*CreateS3Input); out.Format != "custom" || out.FormatVersion != 1 {
+		t.Errorf("expected explicit values to be kept: %+v", out)
+	}
+
+	if out := c.withLoggingDefaults(&CreateFTPInput{}).(*CreateFTPInput); out.Format != "default" {
+		t.Errorf("bad defaults: %+v", out)
+	}
+
+	none := &CreateSyslogInput{}
+	if out := (&Client{}).withLoggingDefaults(none); out != none {
+		t.Errorf("expected the input unchanged without defaults")
+	}
+
+	ftp := &CreateFTPInput{}
+	if out := (&Client{}).withLoggingDefaults(ftp).(*CreateFTPInput); out.Period != DefaultLoggingPeriod || ftp.Period != 0 {
+		t.Errorf("bad default period: %d", out.Period)
+	}
+	hourly := &CreateGCSInput{Period: 60}
+	if out := (&Client{}).withLoggingDefaults(hourly); out != hourly {
+		t.Errorf("expected an explicit period to be kept")
+	}
+}
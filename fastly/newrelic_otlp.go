@@ -0,0 +1,244 @@
+package fastly
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// NewRelicOTLP represents a New Relic OTLP logging response from the Fastly API.
+type NewRelicOTLP struct {
+	ServiceID string `mapstructure:"service_id"`
+	Version   int    `mapstructure:"version"`
+
+	Name              string     `mapstructure:"name"`
+	Token             string     `mapstructure:"token" sensitive:"true"`
+	Region            string     `mapstructure:"region"`
+	URL               string     `mapstructure:"url"`
+	Format            string     `mapstructure:"format"`
+	FormatVersion     uint       `mapstructure:"format_version"`
+	ResponseCondition string     `mapstructure:"response_condition"`
+	CreatedAt         *time.Time `mapstructure:"created_at"`
+	UpdatedAt         *time.Time `mapstructure:"updated_at"`
+	DeletedAt         *time.Time `mapstructure:"deleted_at"`
+}
+
+// String formats the New Relic OTLP endpoint with its token redacted.
+func (n *NewRelicOTLP) String() string {
+	return redactedString(n)
+}
+
+// GoString is like String, for the %#v verb.
+func (n *NewRelicOTLP) GoString() string {
+	return redactedGoString(n)
+}
+
+// newRelicOTLPByName is a sortable list of New Relic OTLP endpoints.
+type newRelicOTLPByName []*NewRelicOTLP
+
+// Len, Swap, and Less implement the sortable interface.
+func (s newRelicOTLPByName) Len() int      { return len(s) }
+func (s newRelicOTLPByName) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s newRelicOTLPByName) Less(i, j int) bool {
+	return s[i].Name < s[j].Name
+}
+
+// ListNewRelicOTLPInput is used as input to the ListNewRelicOTLP function.
+type ListNewRelicOTLPInput struct {
+	// Service is the ID of the service (required).
+	Service string
+
+	// Version is the specific configuration version (required).
+	Version int
+}
+
+// ListNewRelicOTLP returns the list of New Relic OTLP endpoints for the configuration
+// version.
+func (c *Client) ListNewRelicOTLP(i *ListNewRelicOTLPInput) ([]*NewRelicOTLP, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/newrelicotlp", i.Service, i.Version)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ns []*NewRelicOTLP
+	if err := decodeJSON(&ns, resp.Body); err != nil {
+		return nil, err
+	}
+	sort.Stable(newRelicOTLPByName(ns))
+	return ns, nil
+}
+
+// CreateNewRelicOTLPInput is used as input to the CreateNewRelicOTLP function.
+type CreateNewRelicOTLPInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	Name              string `form:"name,omitempty"`
+	Token             string `form:"token,omitempty" sensitive:"true"`
+	Region            string `form:"region,omitempty"`
+	URL               string `form:"url,omitempty"`
+	Format            string `form:"format,omitempty"`
+	FormatVersion     uint   `form:"format_version,omitempty"`
+	ResponseCondition string `form:"response_condition,omitempty"`
+	Placement         string `form:"placement,omitempty"`
+}
+
+// CreateNewRelicOTLP creates a new Fastly New Relic OTLP logging endpoint.
+func (c *Client) CreateNewRelicOTLP(i *CreateNewRelicOTLPInput) (*NewRelicOTLP, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/newrelicotlp", i.Service, i.Version)
+	resp, err := c.PostForm(path, c.withLoggingDefaults(i), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var n *NewRelicOTLP
+	if err := decodeJSON(&n, resp.Body); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// GetNewRelicOTLPInput is used as input to the GetNewRelicOTLP function.
+type GetNewRelicOTLPInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the New Relic OTLP endpoint to fetch.
+	Name string
+}
+
+// GetNewRelicOTLP gets the New Relic OTLP logging configuration with the given parameters.
+func (c *Client) GetNewRelicOTLP(i *GetNewRelicOTLPInput) (*NewRelicOTLP, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/newrelicotlp/%s", i.Service, i.Version, url.PathEscape(i.Name))
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var n *NewRelicOTLP
+	if err := decodeJSON(&n, resp.Body); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// UpdateNewRelicOTLPInput is used as input to the UpdateNewRelicOTLP function.
+type UpdateNewRelicOTLPInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the New Relic OTLP endpoint to update.
+	Name string
+
+	NewName           string `form:"name,omitempty"`
+	Token             string `form:"token,omitempty" sensitive:"true"`
+	Region            string `form:"region,omitempty"`
+	URL               string `form:"url,omitempty"`
+	Format            string `form:"format,omitempty"`
+	FormatVersion     uint   `form:"format_version,omitempty"`
+	ResponseCondition string `form:"response_condition,omitempty"`
+}
+
+// UpdateNewRelicOTLP updates a specific New Relic OTLP logging endpoint.
+func (c *Client) UpdateNewRelicOTLP(i *UpdateNewRelicOTLPInput) (*NewRelicOTLP, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/newrelicotlp/%s", i.Service, i.Version, url.PathEscape(i.Name))
+	resp, err := c.PutForm(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var n *NewRelicOTLP
+	if err := decodeJSON(&n, resp.Body); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+// DeleteNewRelicOTLPInput is the input parameter to DeleteNewRelicOTLP.
+type DeleteNewRelicOTLPInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the New Relic OTLP endpoint to delete (required).
+	Name string
+}
+
+// DeleteNewRelicOTLP deletes the given New Relic OTLP logging endpoint.
+func (c *Client) DeleteNewRelicOTLP(i *DeleteNewRelicOTLPInput) error {
+	if i.Service == "" {
+		return ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return ErrMissingName
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/newrelicotlp/%s", i.Service, i.Version, url.PathEscape(i.Name))
+	resp, err := c.Delete(path, nil)
+	if err != nil {
+		return err
+	}
+
+	var r *statusResp
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return err
+	}
+	if !r.Ok() {
+		return fmt.Errorf("Not Ok")
+	}
+	return nil
+}
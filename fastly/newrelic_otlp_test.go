@@ -0,0 +1,252 @@
+package fastly
+
+import "testing"
+
+func TestClient_NewRelicOTLP(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var tv *Version
+	record(t, "newrelic_otlp/version", func(c *Client) {
+		tv = testVersion(t, c)
+	})
+
+	// Create
+	var n *NewRelicOTLP
+	record(t, "newrelic_otlp/create", func(c *Client) {
+		n, err = c.CreateNewRelicOTLP(&CreateNewRelicOTLPInput{
+			Service:       testServiceID,
+			Version:       tv.Number,
+			Name:          "test-newrelic-otlp",
+			Token:         "abcd1234",
+			Region:        "US",
+			Format:        "format",
+			FormatVersion: 2,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure deleted
+	defer func() {
+		record(t, "newrelic_otlp/cleanup", func(c *Client) {
+			c.DeleteNewRelicOTLP(&DeleteNewRelicOTLPInput{
+				Service: testServiceID,
+				Version: tv.Number,
+				Name:    "test-newrelic-otlp",
+			})
+
+			c.DeleteNewRelicOTLP(&DeleteNewRelicOTLPInput{
+				Service: testServiceID,
+				Version: tv.Number,
+				Name:    "new-test-newrelic-otlp",
+			})
+		})
+	}()
+
+	if n.Name != "test-newrelic-otlp" {
+		t.Errorf("bad name: %q", n.Name)
+	}
+	if n.Token != "abcd1234" {
+		t.Errorf("bad token: %q", n.Token)
+	}
+	if n.Region != "US" {
+		t.Errorf("bad region: %q", n.Region)
+	}
+	if n.Format != "format" {
+		t.Errorf("bad format: %q", n.Format)
+	}
+	if n.FormatVersion != 2 {
+		t.Errorf("bad format_version: %d", n.FormatVersion)
+	}
+
+	// List
+	var ns []*NewRelicOTLP
+	record(t, "newrelic_otlp/list", func(c *Client) {
+		ns, err = c.ListNewRelicOTLP(&ListNewRelicOTLPInput{
+			Service: testServiceID,
+			Version: tv.Number,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ns) < 1 {
+		t.Errorf("bad newrelic_otlp: %v", ns)
+	}
+
+	// Get
+	var nn *NewRelicOTLP
+	record(t, "newrelic_otlp/get", func(c *Client) {
+		nn, err = c.GetNewRelicOTLP(&GetNewRelicOTLPInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Name:    "test-newrelic-otlp",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Name != nn.Name {
+		t.Errorf("bad name: %q", n.Name)
+	}
+	if n.Token != nn.Token {
+		t.Errorf("bad token: %q", n.Token)
+	}
+	if n.Region != nn.Region {
+		t.Errorf("bad region: %q", n.Region)
+	}
+	if n.Format != nn.Format {
+		t.Errorf("bad format: %q", n.Format)
+	}
+	if n.FormatVersion != nn.FormatVersion {
+		t.Errorf("bad format_version: %d", n.FormatVersion)
+	}
+
+	// Update
+	var un *NewRelicOTLP
+	record(t, "newrelic_otlp/update", func(c *Client) {
+		un, err = c.UpdateNewRelicOTLP(&UpdateNewRelicOTLPInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Name:    "test-newrelic-otlp",
+			NewName: "new-test-newrelic-otlp",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if un.Name != "new-test-newrelic-otlp" {
+		t.Errorf("bad name: %q", un.Name)
+	}
+
+	// Delete
+	record(t, "newrelic_otlp/delete", func(c *Client) {
+		err = c.DeleteNewRelicOTLP(&DeleteNewRelicOTLPInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Name:    "new-test-newrelic-otlp",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_ListNewRelicOTLP_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListNewRelicOTLP(&ListNewRelicOTLPInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ListNewRelicOTLP(&ListNewRelicOTLPInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_CreateNewRelicOTLP_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateNewRelicOTLP(&CreateNewRelicOTLPInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateNewRelicOTLP(&CreateNewRelicOTLPInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetNewRelicOTLP_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetNewRelicOTLP(&GetNewRelicOTLPInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetNewRelicOTLP(&GetNewRelicOTLPInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetNewRelicOTLP(&GetNewRelicOTLPInput{
+		Service: "foo",
+		Version: 1,
+		Name:    "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdateNewRelicOTLP_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateNewRelicOTLP(&UpdateNewRelicOTLPInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateNewRelicOTLP(&UpdateNewRelicOTLPInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateNewRelicOTLP(&UpdateNewRelicOTLPInput{
+		Service: "foo",
+		Version: 1,
+		Name:    "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_DeleteNewRelicOTLP_validation(t *testing.T) {
+	var err error
+	err = testClient.DeleteNewRelicOTLP(&DeleteNewRelicOTLPInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteNewRelicOTLP(&DeleteNewRelicOTLPInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteNewRelicOTLP(&DeleteNewRelicOTLPInput{
+		Service: "foo",
+		Version: 1,
+		Name:    "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
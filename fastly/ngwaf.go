@@ -0,0 +1,758 @@
+package fastly
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// NGWAFRuleType is the kind of a Next-Gen WAF workspace rule.
+type NGWAFRuleType string
+
+const (
+	NGWAFRuleTypeRequest   NGWAFRuleType = "request"
+	NGWAFRuleTypeSignal    NGWAFRuleType = "signal"
+	NGWAFRuleTypeRateLimit NGWAFRuleType = "rate_limit"
+)
+
+// NGWAFRedactionType is the part of a request or response a Next-Gen WAF
+// redaction applies to.
+type NGWAFRedactionType string
+
+const (
+	NGWAFRedactionTypeRequestParameter NGWAFRedactionType = "request_parameter"
+	NGWAFRedactionTypeRequestHeader    NGWAFRedactionType = "request_header"
+	NGWAFRedactionTypeResponseHeader   NGWAFRedactionType = "response_header"
+)
+
+// NGWAFCondition is a single condition of a Next-Gen WAF rule, such as
+// matching the request path against a value.
+type NGWAFCondition struct {
+	Type     string `mapstructure:"type" json:"type"`
+	Field    string `mapstructure:"field" json:"field"`
+	Operator string `mapstructure:"operator" json:"operator"`
+	Value    string `mapstructure:"value" json:"value"`
+}
+
+// NGWAFAction is what a Next-Gen WAF rule does when its conditions match,
+// such as blocking the request or adding a signal.
+type NGWAFAction struct {
+	Type   string `mapstructure:"type" json:"type"`
+	Signal string `mapstructure:"signal" json:"signal,omitempty"`
+}
+
+// NGWAFRule represents a Next-Gen WAF workspace rule response from the Fastly
+// API.
+type NGWAFRule struct {
+	ID            string            `mapstructure:"id"`
+	Type          NGWAFRuleType     `mapstructure:"type"`
+	Description   string            `mapstructure:"description"`
+	Enabled       bool              `mapstructure:"enabled"`
+	GroupOperator string            `mapstructure:"group_operator"`
+	Conditions    []*NGWAFCondition `mapstructure:"conditions"`
+	Actions       []*NGWAFAction    `mapstructure:"actions"`
+	CreatedAt     *time.Time        `mapstructure:"created_at"`
+	UpdatedAt     *time.Time        `mapstructure:"updated_at"`
+}
+
+// ngwafRulesByID is a sortable list of Next-Gen WAF rules.
+type ngwafRulesByID []*NGWAFRule
+
+// Len, Swap, and Less implement the sortable interface.
+func (s ngwafRulesByID) Len() int      { return len(s) }
+func (s ngwafRulesByID) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s ngwafRulesByID) Less(i, j int) bool {
+	return s[i].ID < s[j].ID
+}
+
+// ngwafRulesResponse is the envelope the API wraps a list of rules in.
+type ngwafRulesResponse struct {
+	Data []*NGWAFRule `mapstructure:"data"`
+}
+
+// ListNGWAFRulesInput is used as input to the ListNGWAFRules function.
+type ListNGWAFRulesInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace (required).
+	Workspace string
+}
+
+// ListNGWAFRules returns the list of rules for the workspace.
+func (c *Client) ListNGWAFRules(i *ListNGWAFRulesInput) ([]*NGWAFRule, error) {
+	if i.Workspace == "" {
+		return nil, ErrMissingWorkspace
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/rules", i.Workspace)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *ngwafRulesResponse
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, nil
+	}
+	sort.Stable(ngwafRulesByID(r.Data))
+	return r.Data, nil
+}
+
+// CreateNGWAFRuleInput is used as input to the CreateNGWAFRule function.
+type CreateNGWAFRuleInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace (required).
+	Workspace string `json:"-"`
+
+	Type          NGWAFRuleType     `json:"type"`
+	Description   string            `json:"description,omitempty"`
+	Enabled       bool              `json:"enabled"`
+	GroupOperator string            `json:"group_operator,omitempty"`
+	Conditions    []*NGWAFCondition `json:"conditions,omitempty"`
+	Actions       []*NGWAFAction    `json:"actions,omitempty"`
+}
+
+// CreateNGWAFRule creates a new Next-Gen WAF workspace rule.
+func (c *Client) CreateNGWAFRule(i *CreateNGWAFRuleInput) (*NGWAFRule, error) {
+	if i.Workspace == "" {
+		return nil, ErrMissingWorkspace
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/rules", i.Workspace)
+	resp, err := c.PostJSON(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *NGWAFRule
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetNGWAFRuleInput is used as input to the GetNGWAFRule function.
+type GetNGWAFRuleInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace. ID is the ID of the
+	// rule. Both fields are required.
+	Workspace string
+	ID        string
+}
+
+// GetNGWAFRule gets the Next-Gen WAF workspace rule with the given ID.
+func (c *Client) GetNGWAFRule(i *GetNGWAFRuleInput) (*NGWAFRule, error) {
+	if i.Workspace == "" {
+		return nil, ErrMissingWorkspace
+	}
+
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/rules/%s", i.Workspace, i.ID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *NGWAFRule
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// UpdateNGWAFRuleInput is used as input to the UpdateNGWAFRule function.
+type UpdateNGWAFRuleInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace. ID is the ID of the
+	// rule. Both fields are required.
+	Workspace string `json:"-"`
+	ID        string `json:"-"`
+
+	Description   string            `json:"description,omitempty"`
+	Enabled       *bool             `json:"enabled,omitempty"`
+	GroupOperator string            `json:"group_operator,omitempty"`
+	Conditions    []*NGWAFCondition `json:"conditions,omitempty"`
+	Actions       []*NGWAFAction    `json:"actions,omitempty"`
+}
+
+// UpdateNGWAFRule updates a specific Next-Gen WAF workspace rule.
+func (c *Client) UpdateNGWAFRule(i *UpdateNGWAFRuleInput) (*NGWAFRule, error) {
+	if i.Workspace == "" {
+		return nil, ErrMissingWorkspace
+	}
+
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/rules/%s", i.Workspace, i.ID)
+	resp, err := c.PatchJSON(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *NGWAFRule
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// DeleteNGWAFRuleInput is the input parameter to DeleteNGWAFRule.
+type DeleteNGWAFRuleInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace. ID is the ID of the
+	// rule. Both fields are required.
+	Workspace string
+	ID        string
+}
+
+// DeleteNGWAFRule deletes the given Next-Gen WAF workspace rule.
+func (c *Client) DeleteNGWAFRule(i *DeleteNGWAFRuleInput) error {
+	if i.Workspace == "" {
+		return ErrMissingWorkspace
+	}
+
+	if i.ID == "" {
+		return ErrMissingID
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/rules/%s", i.Workspace, i.ID)
+	resp, err := c.Delete(path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// The Next-Gen WAF API responds with 204 No Content rather than a status
+	// response.
+	return nil
+}
+
+// NGWAFSignal represents a Next-Gen WAF custom signal response from the Fastly
+// API. Rules refer to a signal by its ReferenceID.
+type NGWAFSignal struct {
+	ID          string     `mapstructure:"id"`
+	ReferenceID string     `mapstructure:"reference_id"`
+	Name        string     `mapstructure:"name"`
+	Description string     `mapstructure:"description"`
+	CreatedAt   *time.Time `mapstructure:"created_at"`
+	UpdatedAt   *time.Time `mapstructure:"updated_at"`
+}
+
+// ngwafSignalsByName is a sortable list of Next-Gen WAF signals.
+type ngwafSignalsByName []*NGWAFSignal
+
+// Len, Swap, and Less implement the sortable interface.
+func (s ngwafSignalsByName) Len() int      { return len(s) }
+func (s ngwafSignalsByName) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s ngwafSignalsByName) Less(i, j int) bool {
+	return s[i].Name < s[j].Name
+}
+
+// ngwafSignalsResponse is the envelope the API wraps a list of signals in.
+type ngwafSignalsResponse struct {
+	Data []*NGWAFSignal `mapstructure:"data"`
+}
+
+// ListNGWAFSignalsInput is used as input to the ListNGWAFSignals function.
+type ListNGWAFSignalsInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace (required).
+	Workspace string
+}
+
+// ListNGWAFSignals returns the list of custom signals for the workspace.
+func (c *Client) ListNGWAFSignals(i *ListNGWAFSignalsInput) ([]*NGWAFSignal, error) {
+	if i.Workspace == "" {
+		return nil, ErrMissingWorkspace
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/signals", i.Workspace)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *ngwafSignalsResponse
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, nil
+	}
+	sort.Stable(ngwafSignalsByName(r.Data))
+	return r.Data, nil
+}
+
+// CreateNGWAFSignalInput is used as input to the CreateNGWAFSignal function.
+type CreateNGWAFSignalInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace (required).
+	Workspace string `json:"-"`
+
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+}
+
+// CreateNGWAFSignal creates a new Next-Gen WAF custom signal.
+func (c *Client) CreateNGWAFSignal(i *CreateNGWAFSignalInput) (*NGWAFSignal, error) {
+	if i.Workspace == "" {
+		return nil, ErrMissingWorkspace
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/signals", i.Workspace)
+	resp, err := c.PostJSON(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var s *NGWAFSignal
+	if err := decodeJSON(&s, resp.Body); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// GetNGWAFSignalInput is used as input to the GetNGWAFSignal function.
+type GetNGWAFSignalInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace. ID is the ID of the
+	// signal. Both fields are required.
+	Workspace string
+	ID        string
+}
+
+// GetNGWAFSignal gets the Next-Gen WAF custom signal with the given ID.
+func (c *Client) GetNGWAFSignal(i *GetNGWAFSignalInput) (*NGWAFSignal, error) {
+	if i.Workspace == "" {
+		return nil, ErrMissingWorkspace
+	}
+
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/signals/%s", i.Workspace, i.ID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var s *NGWAFSignal
+	if err := decodeJSON(&s, resp.Body); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UpdateNGWAFSignalInput is used as input to the UpdateNGWAFSignal function.
+type UpdateNGWAFSignalInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace. ID is the ID of the
+	// signal. Both fields are required.
+	Workspace string `json:"-"`
+	ID        string `json:"-"`
+
+	Description string `json:"description"`
+}
+
+// UpdateNGWAFSignal updates the description of a Next-Gen WAF custom signal.
+// The name of a signal cannot be changed once it has been created.
+func (c *Client) UpdateNGWAFSignal(i *UpdateNGWAFSignalInput) (*NGWAFSignal, error) {
+	if i.Workspace == "" {
+		return nil, ErrMissingWorkspace
+	}
+
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/signals/%s", i.Workspace, i.ID)
+	resp, err := c.PatchJSON(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var s *NGWAFSignal
+	if err := decodeJSON(&s, resp.Body); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// DeleteNGWAFSignalInput is the input parameter to DeleteNGWAFSignal.
+type DeleteNGWAFSignalInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace. ID is the ID of the
+	// signal. Both fields are required.
+	Workspace string
+	ID        string
+}
+
+// DeleteNGWAFSignal deletes the given Next-Gen WAF custom signal.
+func (c *Client) DeleteNGWAFSignal(i *DeleteNGWAFSignalInput) error {
+	if i.Workspace == "" {
+		return ErrMissingWorkspace
+	}
+
+	if i.ID == "" {
+		return ErrMissingID
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/signals/%s", i.Workspace, i.ID)
+	resp, err := c.Delete(path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// NGWAFRedaction represents a Next-Gen WAF redaction response from the Fastly
+// API. Values of the named field are redacted from stored request data.
+type NGWAFRedaction struct {
+	ID        string             `mapstructure:"id"`
+	Field     string             `mapstructure:"field"`
+	Type      NGWAFRedactionType `mapstructure:"type"`
+	CreatedAt *time.Time         `mapstructure:"created_at"`
+}
+
+// ngwafRedactionsByField is a sortable list of Next-Gen WAF redactions.
+type ngwafRedactionsByField []*NGWAFRedaction
+
+// Len, Swap, and Less implement the sortable interface.
+func (s ngwafRedactionsByField) Len() int      { return len(s) }
+func (s ngwafRedactionsByField) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s ngwafRedactionsByField) Less(i, j int) bool {
+	return s[i].Field < s[j].Field
+}
+
+// ngwafRedactionsResponse is the envelope the API wraps a list of redactions
+// in.
+type ngwafRedactionsResponse struct {
+	Data []*NGWAFRedaction `mapstructure:"data"`
+}
+
+// ListNGWAFRedactionsInput is used as input to the ListNGWAFRedactions
+// function.
+type ListNGWAFRedactionsInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace (required).
+	Workspace string
+}
+
+// ListNGWAFRedactions returns the list of redactions for the workspace.
+func (c *Client) ListNGWAFRedactions(i *ListNGWAFRedactionsInput) ([]*NGWAFRedaction, error) {
+	if i.Workspace == "" {
+		return nil, ErrMissingWorkspace
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/redactions", i.Workspace)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *ngwafRedactionsResponse
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	if r == nil {
+		return nil, nil
+	}
+	sort.Stable(ngwafRedactionsByField(r.Data))
+	return r.Data, nil
+}
+
+// CreateNGWAFRedactionInput is used as input to the CreateNGWAFRedaction
+// function.
+type CreateNGWAFRedactionInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace (required).
+	Workspace string `json:"-"`
+
+	Field string             `json:"field"`
+	Type  NGWAFRedactionType `json:"type"`
+}
+
+// CreateNGWAFRedaction creates a new Next-Gen WAF redaction.
+func (c *Client) CreateNGWAFRedaction(i *CreateNGWAFRedactionInput) (*NGWAFRedaction, error) {
+	if i.Workspace == "" {
+		return nil, ErrMissingWorkspace
+	}
+
+	if i.Field == "" {
+		return nil, ErrMissingField
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/redactions", i.Workspace)
+	resp, err := c.PostJSON(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *NGWAFRedaction
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// UpdateNGWAFRedactionInput is used as input to the UpdateNGWAFRedaction
+// function.
+type UpdateNGWAFRedactionInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace. ID is the ID of the
+	// redaction. Both fields are required.
+	Workspace string `json:"-"`
+	ID        string `json:"-"`
+
+	Field string             `json:"field,omitempty"`
+	Type  NGWAFRedactionType `json:"type,omitempty"`
+}
+
+// UpdateNGWAFRedaction updates a specific Next-Gen WAF redaction.
+func (c *Client) UpdateNGWAFRedaction(i *UpdateNGWAFRedactionInput) (*NGWAFRedaction, error) {
+	if i.Workspace == "" {
+		return nil, ErrMissingWorkspace
+	}
+
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/redactions/%s", i.Workspace, i.ID)
+	resp, err := c.PatchJSON(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *NGWAFRedaction
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// DeleteNGWAFRedactionInput is the input parameter to DeleteNGWAFRedaction.
+type DeleteNGWAFRedactionInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace. ID is the ID of the
+	// redaction. Both fields are required.
+	Workspace string
+	ID        string
+}
+
+// DeleteNGWAFRedaction deletes the given Next-Gen WAF redaction.
+func (c *Client) DeleteNGWAFRedaction(i *DeleteNGWAFRedactionInput) error {
+	if i.Workspace == "" {
+		return ErrMissingWorkspace
+	}
+
+	if i.ID == "" {
+		return ErrMissingID
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/redactions/%s", i.Workspace, i.ID)
+	resp, err := c.Delete(path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return nil
+}
+
+// NGWAFEdgeDeployment represents the Next-Gen WAF edge deployment of a
+// workspace, which runs the WAF on Fastly's edge in front of the services
+// mapped to it.
+type NGWAFEdgeDeployment struct {
+	WorkspaceID   string                        `mapstructure:"workspace_id"`
+	AgentHostName string                        `mapstructure:"agent_host_name"`
+	Services      []*NGWAFEdgeDeploymentService `mapstructure:"services"`
+	CreatedAt     *time.Time                    `mapstructure:"created_at"`
+	UpdatedAt     *time.Time                    `mapstructure:"updated_at"`
+}
+
+// NGWAFEdgeDeploymentService represents a service mapped to a Next-Gen WAF
+// edge deployment. PercentEnabled is the share of the service's traffic sent
+// through the WAF.
+type NGWAFEdgeDeploymentService struct {
+	ServiceID      string     `mapstructure:"service_id"`
+	PercentEnabled int        `mapstructure:"percent_enabled"`
+	SyncedVersion  int        `mapstructure:"synced_version"`
+	SyncedAt       *time.Time `mapstructure:"synced_at"`
+	CreatedAt      *time.Time `mapstructure:"created_at"`
+	UpdatedAt      *time.Time `mapstructure:"updated_at"`
+}
+
+// ngwafEdgeDeploymentServicesByID is a sortable list of edge deployment
+// services.
+type ngwafEdgeDeploymentServicesByID []*NGWAFEdgeDeploymentService
+
+// Len, Swap, and Less implement the sortable interface.
+func (s ngwafEdgeDeploymentServicesByID) Len() int      { return len(s) }
+func (s ngwafEdgeDeploymentServicesByID) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s ngwafEdgeDeploymentServicesByID) Less(i, j int) bool {
+	return s[i].ServiceID < s[j].ServiceID
+}
+
+// CreateNGWAFEdgeDeploymentInput is used as input to the
+// CreateNGWAFEdgeDeployment function.
+type CreateNGWAFEdgeDeploymentInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace (required).
+	Workspace string
+}
+
+// CreateNGWAFEdgeDeployment creates the edge deployment for a Next-Gen WAF
+// workspace. It is safe to call for a workspace which already has one, in
+// which case the existing deployment is returned.
+func (c *Client) CreateNGWAFEdgeDeployment(i *CreateNGWAFEdgeDeploymentInput) (*NGWAFEdgeDeployment, error) {
+	if i.Workspace == "" {
+		return nil, ErrMissingWorkspace
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/edge-deployment", i.Workspace)
+	resp, err := c.Put(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var d *NGWAFEdgeDeployment
+	if err := decodeJSON(&d, resp.Body); err != nil {
+		return nil, err
+	}
+	sort.Stable(ngwafEdgeDeploymentServicesByID(d.Services))
+	return d, nil
+}
+
+// GetNGWAFEdgeDeploymentInput is used as input to the GetNGWAFEdgeDeployment
+// function.
+type GetNGWAFEdgeDeploymentInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace (required).
+	Workspace string
+}
+
+// GetNGWAFEdgeDeployment gets the edge deployment of a Next-Gen WAF workspace,
+// including the services mapped to it.
+func (c *Client) GetNGWAFEdgeDeployment(i *GetNGWAFEdgeDeploymentInput) (*NGWAFEdgeDeployment, error) {
+	if i.Workspace == "" {
+		return nil, ErrMissingWorkspace
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/edge-deployment", i.Workspace)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var d *NGWAFEdgeDeployment
+	if err := decodeJSON(&d, resp.Body); err != nil {
+		return nil, err
+	}
+	sort.Stable(ngwafEdgeDeploymentServicesByID(d.Services))
+	return d, nil
+}
+
+// MapNGWAFEdgeDeploymentServiceInput is used as input to the
+// MapNGWAFEdgeDeploymentService function.
+type MapNGWAFEdgeDeploymentServiceInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace. Service is the ID of
+	// the service to map to it. Both fields are required.
+	Workspace string `json:"-"`
+	Service   string `json:"-"`
+
+	// PercentEnabled is the share of traffic, from 0 to 100, sent through the
+	// WAF. Optional; the API default is 100.
+	PercentEnabled *int `json:"percent_enabled,omitempty"`
+
+	// ActivateVersion activates the service version the WAF is added to.
+	// Otherwise the new version is left inactive for the caller to activate.
+	ActivateVersion bool `json:"activate_version"`
+}
+
+// MapNGWAFEdgeDeploymentService maps a service to the edge deployment of a
+// Next-Gen WAF workspace, or updates an existing mapping. The API clones the
+// service's active version and adds the WAF to it.
+func (c *Client) MapNGWAFEdgeDeploymentService(i *MapNGWAFEdgeDeploymentServiceInput) (*NGWAFEdgeDeploymentService, error) {
+	if i.Workspace == "" {
+		return nil, ErrMissingWorkspace
+	}
+
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/edge-deployment/services/%s", i.Workspace, i.Service)
+	resp, err := c.PutJSON(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var s *NGWAFEdgeDeploymentService
+	if err := decodeJSON(&s, resp.Body); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// SyncNGWAFEdgeDeploymentServiceInput is used as input to the
+// SyncNGWAFEdgeDeploymentService function.
+type SyncNGWAFEdgeDeploymentServiceInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace. Service is the ID of
+	// the mapped service. Both fields are required.
+	Workspace string
+	Service   string
+}
+
+// SyncNGWAFEdgeDeploymentService updates the edge deployment with the
+// backends of the service's active version. It should be called after
+// activating a version which adds, removes or changes backends.
+func (c *Client) SyncNGWAFEdgeDeploymentService(i *SyncNGWAFEdgeDeploymentServiceInput) (*NGWAFEdgeDeploymentService, error) {
+	if i.Workspace == "" {
+		return nil, ErrMissingWorkspace
+	}
+
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/edge-deployment/services/%s/sync", i.Workspace, i.Service)
+	resp, err := c.Post(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var s *NGWAFEdgeDeploymentService
+	if err := decodeJSON(&s, resp.Body); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UnmapNGWAFEdgeDeploymentServiceInput is the input parameter to
+// UnmapNGWAFEdgeDeploymentService.
+type UnmapNGWAFEdgeDeploymentServiceInput struct {
+	// Workspace is the ID of the Next-Gen WAF workspace. Service is the ID of
+	// the mapped service. Both fields are required.
+	Workspace string
+	Service   string
+}
+
+// UnmapNGWAFEdgeDeploymentService removes a service from the edge deployment
+// of a Next-Gen WAF workspace.
+func (c *Client) UnmapNGWAFEdgeDeploymentService(i *UnmapNGWAFEdgeDeploymentServiceInput) error {
+	if i.Workspace == "" {
+		return ErrMissingWorkspace
+	}
+
+	if i.Service == "" {
+		return ErrMissingService
+	}
+
+	path := fmt.Sprintf("/ngwaf/v1/workspaces/%s/edge-deployment/services/%s", i.Workspace, i.Service)
+	resp, err := c.Delete(path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// The Next-Gen WAF API responds with 204 No Content rather than a status
+	// response.
+	return nil
+}
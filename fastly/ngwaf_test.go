@@ -0,0 +1,392 @@
+package fastly
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const testNGWAFWorkspace = "wBwpveQ9Mv8f4Q3h9nQVnW"
+
+func TestClient_NGWAFRules(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	// Create
+	var r *NGWAFRule
+	record(t, "ngwaf/rules/create", func(c *Client) {
+		r, err = c.CreateNGWAFRule(&CreateNGWAFRuleInput{
+			Workspace:     testNGWAFWorkspace,
+			Type:          NGWAFRuleTypeRequest,
+			Description:   "block admin",
+			Enabled:       true,
+			GroupOperator: "all",
+			Conditions: []*NGWAFCondition{
+				{Type: "single", Field: "path", Operator: "equals", Value: "/admin"},
+			},
+			Actions: []*NGWAFAction{
+				{Type: "block"},
+			},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Type != NGWAFRuleTypeRequest {
+		t.Errorf("bad type: %q", r.Type)
+	}
+	if !r.Enabled {
+		t.Errorf("bad enabled: %t", r.Enabled)
+	}
+	if len(r.Conditions) != 1 || r.Conditions[0].Value != "/admin" {
+		t.Errorf("bad conditions: %v", r.Conditions)
+	}
+
+	// List
+	var rs []*NGWAFRule
+	record(t, "ngwaf/rules/list", func(c *Client) {
+		rs, err = c.ListNGWAFRules(&ListNGWAFRulesInput{
+			Workspace: testNGWAFWorkspace,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) < 1 {
+		t.Errorf("bad rules: %v", rs)
+	}
+
+	// Get
+	var nr *NGWAFRule
+	record(t, "ngwaf/rules/get", func(c *Client) {
+		nr, err = c.GetNGWAFRule(&GetNGWAFRuleInput{
+			Workspace: testNGWAFWorkspace,
+			ID:        r.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if nr.Description != r.Description {
+		t.Errorf("bad description: %q", nr.Description)
+	}
+
+	// Update
+	var ur *NGWAFRule
+	disabled := false
+	record(t, "ngwaf/rules/update", func(c *Client) {
+		ur, err = c.UpdateNGWAFRule(&UpdateNGWAFRuleInput{
+			Workspace: testNGWAFWorkspace,
+			ID:        r.ID,
+			Enabled:   &disabled,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ur.Enabled {
+		t.Errorf("bad enabled: %t", ur.Enabled)
+	}
+
+	// Delete
+	record(t, "ngwaf/rules/delete", func(c *Client) {
+		err = c.DeleteNGWAFRule(&DeleteNGWAFRuleInput{
+			Workspace: testNGWAFWorkspace,
+			ID:        r.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_NGWAFSignals(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	// Create
+	var s *NGWAFSignal
+	record(t, "ngwaf/signals/create", func(c *Client) {
+		s, err = c.CreateNGWAFSignal(&CreateNGWAFSignalInput{
+			Workspace:   testNGWAFWorkspace,
+			Name:        "bad-bot",
+			Description: "known bad bots",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.ReferenceID != "site.bad-bot" {
+		t.Errorf("bad reference_id: %q", s.ReferenceID)
+	}
+
+	// List
+	var ss []*NGWAFSignal
+	record(t, "ngwaf/signals/list", func(c *Client) {
+		ss, err = c.ListNGWAFSignals(&ListNGWAFSignalsInput{
+			Workspace: testNGWAFWorkspace,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ss) < 1 {
+		t.Errorf("bad signals: %v", ss)
+	}
+
+	// Get
+	var ns *NGWAFSignal
+	record(t, "ngwaf/signals/get", func(c *Client) {
+		ns, err = c.GetNGWAFSignal(&GetNGWAFSignalInput{
+			Workspace: testNGWAFWorkspace,
+			ID:        s.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ns.Name != s.Name {
+		t.Errorf("bad name: %q", ns.Name)
+	}
+
+	// Update
+	var us *NGWAFSignal
+	record(t, "ngwaf/signals/update", func(c *Client) {
+		us, err = c.UpdateNGWAFSignal(&UpdateNGWAFSignalInput{
+			Workspace:   testNGWAFWorkspace,
+			ID:          s.ID,
+			Description: "known bad bots and scrapers",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if us.Description != "known bad bots and scrapers" {
+		t.Errorf("bad description: %q", us.Description)
+	}
+
+	// Delete
+	record(t, "ngwaf/signals/delete", func(c *Client) {
+		err = c.DeleteNGWAFSignal(&DeleteNGWAFSignalInput{
+			Workspace: testNGWAFWorkspace,
+			ID:        s.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_NGWAFRedactions(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	// Create
+	var r *NGWAFRedaction
+	record(t, "ngwaf/redactions/create", func(c *Client) {
+		r, err = c.CreateNGWAFRedaction(&CreateNGWAFRedactionInput{
+			Workspace: testNGWAFWorkspace,
+			Field:     "password",
+			Type:      NGWAFRedactionTypeRequestParameter,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Field != "password" {
+		t.Errorf("bad field: %q", r.Field)
+	}
+
+	// List
+	var rs []*NGWAFRedaction
+	record(t, "ngwaf/redactions/list", func(c *Client) {
+		rs, err = c.ListNGWAFRedactions(&ListNGWAFRedactionsInput{
+			Workspace: testNGWAFWorkspace,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rs) < 1 {
+		t.Errorf("bad redactions: %v", rs)
+	}
+
+	// Update
+	var ur *NGWAFRedaction
+	record(t, "ngwaf/redactions/update", func(c *Client) {
+		ur, err = c.UpdateNGWAFRedaction(&UpdateNGWAFRedactionInput{
+			Workspace: testNGWAFWorkspace,
+			ID:        r.ID,
+			Field:     "passwd",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ur.Field != "passwd" {
+		t.Errorf("bad field: %q", ur.Field)
+	}
+
+	// Delete
+	record(t, "ngwaf/redactions/delete", func(c *Client) {
+		err = c.DeleteNGWAFRedaction(&DeleteNGWAFRedactionInput{
+			Workspace: testNGWAFWorkspace,
+			ID:        r.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_NGWAFEdgeDeployment(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	// Create
+	var d *NGWAFEdgeDeployment
+	record(t, "ngwaf/edge_deployment/create", func(c *Client) {
+		d, err = c.CreateNGWAFEdgeDeployment(&CreateNGWAFEdgeDeploymentInput{
+			Workspace: testNGWAFWorkspace,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.WorkspaceID != testNGWAFWorkspace {
+		t.Errorf("bad workspace_id: %q", d.WorkspaceID)
+	}
+
+	// Map
+	percent := 50
+	var s *NGWAFEdgeDeploymentService
+	record(t, "ngwaf/edge_deployment/map", func(c *Client) {
+		s, err = c.MapNGWAFEdgeDeploymentService(&MapNGWAFEdgeDeploymentServiceInput{
+			Workspace:       testNGWAFWorkspace,
+			Service:         testServiceID,
+			PercentEnabled:  &percent,
+			ActivateVersion: true,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.ServiceID != testServiceID || s.PercentEnabled != 50 {
+		t.Errorf("bad service: %q, %d", s.ServiceID, s.PercentEnabled)
+	}
+
+	// Sync
+	var ss *NGWAFEdgeDeploymentService
+	record(t, "ngwaf/edge_deployment/sync", func(c *Client) {
+		ss, err = c.SyncNGWAFEdgeDeploymentService(&SyncNGWAFEdgeDeploymentServiceInput{
+			Workspace: testNGWAFWorkspace,
+			Service:   testServiceID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ss.SyncedVersion != 697 || ss.SyncedAt == nil {
+		t.Errorf("bad sync: %d, %v", ss.SyncedVersion, ss.SyncedAt)
+	}
+
+	// Get
+	var nd *NGWAFEdgeDeployment
+	record(t, "ngwaf/edge_deployment/get", func(c *Client) {
+		nd, err = c.GetNGWAFEdgeDeployment(&GetNGWAFEdgeDeploymentInput{
+			Workspace: testNGWAFWorkspace,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(nd.Services) != 1 || nd.Services[0].ServiceID != testServiceID {
+		t.Errorf("bad services: %v", nd.Services)
+	}
+
+	// Unmap
+	record(t, "ngwaf/edge_deployment/unmap", func(c *Client) {
+		err = c.UnmapNGWAFEdgeDeploymentService(&UnmapNGWAFEdgeDeploymentServiceInput{
+			Workspace: testNGWAFWorkspace,
+			Service:   testServiceID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_ListNGWAF_emptyBodies(t *testing.T) {
+	body := "null"
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(body))
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if rules, err := c.ListNGWAFRules(&ListNGWAFRulesInput{Workspace: "ws"}); err != nil || len(rules) != 0 {
+		t.Errorf("bad rules %v or error %v", rules, err)
+	}
+	if signals, err := c.ListNGWAFSignals(&ListNGWAFSignalsInput{Workspace: "ws"}); err != nil || len(signals) != 0 {
+		t.Errorf("bad signals %v or error %v", signals, err)
+	}
+	if redactions, err := c.ListNGWAFRedactions(&ListNGWAFRedactionsInput{Workspace: "ws"}); err != nil || len(redactions) != 0 {
+		t.Errorf("bad redactions %v or error %v", redactions, err)
+	}
+
+	body = ""
+	if _, err := c.ListNGWAFRules(&ListNGWAFRulesInput{Workspace: "ws"}); err == nil {
+		t.Error("expected an error for an empty body")
+	}
+}
+
+func TestClient_NGWAF_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListNGWAFRules(&ListNGWAFRulesInput{})
+	if err != ErrMissingWorkspace {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetNGWAFRule(&GetNGWAFRuleInput{
+		Workspace: "foo",
+	})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateNGWAFSignal(&CreateNGWAFSignalInput{
+		Workspace: "foo",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateNGWAFRedaction(&CreateNGWAFRedactionInput{
+		Workspace: "foo",
+	})
+	if err != ErrMissingField {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteNGWAFRedaction(&DeleteNGWAFRedactionInput{
+		Workspace: "foo",
+	})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.MapNGWAFEdgeDeploymentService(&MapNGWAFEdgeDeploymentServiceInput{
+		Workspace: "foo",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+}
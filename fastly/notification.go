@@ -0,0 +1,238 @@
+package fastly
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// NotificationIntegrationType is the kind of destination account
+// notifications are delivered to.
+type NotificationIntegrationType string
+
+const (
+	NotificationIntegrationTypeWebhook        NotificationIntegrationType = "webhook"
+	NotificationIntegrationTypeSlack          NotificationIntegrationType = "slack"
+	NotificationIntegrationTypePagerDuty      NotificationIntegrationType = "pagerduty"
+	NotificationIntegrationTypeMicrosoftTeams NotificationIntegrationType = "microsoftteams"
+	NotificationIntegrationTypeMailingList    NotificationIntegrationType = "mailinglist"
+)
+
+// NotificationWebhookConfigKey is the key of the Config of a webhook
+// integration which holds the URL notifications are posted to.
+const NotificationWebhookConfigKey = "webhook"
+
+// NotificationIntegration represents a notification integration response
+// from the Fastly API: a destination, such as a webhook, which account
+// notifications are pushed to as they happen.
+type NotificationIntegration struct {
+	ID          string                      `mapstructure:"id"`
+	Name        string                      `mapstructure:"name"`
+	Description string                      `mapstructure:"description"`
+	Type        NotificationIntegrationType `mapstructure:"type"`
+	Config      map[string]string           `mapstructure:"config"`
+	Status      string                      `mapstructure:"status"`
+	CreatedAt   *time.Time                  `mapstructure:"created_at"`
+	UpdatedAt   *time.Time                  `mapstructure:"updated_at"`
+}
+
+// notificationIntegrationsByName is a sortable list of notification
+// integrations.
+type notificationIntegrationsByName []*NotificationIntegration
+
+// Len, Swap, and Less implement the sortable interface.
+func (s notificationIntegrationsByName) Len() int      { return len(s) }
+func (s notificationIntegrationsByName) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s notificationIntegrationsByName) Less(i, j int) bool {
+	return s[i].Name < s[j].Name
+}
+
+// notificationIntegrationsResponse is the envelope the API wraps a list of
+// integrations in.
+type notificationIntegrationsResponse struct {
+	Data []*NotificationIntegration `mapstructure:"data"`
+}
+
+// ListNotificationIntegrationsInput is used as input to the
+// ListNotificationIntegrations function.
+type ListNotificationIntegrationsInput struct {
+	// Type limits the list to integrations of one type. Optional.
+	Type NotificationIntegrationType
+}
+
+// ListNotificationIntegrations returns the notification integrations of the
+// account.
+func (c *Client) ListNotificationIntegrations(i *ListNotificationIntegrationsInput) ([]*NotificationIntegration, error) {
+	ro := &RequestOptions{Params: map[string]string{}}
+	if i.Type != "" {
+		ro.Params["type"] = string(i.Type)
+	}
+
+	resp, err := c.Get("/notifications/integrations", ro)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *notificationIntegrationsResponse
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	sort.Stable(notificationIntegrationsByName(r.Data))
+	return r.Data, nil
+}
+
+// CreateNotificationIntegrationInput is used as input to the
+// CreateNotificationIntegration function.
+type CreateNotificationIntegrationInput struct {
+	// Name and Type are required. For a webhook, Config must hold the URL
+	// under NotificationWebhookConfigKey.
+	Name        string                      `json:"name"`
+	Description string                      `json:"description,omitempty"`
+	Type        NotificationIntegrationType `json:"type"`
+	Config      map[string]string           `json:"config"`
+}
+
+// CreateNotificationIntegration creates a new notification integration.
+// Webhooks receive a JSON POST for each notification, signed with the key
+// returned by GetNotificationSigningKey.
+func (c *Client) CreateNotificationIntegration(i *CreateNotificationIntegrationInput) (*NotificationIntegration, error) {
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if i.Type == "" {
+		return nil, ErrMissingType
+	}
+
+	if i.Type == NotificationIntegrationTypeWebhook && i.Config[NotificationWebhookConfigKey] == "" {
+		return nil, ErrMissingURL
+	}
+
+	resp, err := c.PostJSON("/notifications/integrations", i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *NotificationIntegration
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetNotificationIntegrationInput is used as input to the
+// GetNotificationIntegration function.
+type GetNotificationIntegrationInput struct {
+	// ID is the ID of the integration (required).
+	ID string
+}
+
+// GetNotificationIntegration gets the notification integration with the given
+// ID.
+func (c *Client) GetNotificationIntegration(i *GetNotificationIntegrationInput) (*NotificationIntegration, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/notifications/integrations/%s", i.ID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *NotificationIntegration
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// UpdateNotificationIntegrationInput is used as input to the
+// UpdateNotificationIntegration function.
+type UpdateNotificationIntegrationInput struct {
+	// ID is the ID of the integration (required).
+	ID string `json:"-"`
+
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Config      map[string]string `json:"config,omitempty"`
+}
+
+// UpdateNotificationIntegration updates a specific notification integration.
+// The API responds without a body, so the integration is fetched again.
+func (c *Client) UpdateNotificationIntegration(i *UpdateNotificationIntegrationInput) (*NotificationIntegration, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/notifications/integrations/%s", i.ID)
+	resp, err := c.PatchJSON(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body.Close()
+
+	return c.GetNotificationIntegration(&GetNotificationIntegrationInput{ID: i.ID})
+}
+
+// DeleteNotificationIntegrationInput is the input parameter to
+// DeleteNotificationIntegration.
+type DeleteNotificationIntegrationInput struct {
+	// ID is the ID of the integration (required).
+	ID string
+}
+
+// DeleteNotificationIntegration deletes the given notification integration.
+func (c *Client) DeleteNotificationIntegration(i *DeleteNotificationIntegrationInput) error {
+	if i.ID == "" {
+		return ErrMissingID
+	}
+
+	path := fmt.Sprintf("/notifications/integrations/%s", i.ID)
+	_, err := c.Delete(path, nil)
+	return err
+}
+
+// NotificationSigningKey is the key webhook notifications are signed with, so
+// the receiver can check they came from Fastly.
+type NotificationSigningKey struct {
+	SigningKey string `mapstructure:"signingKey" sensitive:"true"`
+}
+
+// GetNotificationSigningKeyInput is used as input to the
+// GetNotificationSigningKey and RotateNotificationSigningKey functions.
+type GetNotificationSigningKeyInput struct {
+	// ID is the ID of the webhook integration (required).
+	ID string
+}
+
+// GetNotificationSigningKey returns the signing key of a webhook integration.
+func (c *Client) GetNotificationSigningKey(i *GetNotificationSigningKeyInput) (*NotificationSigningKey, error) {
+	return c.notificationSigningKey("GET", "signingKey", i)
+}
+
+// RotateNotificationSigningKey replaces the signing key of a webhook
+// integration and returns the new key. Notifications are signed with the new
+// key straight away, so the receiver must be updated at the same time.
+func (c *Client) RotateNotificationSigningKey(i *GetNotificationSigningKeyInput) (*NotificationSigningKey, error) {
+	return c.notificationSigningKey("POST", "rotateSigningKey", i)
+}
+
+// notificationSigningKey sends a signing key request for an integration.
+func (c *Client) notificationSigningKey(verb, action string, i *GetNotificationSigningKeyInput) (*NotificationSigningKey, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/notifications/integrations/%s/%s", i.ID, action)
+	resp, err := c.Request(verb, path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var k *NotificationSigningKey
+	if err := decodeJSON(&k, resp.Body); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
@@ -0,0 +1,84 @@
+package fastly
+
+import "testing"
+
+func TestClient_NotificationIntegrations(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var n, un *NotificationIntegration
+	var ns []*NotificationIntegration
+	var key *NotificationSigningKey
+	record(t, "notifications/webhook", func(c *Client) {
+		if n, err = c.CreateNotificationIntegration(&CreateNotificationIntegrationInput{
+			Name:        "incidents",
+			Description: "Incident tooling",
+			Type:        NotificationIntegrationTypeWebhook,
+			Config:      map[string]string{NotificationWebhookConfigKey: "https://hooks.example.com/fastly"},
+		}); err != nil {
+			return
+		}
+		if ns, err = c.ListNotificationIntegrations(&ListNotificationIntegrationsInput{
+			Type: NotificationIntegrationTypeWebhook,
+		}); err != nil {
+			return
+		}
+		if un, err = c.UpdateNotificationIntegration(&UpdateNotificationIntegrationInput{
+			ID:     n.ID,
+			Config: map[string]string{NotificationWebhookConfigKey: "https://hooks.example.com/fastly/v2"},
+		}); err != nil {
+			return
+		}
+		if key, err = c.RotateNotificationSigningKey(&GetNotificationSigningKeyInput{
+			ID: n.ID,
+		}); err != nil {
+			return
+		}
+		err = c.DeleteNotificationIntegration(&DeleteNotificationIntegrationInput{
+			ID: n.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Type != NotificationIntegrationTypeWebhook || n.Status != "active" {
+		t.Errorf("bad integration: %#v", n)
+	}
+	if len(ns) != 1 || ns[0].ID != n.ID {
+		t.Errorf("bad integrations: %v", ns)
+	}
+	if un.Config[NotificationWebhookConfigKey] != "https://hooks.example.com/fastly/v2" {
+		t.Errorf("bad config: %v", un.Config)
+	}
+	if key.SigningKey != "rotated-signing-key" {
+		t.Errorf("bad signing key: %q", key.SigningKey)
+	}
+}
+
+func TestClient_NotificationIntegrations_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateNotificationIntegration(&CreateNotificationIntegrationInput{
+		Name: "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateNotificationIntegration(&CreateNotificationIntegrationInput{
+		Name: "incidents",
+		Type: NotificationIntegrationTypeWebhook,
+	})
+	if err != ErrMissingURL {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetNotificationSigningKey(&GetNotificationSigningKeyInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteNotificationIntegration(&DeleteNotificationIntegrationInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -0,0 +1,231 @@
+package fastly
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OriginInspectorResponse is a page of Origin Inspector historical metrics.
+// Each series holds the values for one combination of the grouped
+// dimensions.
+type OriginInspectorResponse struct {
+	Data []*OriginInspectorSeries `mapstructure:"data"`
+	Meta *OriginInspectorMeta     `mapstructure:"meta"`
+}
+
+// OriginInspectorMeta describes the query that produced a response.
+// NextCursor is set when there are more results to fetch.
+type OriginInspectorMeta struct {
+	ServiceID  string `mapstructure:"service_id"`
+	Start      string `mapstructure:"start"`
+	End        string `mapstructure:"end"`
+	Downsample string `mapstructure:"downsample"`
+	Metric     string `mapstructure:"metric"`
+	GroupBy    string `mapstructure:"group_by"`
+	Limit      int    `mapstructure:"limit"`
+	NextCursor string `mapstructure:"next_cursor"`
+}
+
+// OriginInspectorSeries is the time series for one group of results.
+// Dimensions are the grouped values, such as {"host": "origin.example.com"},
+// and are empty when results are not grouped.
+type OriginInspectorSeries struct {
+	Dimensions map[string]string        `mapstructure:"dimensions"`
+	Values     []*OriginInspectorValues `mapstructure:"values"`
+}
+
+// Host returns the origin host of the series, when grouped by host.
+func (s *OriginInspectorSeries) Host() string {
+	return s.Dimensions["host"]
+}
+
+// OriginInspectorValues are the origin metrics for a single sample period.
+// Only the metrics requested are set.
+type OriginInspectorValues struct {
+	Timestamp           uint64 `mapstructure:"timestamp"`         // Start of the sample period, as a Unix timestamp.
+	Responses           uint64 `mapstructure:"responses"`         // Number of responses from origin.
+	ResponseHeaderBytes uint64 `mapstructure:"resp_header_bytes"` // Total header bytes received from origin.
+	ResponseBodyBytes   uint64 `mapstructure:"resp_body_bytes"`   // Total body bytes received from origin.
+	Status1xx           uint64 `mapstructure:"status_1xx"`        // Number of "Informational" responses from origin.
+	Status2xx           uint64 `mapstructure:"status_2xx"`        // Number of "Success" responses from origin.
+	Status3xx           uint64 `mapstructure:"status_3xx"`        // Number of "Redirection" responses from origin.
+	Status4xx           uint64 `mapstructure:"status_4xx"`        // Number of "Client Error" responses from origin.
+	Status5xx           uint64 `mapstructure:"status_5xx"`        // Number of "Server Error" responses from origin.
+
+	Latency0To1ms         uint64 `mapstructure:"latency_0_to_1ms"`         // Number of responses with latency under 1ms.
+	Latency1To5ms         uint64 `mapstructure:"latency_1_to_5ms"`         // Number of responses with latency from 1ms to 5ms.
+	Latency5To10ms        uint64 `mapstructure:"latency_5_to_10ms"`        // Number of responses with latency from 5ms to 10ms.
+	Latency10To50ms       uint64 `mapstructure:"latency_10_to_50ms"`       // Number of responses with latency from 10ms to 50ms.
+	Latency50To100ms      uint64 `mapstructure:"latency_50_to_100ms"`      // Number of responses with latency from 50ms to 100ms.
+	Latency100To250ms     uint64 `mapstructure:"latency_100_to_250ms"`     // Number of responses with latency from 100ms to 250ms.
+	Latency250To500ms     uint64 `mapstructure:"latency_250_to_500ms"`     // Number of responses with latency from 250ms to 500ms.
+	Latency500To1000ms    uint64 `mapstructure:"latency_500_to_1000ms"`    // Number of responses with latency from 500ms to 1s.
+	Latency1000To5000ms   uint64 `mapstructure:"latency_1000_to_5000ms"`   // Number of responses with latency from 1s to 5s.
+	Latency5000To10000ms  uint64 `mapstructure:"latency_5000_to_10000ms"`  // Number of responses with latency from 5s to 10s.
+	Latency10000To60000ms uint64 `mapstructure:"latency_10000_to_60000ms"` // Number of responses with latency from 10s to 60s.
+	Latency60000ms        uint64 `mapstructure:"latency_60000ms"`          // Number of responses with latency of 60s or more.
+}
+
+// LatencyBucket is the number of origin responses whose latency was at least
+// Min and less than Max. Max is zero for the last, unbounded bucket.
+type LatencyBucket struct {
+	Min   time.Duration
+	Max   time.Duration
+	Count uint64
+}
+
+// LatencyDistribution is a list of latency buckets, from fastest to slowest.
+type LatencyDistribution []*LatencyBucket
+
+// Latency returns the latency distribution of the sample period.
+func (v *OriginInspectorValues) Latency() LatencyDistribution {
+	ms := time.Millisecond
+	return LatencyDistribution{
+		{0, 1 * ms, v.Latency0To1ms},
+		{1 * ms, 5 * ms, v.Latency1To5ms},
+		{5 * ms, 10 * ms, v.Latency5To10ms},
+		{10 * ms, 50 * ms, v.Latency10To50ms},
+		{50 * ms, 100 * ms, v.Latency50To100ms},
+		{100 * ms, 250 * ms, v.Latency100To250ms},
+		{250 * ms, 500 * ms, v.Latency250To500ms},
+		{500 * ms, 1000 * ms, v.Latency500To1000ms},
+		{1000 * ms, 5000 * ms, v.Latency1000To5000ms},
+		{5000 * ms, 10000 * ms, v.Latency5000To10000ms},
+		{10000 * ms, 60000 * ms, v.Latency10000To60000ms},
+		{60000 * ms, 0, v.Latency60000ms},
+	}
+}
+
+// Latency returns the latency distribution of the whole series, summed over
+// every sample period.
+func (s *OriginInspectorSeries) Latency() LatencyDistribution {
+	var d LatencyDistribution
+	for _, v := range s.Values {
+		d = d.Add(v.Latency())
+	}
+	return d
+}
+
+// Add returns the sum of two distributions with the same buckets. Either may
+// be nil.
+func (d LatencyDistribution) Add(o LatencyDistribution) LatencyDistribution {
+	if d == nil {
+		d, o = o, d
+	}
+	sum := make(LatencyDistribution, len(d))
+	for n, b := range d {
+		sum[n] = &LatencyBucket{Min: b.Min, Max: b.Max, Count: b.Count}
+		if n < len(o) {
+			sum[n].Count += o[n].Count
+		}
+	}
+	return sum
+}
+
+// Total returns the number of responses in the distribution.
+func (d LatencyDistribution) Total() uint64 {
+	var total uint64
+	for _, b := range d {
+		total += b.Count
+	}
+	return total
+}
+
+// FractionWithin returns the fraction of responses, between 0 and 1, that
+// were faster than the given latency. Because only bucket counts are known,
+// the latency is rounded down to a bucket boundary, so the result never
+// overstates how many responses met it. It returns 0 for an empty
+// distribution.
+func (d LatencyDistribution) FractionWithin(latency time.Duration) float64 {
+	total := d.Total()
+	if total == 0 {
+		return 0
+	}
+	var within uint64
+	for _, b := range d {
+		if b.Max == 0 || b.Max > latency {
+			break
+		}
+		within += b.Count
+	}
+	return float64(within) / float64(total)
+}
+
+// GetOriginInspectorInput is used as input to the GetOriginInspector function.
+type GetOriginInspectorInput struct {
+	// Service is the ID of the service (required).
+	Service string
+
+	// Start and End are the time range, as Unix timestamps or RFC 3339 times.
+	// Start is required and End defaults to now.
+	Start string
+	End   string
+
+	// Downsample is the sample period, one of "minute", "hour" or "day".
+	Downsample string
+
+	// Metrics are the metrics to return, such as "responses" or
+	// "latency_0_to_1ms". The API default is every metric.
+	Metrics []string
+
+	// GroupBy are the dimensions to group results by, such as "host",
+	// "region" or "datacenter". Results are aggregated across every origin
+	// when not grouped.
+	GroupBy []string
+
+	// Hosts, Datacenters and Region limit results to the given origin hosts,
+	// POPs and region. Optional.
+	Hosts       []string
+	Datacenters []string
+	Region      string
+
+	// Cursor and Limit select a page of results. Cursor is the NextCursor of
+	// the previous page.
+	Cursor string
+	Limit  int
+}
+
+// GetOriginInspector returns historical Origin Inspector metrics for a
+// service.
+func (c *Client) GetOriginInspector(i *GetOriginInspectorInput) (*OriginInspectorResponse, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Start == "" {
+		return nil, ErrMissingStart
+	}
+
+	params := map[string]string{"start": i.Start}
+	for k, v := range map[string]string{
+		"end":        i.End,
+		"downsample": i.Downsample,
+		"metric":     strings.Join(i.Metrics, ","),
+		"group_by":   strings.Join(i.GroupBy, ","),
+		"host":       strings.Join(i.Hosts, ","),
+		"datacenter": strings.Join(i.Datacenters, ","),
+		"region":     i.Region,
+		"cursor":     i.Cursor,
+	} {
+		if v != "" {
+			params[k] = v
+		}
+	}
+	if i.Limit != 0 {
+		params["limit"] = strconv.Itoa(i.Limit)
+	}
+
+	path := fmt.Sprintf("/metrics/origins/services/%s", i.Service)
+	resp, err := c.Get(path, &RequestOptions{Params: params})
+	if err != nil {
+		return nil, err
+	}
+
+	var r *OriginInspectorResponse
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
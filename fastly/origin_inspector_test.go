@@ -0,0 +1,69 @@
+package fastly
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClient_GetOriginInspector(t *testing.T) {
+	t.Parallel()
+
+	var r *OriginInspectorResponse
+	var err error
+	record(t, "origin_inspector/get_by_host", func(c *Client) {
+		r, err = c.GetOriginInspector(&GetOriginInspectorInput{
+			Service:    "7i6HN3TK9wS159v2gPAZ8A",
+			Start:      "1760400000",
+			End:        "1760407200",
+			Downsample: "hour",
+			GroupBy:    []string{"host"},
+			Hosts:      []string{"origin-a.example.com", "origin-b.example.com"},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Data) != 2 {
+		t.Fatalf("bad data: %v", r.Data)
+	}
+
+	a := r.Data[0]
+	if a.Host() != "origin-a.example.com" {
+		t.Errorf("bad host: %q", a.Host())
+	}
+	if len(a.Values) != 2 || a.Values[0].Responses != 800 {
+		t.Errorf("bad values: %v", a.Values)
+	}
+
+	latency := a.Latency()
+	if latency.Total() != 1600 {
+		t.Errorf("bad total: %d", latency.Total())
+	}
+	if f := latency.FractionWithin(50 * time.Millisecond); f != 1460.0/1600 {
+		t.Errorf("bad fraction within 50ms: %v", f)
+	}
+	if f := latency.FractionWithin(75 * time.Millisecond); f != 1460.0/1600 {
+		t.Errorf("bad fraction within 75ms: %v", f)
+	}
+	if f := latency.FractionWithin(time.Minute); f != 1 {
+		t.Errorf("bad fraction within 1m: %v", f)
+	}
+	if r.Meta.GroupBy != "host" {
+		t.Errorf("bad meta: %v", r.Meta)
+	}
+}
+
+func TestClient_GetOriginInspector_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetOriginInspector(&GetOriginInspectorInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetOriginInspector(&GetOriginInspectorInput{
+		Service: "foo",
+	})
+	if err != ErrMissingStart {
+		t.Errorf("bad error: %s", err)
+	}
+}
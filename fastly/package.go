@@ -0,0 +1,317 @@
+package fastly
+
+import (
+	"archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha512"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Package is the Compute package uploaded to a service version.
+type Package struct {
+	ID        string           `mapstructure:"id"`
+	ServiceID string           `mapstructure:"service_id"`
+	Version   int              `mapstructure:"version"`
+	Metadata  *PackageMetadata `mapstructure:"metadata"`
+	CreatedAt *time.Time       `mapstructure:"created_at"`
+	UpdatedAt *time.Time       `mapstructure:"updated_at"`
+	DeletedAt *time.Time       `mapstructure:"deleted_at"`
+}
+
+// PackageMetadata describes a Compute package. Name, Description, Authors and
+// Language come from the package's fastly.toml manifest. HashSum is the
+// SHA-512 of the archive and FilesHash is the SHA-512 of the contents of the
+// files in it, which unlike HashSum does not change when an unchanged package
+// is rebuilt.
+type PackageMetadata struct {
+	Name        string   `mapstructure:"name"`
+	Description string   `mapstructure:"description"`
+	Authors     []string `mapstructure:"authors"`
+	Language    string   `mapstructure:"language"`
+	Size        int64    `mapstructure:"size"`
+	HashSum     string   `mapstructure:"hashsum"`
+	FilesHash   string   `mapstructure:"files_hash"`
+}
+
+// GetPackageInput is used as input to the GetPackage function.
+type GetPackageInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+}
+
+// GetPackage returns the Compute package of a service version.
+func (c *Client) GetPackage(i *GetPackageInput) (*Package, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/package", i.Service, i.Version)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var p *Package
+	if err := decodeJSON(&p, resp.Body); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// GetPackageMetadata reads the metadata of a local Compute package archive,
+// a gzipped tar file with the fastly.toml manifest in its top-level
+// directory, without uploading it.
+func GetPackageMetadata(file string) (*PackageMetadata, error) {
+	b, err := ioutil.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	zr, err := gzip.NewReader(bytes.NewReader(b))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+
+	files := make(map[string][]byte)
+	var manifest []byte
+	tr := tar.NewReader(zr)
+	for {
+		h, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !h.FileInfo().Mode().IsRegular() {
+			continue
+		}
+
+		content, err := ioutil.ReadAll(tr)
+		if err != nil {
+			return nil, err
+		}
+		files[h.Name] = content
+
+		name := strings.TrimPrefix(path.Clean(h.Name), "./")
+		if strings.Count(name, "/") == 1 && path.Base(name) == "fastly.toml" {
+			manifest = content
+		}
+	}
+	if manifest == nil {
+		return nil, fmt.Errorf("%s: package has no fastly.toml manifest", file)
+	}
+
+	m, err := parsePackageManifest(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("%s: fastly.toml: %s", file, err)
+	}
+
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	h := sha512.New()
+	for _, name := range names {
+		h.Write(files[name])
+	}
+
+	m.Size = int64(len(b))
+	m.HashSum = fmt.Sprintf("%x", sha512.Sum512(b))
+	m.FilesHash = fmt.Sprintf("%x", h.Sum(nil))
+	return m, nil
+}
+
+// parsePackageManifest reads the top-level name, description, authors and
+// language keys of a fastly.toml manifest. Only the value forms used by these
+// keys are supported: strings and single-line arrays of strings. Tables, and
+// the keys in them, are skipped.
+func parsePackageManifest(b []byte) (*PackageMetadata, error) {
+	m := &PackageMetadata{}
+	s := bufio.NewScanner(bytes.NewReader(b))
+	for line := 1; s.Scan(); line++ {
+		text := strings.TrimSpace(s.Text())
+		if text == "" || strings.HasPrefix(text, "#") {
+			continue
+		}
+		if strings.HasPrefix(text, "[") {
+			break
+		}
+
+		n := strings.Index(text, "=")
+		if n < 0 {
+			return nil, fmt.Errorf("line %d: expected key = value", line)
+		}
+		key := strings.TrimSpace(text[:n])
+		value := strings.TrimSpace(text[n+1:])
+
+		var err error
+		switch key {
+		case "name":
+			m.Name, err = parseTOMLString(value)
+		case "description":
+			m.Description, err = parseTOMLString(value)
+		case "language":
+			m.Language, err = parseTOMLString(value)
+		case "authors":
+			m.Authors, err = parseTOMLStrings(value)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s: %s", line, key, err)
+		}
+	}
+	return m, s.Err()
+}
+
+// parseTOMLString parses a basic ("...") or literal ('...') TOML string,
+// ignoring any trailing comment.
+func parseTOMLString(v string) (string, error) {
+	s, rest, err := cutTOMLString(v)
+	if err != nil {
+		return "", err
+	}
+	if rest = strings.TrimSpace(rest); rest != "" && !strings.HasPrefix(rest, "#") {
+		return "", fmt.Errorf("unexpected %q after string", rest)
+	}
+	return s, nil
+}
+
+// parseTOMLStrings parses a single-line TOML array of strings.
+func parseTOMLStrings(v string) ([]string, error) {
+	if !strings.HasPrefix(v, "[") {
+		return nil, fmt.Errorf("expected an array")
+	}
+	v = strings.TrimSpace(v[1:])
+
+	list := []string{}
+	for !strings.HasPrefix(v, "]") {
+		s, rest, err := cutTOMLString(v)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, s)
+
+		v = strings.TrimSpace(rest)
+		if strings.HasPrefix(v, ",") {
+			v = strings.TrimSpace(v[1:])
+		} else if !strings.HasPrefix(v, "]") {
+			return nil, fmt.Errorf("expected , or ] in array")
+		}
+	}
+	return list, nil
+}
+
+// cutTOMLString parses the string at the start of v and returns it along with
+// the rest of v.
+func cutTOMLString(v string) (string, string, error) {
+	if strings.HasPrefix(v, "'") {
+		n := strings.Index(v[1:], "'")
+		if n < 0 {
+			return "", "", fmt.Errorf("unterminated string")
+		}
+		return v[1 : n+1], v[n+2:], nil
+	}
+
+	if !strings.HasPrefix(v, `"`) {
+		return "", "", fmt.Errorf("expected a string")
+	}
+	for n := 1; n < len(v); n++ {
+		switch v[n] {
+		case '\\':
+			n++
+		case '"':
+			s, err := strconv.Unquote(v[:n+1])
+			return s, v[n+1:], err
+		}
+	}
+	return "", "", fmt.Errorf("unterminated string")
+}
+
+// PackageComparison is the result of ComparePackage.
+type PackageComparison struct {
+	// Local is the metadata of the local package archive.
+	Local *PackageMetadata
+
+	// Deployed is the metadata of the package of the service version, or nil
+	// if the version has no package.
+	Deployed *PackageMetadata
+
+	// Changed is true when the local package differs from the deployed one,
+	// or there is none, and so needs to be uploaded.
+	Changed bool
+}
+
+// ComparePackageInput is used as input to the ComparePackage function.
+type ComparePackageInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// File is the path of the local package archive (required).
+	File string
+}
+
+// ComparePackage compares a local package archive with the package of a
+// service version, so deploy tools can skip the upload when nothing changed.
+// Packages are compared by FilesHash, or by HashSum if the deployed package
+// has no files hash.
+func (c *Client) ComparePackage(i *ComparePackageInput) (*PackageComparison, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.File == "" {
+		return nil, ErrMissingFile
+	}
+
+	local, err := GetPackageMetadata(i.File)
+	if err != nil {
+		return nil, err
+	}
+
+	cmp := &PackageComparison{Local: local, Changed: true}
+	p, err := c.GetPackage(&GetPackageInput{
+		Service: i.Service,
+		Version: i.Version,
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return cmp, nil
+		}
+		return nil, err
+	}
+	if p.Metadata == nil {
+		return cmp, nil
+	}
+
+	cmp.Deployed = p.Metadata
+	if p.Metadata.FilesHash != "" {
+		cmp.Changed = p.Metadata.FilesHash != local.FilesHash
+	} else {
+		cmp.Changed = p.Metadata.HashSum != local.HashSum
+	}
+	return cmp, nil
+}
@@ -0,0 +1,128 @@
+package fastly
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+const testPackageManifest = `# This file describes a Fastly Compute package.
+manifest_version = 3
+name = "edge-app"
+description = "Edge \"app\""
+authors = ["dev@example.com", 'ops@example.com']
+language = "rust"
+
+[scripts]
+build = "cargo build --release"
+`
+
+// writeTestPackage writes a package archive to a temporary directory and
+// returns its path.
+func writeTestPackage(t *testing.T) string {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(zw)
+	for _, f := range []struct {
+		name    string
+		content string
+	}{
+		{"edge-app/fastly.toml", testPackageManifest},
+		{"edge-app/bin/main.wasm", "\x00asm\x01\x00\x00\x00"},
+	} {
+		if err := tw.WriteHeader(&tar.Header{Name: f.name, Mode: 0644, Size: int64(len(f.content))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(f.content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := zw.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	dir, err := ioutil.TempDir("", "go-fastly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	file := filepath.Join(dir, "edge-app.tar.gz")
+	if err := ioutil.WriteFile(file, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return file
+}
+
+func TestGetPackageMetadata(t *testing.T) {
+	file := writeTestPackage(t)
+	defer os.RemoveAll(filepath.Dir(file))
+
+	m, err := GetPackageMetadata(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if m.Name != "edge-app" || m.Description != `Edge "app"` || m.Language != "rust" {
+		t.Errorf("bad metadata: %+v", m)
+	}
+	if !reflect.DeepEqual(m.Authors, []string{"dev@example.com", "ops@example.com"}) {
+		t.Errorf("bad authors: %v", m.Authors)
+	}
+	if m.Size == 0 || len(m.HashSum) != 128 || len(m.FilesHash) != 128 {
+		t.Errorf("bad hashes: %+v", m)
+	}
+}
+
+func TestClient_ComparePackage(t *testing.T) {
+	t.Parallel()
+
+	file := writeTestPackage(t)
+	defer os.RemoveAll(filepath.Dir(file))
+
+	var same, changed, missing *PackageComparison
+	var err error
+	record(t, "package/compare", func(c *Client) {
+		for _, v := range []struct {
+			version int
+			cmp     **PackageComparison
+		}{{3, &same}, {4, &changed}, {5, &missing}} {
+			*v.cmp, err = c.ComparePackage(&ComparePackageInput{
+				Service: "7i6HN3TK9wS159v2gPAZ8A",
+				Version: v.version,
+				File:    file,
+			})
+			if err != nil {
+				return
+			}
+		}
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if same.Changed || same.Deployed.Name != "edge-app" {
+		t.Errorf("bad comparison: %+v", same)
+	}
+	if !changed.Changed {
+		t.Errorf("bad comparison: %+v", changed)
+	}
+	if !missing.Changed || missing.Deployed != nil {
+		t.Errorf("bad comparison: %+v", missing)
+	}
+}
+
+func TestClient_ComparePackage_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ComparePackage(&ComparePackageInput{
+		Service: "foo",
+		Version: 1,
+	})
+	if err != ErrMissingFile {
+		t.Errorf("bad error: %s", err)
+	}
+}
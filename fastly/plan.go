@@ -0,0 +1,445 @@
+package fastly
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+)
+
+// PlanAction is the kind of operation a PlanChange performs.
+type PlanAction string
+
+const (
+	// PlanActionCreate creates an object which does not exist yet.
+	PlanActionCreate PlanAction = "create"
+
+	// PlanActionUpdate changes some of the fields of an existing object.
+	PlanActionUpdate PlanAction = "update"
+
+	// PlanActionDelete deletes an object which is not declared.
+	PlanActionDelete PlanAction = "delete"
+)
+
+// Plan is the list of operations which bring a service version to a declared
+// configuration, as computed by PlanVersion and performed by ApplyPlan. It
+// serializes to JSON, so that a CD pipeline can show or approve it before
+// anything changes; sensitive values, such as backend client keys, are
+// replaced by RedactedValue in the JSON.
+type Plan struct {
+	Service string        `json:"service"`
+	Version int           `json:"version"`
+	Changes []*PlanChange `json:"changes"`
+}
+
+// Empty reports whether the version already matches the declared
+// configuration.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// PlanChange is a single operation of a Plan.
+type PlanChange struct {
+	Action PlanAction `json:"action"`
+
+	// Kind is the kind of object changed: "condition", "backend" or "domain".
+	Kind string `json:"kind"`
+
+	// Name is the name of the object changed.
+	Name string `json:"name"`
+
+	// Fields are the fields sent, keyed like Flatten: every declared field
+	// of a created object, and the changed fields of an updated one.
+	Fields map[string]interface{} `json:"fields,omitempty"`
+
+	// Before are the current values of the changed fields of an updated
+	// object, or nil where the field is unset.
+	Before map[string]interface{} `json:"before,omitempty"`
+
+	// Sensitive are the keys of Fields and Before which are redacted in the
+	// JSON.
+	Sensitive []string `json:"sensitive,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface, redacting the
+// sensitive fields.
+func (c *PlanChange) MarshalJSON() ([]byte, error) {
+	type planChange PlanChange
+	cp := planChange(*c)
+	cp.Fields = redactPlanFields(c.Fields, c.Sensitive)
+	cp.Before = redactPlanFields(c.Before, c.Sensitive)
+	return json.Marshal(&cp)
+}
+
+// redactPlanFields returns a copy of m with the set values of the sensitive
+// keys replaced by RedactedValue.
+func redactPlanFields(m map[string]interface{}, sensitive []string) map[string]interface{} {
+	if m == nil {
+		return nil
+	}
+	cp := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if v != nil && containsString(sensitive, k) {
+			v = RedactedValue
+		}
+		cp[k] = v
+	}
+	return cp
+}
+
+// PlanVersionInput is used as input to the PlanVersion function. Each list
+// declares every object of its kind: a nil list leaves that kind alone.
+type PlanVersionInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Service and Version are ignored on the objects, which are matched to
+	// the existing ones by name.
+	Conditions []*CreateConditionInput
+	Backends   []*CreateBackendInput
+	Domains    []*CreateDomainInput
+
+	// Prune deletes the existing objects of the declared kinds whose names
+	// are not declared.
+	Prune bool
+}
+
+// PlanVersion compares the declared objects with those of a version and
+// returns the Plan of the creates, updates and, with Prune, deletes which
+// would make them match, without changing anything. Only the fields set on a
+// declared object are compared, so fields left to the API's defaults are not
+// changed. Creates and updates come in the order of the kinds, so conditions
+// exist before the backends which use them, and deletes come last, in the
+// reverse order.
+func (c *Client) PlanVersion(i *PlanVersionInput) (*Plan, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	p := &Plan{Service: i.Service, Version: i.Version, Changes: []*PlanChange{}}
+	var deletes []*PlanChange
+	for _, k := range planKinds {
+		declared := reflect.ValueOf(k.declared(i))
+		if declared.IsNil() {
+			continue
+		}
+
+		list, err := k.list(c, i.Service, i.Version)
+		if err != nil {
+			return nil, err
+		}
+		current := make(map[string]map[string]interface{})
+		for _, obj := range planObjects(list) {
+			fields, err := Flatten(obj)
+			if err != nil {
+				return nil, err
+			}
+			name, _ := fields["name"].(string)
+			current[name] = fields
+		}
+
+		seen := make(map[string]bool)
+		for _, obj := range planObjects(declared.Interface()) {
+			fields, sensitive := planFields(obj)
+			name, _ := fields["name"].(string)
+			if name == "" {
+				return nil, ErrMissingName
+			}
+			if seen[name] {
+				return nil, fmt.Errorf("%s %q is declared more than once", k.kind, name)
+			}
+			seen[name] = true
+
+			cur, ok := current[name]
+			if !ok {
+				p.Changes = append(p.Changes, &PlanChange{
+					Action:    PlanActionCreate,
+					Kind:      k.kind,
+					Name:      name,
+					Fields:    fields,
+					Sensitive: sensitive,
+				})
+				continue
+			}
+
+			change := &PlanChange{Action: PlanActionUpdate, Kind: k.kind, Name: name}
+			for key, v := range fields {
+				if reflect.DeepEqual(v, cur[key]) {
+					continue
+				}
+				if change.Fields == nil {
+					change.Fields = make(map[string]interface{})
+					change.Before = make(map[string]interface{})
+				}
+				change.Fields[key] = v
+				change.Before[key] = cur[key]
+				if containsString(sensitive, key) {
+					change.Sensitive = append(change.Sensitive, key)
+				}
+			}
+			if change.Fields != nil {
+				sort.Strings(change.Sensitive)
+				p.Changes = append(p.Changes, change)
+			}
+		}
+
+		if i.Prune {
+			var names []string
+			for name := range current {
+				if !seen[name] {
+					names = append(names, name)
+				}
+			}
+			sort.Strings(names)
+
+			var kindDeletes []*PlanChange
+			for _, name := range names {
+				kindDeletes = append(kindDeletes, &PlanChange{Action: PlanActionDelete, Kind: k.kind, Name: name})
+			}
+			deletes = append(kindDeletes, deletes...)
+		}
+	}
+	p.Changes = append(p.Changes, deletes...)
+	return p, nil
+}
+
+// ApplyPlanInput is used as input to the ApplyPlan function.
+type ApplyPlanInput struct {
+	// Plan is the plan to perform, as returned by PlanVersion or decoded from
+	// its JSON (required).
+	Plan *Plan
+}
+
+// ApplyPlan performs the changes of a plan in order, and returns the number
+// performed. It stops at the first error, leaving the changes after it
+// undone. Plans decoded from JSON have their sensitive fields redacted, so a
+// plan which would send a redacted value is refused before anything is
+// changed.
+func (c *Client) ApplyPlan(i *ApplyPlanInput) (int, error) {
+	if i.Plan == nil {
+		return 0, ErrMissingPlan
+	}
+
+	p := i.Plan
+	if p.Service == "" {
+		return 0, ErrMissingService
+	}
+
+	if p.Version == 0 {
+		return 0, ErrMissingVersion
+	}
+
+	// Refuse a plan which cannot be performed before changing anything.
+	for _, change := range p.Changes {
+		if planKindByName(change.Kind) == nil {
+			return 0, fmt.Errorf("unknown plan kind %q", change.Kind)
+		}
+		switch change.Action {
+		case PlanActionCreate, PlanActionUpdate, PlanActionDelete:
+		default:
+			return 0, fmt.Errorf("unknown plan action %q", change.Action)
+		}
+		for _, key := range change.Sensitive {
+			if change.Fields[key] == RedactedValue {
+				return 0, fmt.Errorf("%s %q: field %q is redacted", change.Kind, change.Name, key)
+			}
+		}
+	}
+
+	for n, change := range p.Changes {
+		k := planKindByName(change.Kind)
+
+		var err error
+		switch change.Action {
+		case PlanActionCreate:
+			err = k.create(c, p.Service, p.Version, change.Fields)
+		case PlanActionUpdate:
+			err = k.update(c, p.Service, p.Version, change.Name, change.Fields)
+		case PlanActionDelete:
+			err = k.delete(c, p.Service, p.Version, change.Name)
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+	return len(p.Changes), nil
+}
+
+// planKind is a kind of object a Plan can change.
+type planKind struct {
+	kind string
+
+	// declared returns the declared objects of the kind, a nil slice if
+	// none are.
+	declared func(i *PlanVersionInput) interface{}
+
+	// list returns the existing objects of the kind, as a slice.
+	list func(c *Client, service string, version int) (interface{}, error)
+
+	// create, update and delete perform a change from its flattened fields.
+	create func(c *Client, service string, version int, fields map[string]interface{}) error
+	update func(c *Client, service string, version int, name string, fields map[string]interface{}) error
+	delete func(c *Client, service string, version int, name string) error
+}
+
+// planKinds are the kinds a Plan can change, in the order they are created.
+var planKinds = []*planKind{
+	{
+		kind:     "condition",
+		declared: func(i *PlanVersionInput) interface{} { return i.Conditions },
+		list: func(c *Client, service string, version int) (interface{}, error) {
+			return c.ListConditions(&ListConditionsInput{Service: service, Version: version})
+		},
+		create: func(c *Client, service string, version int, fields map[string]interface{}) error {
+			var i CreateConditionInput
+			if err := Expand(fields, &i); err != nil {
+				return err
+			}
+			i.Service, i.Version = service, version
+			_, err := c.CreateCondition(&i)
+			return err
+		},
+		update: func(c *Client, service string, version int, name string, fields map[string]interface{}) error {
+			var i UpdateConditionInput
+			if err := Expand(fields, &i); err != nil {
+				return err
+			}
+			i.Service, i.Version, i.Name = service, version, name
+			_, err := c.UpdateCondition(&i)
+			return err
+		},
+		delete: func(c *Client, service string, version int, name string) error {
+			return c.DeleteCondition(&DeleteConditionInput{Service: service, Version: version, Name: name})
+		},
+	},
+	{
+		kind:     "backend",
+		declared: func(i *PlanVersionInput) interface{} { return i.Backends },
+		list: func(c *Client, service string, version int) (interface{}, error) {
+			return c.ListBackends(&ListBackendsInput{Service: service, Version: version})
+		},
+		create: func(c *Client, service string, version int, fields map[string]interface{}) error {
+			var i CreateBackendInput
+			if err := Expand(fields, &i); err != nil {
+				return err
+			}
+			i.Service, i.Version = service, version
+			_, err := c.CreateBackend(&i)
+			return err
+		},
+		update: func(c *Client, service string, version int, name string, fields map[string]interface{}) error {
+			var i UpdateBackendInput
+			if err := Expand(fields, &i); err != nil {
+				return err
+			}
+			i.Service, i.Version, i.Name, i.NewName = service, version, name, ""
+			_, err := c.UpdateBackend(&i)
+			return err
+		},
+		delete: func(c *Client, service string, version int, name string) error {
+			return c.DeleteBackend(&DeleteBackendInput{Service: service, Version: version, Name: name})
+		},
+	},
+	{
+		kind:     "domain",
+		declared: func(i *PlanVersionInput) interface{} { return i.Domains },
+		list: func(c *Client, service string, version int) (interface{}, error) {
+			return c.ListDomains(&ListDomainsInput{Service: service, Version: version})
+		},
+		create: func(c *Client, service string, version int, fields map[string]interface{}) error {
+			var i CreateDomainInput
+			if err := Expand(fields, &i); err != nil {
+				return err
+			}
+			i.Service, i.Version = service, version
+			_, err := c.CreateDomain(&i)
+			return err
+		},
+		update: func(c *Client, service string, version int, name string, fields map[string]interface{}) error {
+			var i UpdateDomainInput
+			if err := Expand(fields, &i); err != nil {
+				return err
+			}
+			i.Service, i.Version, i.Name, i.NewName = service, version, name, ""
+			_, err := c.UpdateDomain(&i)
+			return err
+		},
+		delete: func(c *Client, service string, version int, name string) error {
+			return c.DeleteDomain(&DeleteDomainInput{Service: service, Version: version, Name: name})
+		},
+	},
+}
+
+// planKindByName returns the planKind named kind, or nil if there is none.
+func planKindByName(kind string) *planKind {
+	for _, k := range planKinds {
+		if k.kind == kind {
+			return k
+		}
+	}
+	return nil
+}
+
+// planObjects returns the non-nil elements of the slice l.
+func planObjects(l interface{}) []interface{} {
+	v := reflect.ValueOf(l)
+	objs := make([]interface{}, 0, v.Len())
+	for n := 0; n < v.Len(); n++ {
+		if e := v.Index(n); !e.IsNil() {
+			objs = append(objs, e.Interface())
+		}
+	}
+	return objs
+}
+
+// planFields returns the flattened form-encoded fields which are set on the
+// input struct pointed to by i, as the API would receive them, and the keys
+// of those which are sensitive. Like omitempty, zero values are unset unless
+// they are behind a pointer, such as a *Compatibool.
+func planFields(i interface{}) (map[string]interface{}, []string) {
+	v := reflect.ValueOf(i).Elem()
+	t := v.Type()
+
+	fields := make(map[string]interface{})
+	var sensitive []string
+	for n := 0; n < t.NumField(); n++ {
+		f := t.Field(n)
+		if f.PkgPath != "" || f.Tag.Get("form") == "" {
+			continue
+		}
+		key, ok := flattenKey(f)
+		if !ok {
+			continue
+		}
+
+		fv := v.Field(n)
+		switch fv.Kind() {
+		case reflect.Ptr:
+			if fv.IsNil() {
+				continue
+			}
+		case reflect.Slice, reflect.Map:
+			if fv.Len() == 0 {
+				continue
+			}
+		default:
+			if reflect.DeepEqual(fv.Interface(), reflect.Zero(f.Type).Interface()) {
+				continue
+			}
+		}
+
+		if fl := flattenValue(fv); fl != nil {
+			fields[key] = fl
+			if f.Tag.Get("sensitive") == "true" {
+				sensitive = append(sensitive, key)
+			}
+		}
+	}
+	return fields, sensitive
+}
@@ -0,0 +1,139 @@
+package fastly
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestClient_PlanVersion(t *testing.T) {
+	var mu sync.Mutex
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		r.ParseForm()
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/service/foo/version/2/condition":
+			w.Write([]byte(`[]`))
+		case r.Method == "GET" && r.URL.Path == "/service/foo/version/2/backend":
+			w.Write([]byte(`[{"name":"origin","address":"old.example.com","port":80,"use_ssl":false}]`))
+		case r.Method == "GET" && r.URL.Path == "/service/foo/version/2/domain":
+			w.Write([]byte(`[{"name":"www.example.com"},{"name":"stale.example.com"}]`))
+		case r.Method == "GET":
+			http.NotFound(w, r)
+		default:
+			requests = append(requests, r.Method+" "+r.URL.Path+" "+r.PostForm.Encode())
+			w.Write([]byte(`{"status":"ok"}`))
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := c.PlanVersion(&PlanVersionInput{
+		Service: "foo",
+		Version: 2,
+		Conditions: []*CreateConditionInput{
+			{Name: "is_api", Statement: `req.url ~ "^/api"`, Type: "REQUEST"},
+		},
+		Backends: []*CreateBackendInput{
+			{Name: "origin", Address: "new.example.com", Port: 80, UseSSL: CBool(true), SSLClientKey: "secret"},
+		},
+		Domains: []*CreateDomainInput{
+			{Name: "www.example.com"},
+		},
+		Prune: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []*PlanChange{
+		{
+			Action: PlanActionCreate,
+			Kind:   "condition",
+			Name:   "is_api",
+			Fields: map[string]interface{}{"name": "is_api", "statement": `req.url ~ "^/api"`, "type": "REQUEST"},
+		},
+		{
+			Action:    PlanActionUpdate,
+			Kind:      "backend",
+			Name:      "origin",
+			Fields:    map[string]interface{}{"address": "new.example.com", "use_ssl": true, "ssl_client_key": "secret"},
+			Before:    map[string]interface{}{"address": "old.example.com", "use_ssl": false, "ssl_client_key": ""},
+			Sensitive: []string{"ssl_client_key"},
+		},
+		{Action: PlanActionDelete, Kind: "domain", Name: "stale.example.com"},
+	}
+	if !reflect.DeepEqual(p.Changes, expected) {
+		b, _ := json.Marshal(p.Changes)
+		t.Fatalf("bad changes: %s", b)
+	}
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(b), "secret") || !strings.Contains(string(b), RedactedValue) {
+		t.Errorf("expected the client key to be redacted: %s", b)
+	}
+
+	var decoded Plan
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ApplyPlan(&ApplyPlanInput{Plan: &decoded}); err == nil || !strings.Contains(err.Error(), "redacted") {
+		t.Errorf("expected a redacted plan to be refused, got %v", err)
+	}
+
+	n, err := c.ApplyPlan(&ApplyPlanInput{Plan: p})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 3 {
+		t.Errorf("expected 3 changes applied, got %d", n)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	expectedRequests := []string{
+		"POST /service/foo/version/2/condition Service=foo&Version=2&name=is_api&statement=req.url+~+%22%5E%2Fapi%22&type=REQUEST",
+		"PUT /service/foo/version/2/backend/origin Name=origin&Service=foo&Version=2&address=new.example.com&ssl_client_key=secret&use_ssl=1",
+		"DELETE /service/foo/version/2/domain/stale.example.com ",
+	}
+	if !reflect.DeepEqual(requests, expectedRequests) {
+		t.Errorf("bad requests: %q", requests)
+	}
+}
+
+func TestClient_PlanVersion_validation(t *testing.T) {
+	var err error
+	_, err = testClient.PlanVersion(&PlanVersionInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.PlanVersion(&PlanVersionInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ApplyPlan(&ApplyPlanInput{})
+	if err != ErrMissingPlan {
+		t.Errorf("bad error: %s", err)
+	}
+}
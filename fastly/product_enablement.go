@@ -0,0 +1,110 @@
+package fastly
+
+import "fmt"
+
+// Product is the ID of a Fastly product which can be enabled per service.
+type Product string
+
+const (
+	ProductBotManagement     Product = "bot_management"
+	ProductBrotliCompression Product = "brotli_compression"
+	ProductDomainInspector   Product = "domain_inspector"
+	ProductImageOptimizer    Product = "image_optimizer"
+	ProductOriginInspector   Product = "origin_inspector"
+	ProductWebSockets        Product = "websockets"
+)
+
+// ProductEnablement represents a product enabled on a service.
+type ProductEnablement struct {
+	Product *ProductEnablementObject `mapstructure:"product"`
+	Service *ProductEnablementObject `mapstructure:"service"`
+}
+
+// ProductEnablementObject identifies the product or service of a
+// ProductEnablement.
+type ProductEnablementObject struct {
+	ID     string `mapstructure:"id"`
+	Object string `mapstructure:"object"`
+}
+
+// ProductEnablementInput is used as input to the GetProduct, EnableProduct
+// and DisableProduct functions.
+type ProductEnablementInput struct {
+	// Product is the product. Service is the ID of the service. Both fields
+	// are required.
+	Product Product
+	Service string
+}
+
+// productEnablementPath returns the API path of a product's enablement on a
+// service.
+func productEnablementPath(i *ProductEnablementInput) (string, error) {
+	if i.Product == "" {
+		return "", ErrMissingProduct
+	}
+
+	if i.Service == "" {
+		return "", ErrMissingService
+	}
+
+	return fmt.Sprintf("/enabled-products/v1/%s/services/%s", i.Product, i.Service), nil
+}
+
+// GetProduct gets the enablement of a product on a service. If the product is
+// not enabled, the API responds with a 404, which IsNotFound reports on the
+// returned *HTTPError.
+func (c *Client) GetProduct(i *ProductEnablementInput) (*ProductEnablement, error) {
+	path, err := productEnablementPath(i)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var p *ProductEnablement
+	if err := decodeJSON(&p, resp.Body); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// EnableProduct enables a product on a service. Enabling a product which is
+// already enabled is not an error.
+func (c *Client) EnableProduct(i *ProductEnablementInput) (*ProductEnablement, error) {
+	path, err := productEnablementPath(i)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.Put(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var p *ProductEnablement
+	if err := decodeJSON(&p, resp.Body); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// DisableProduct disables a product on a service.
+func (c *Client) DisableProduct(i *ProductEnablementInput) error {
+	path, err := productEnablementPath(i)
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.Delete(path, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	// The product API responds with 204 No Content rather than a status
+	// response.
+	return nil
+}
@@ -0,0 +1,41 @@
+package fastly
+
+import "testing"
+
+func TestClient_GetProduct_notEnabled(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	record(t, "products/get_not_found", func(c *Client) {
+		_, err = c.GetProduct(&ProductEnablementInput{
+			Product: ProductWebSockets,
+			Service: testServiceID,
+		})
+	})
+	herr, ok := err.(*HTTPError)
+	if !ok || !herr.IsNotFound() {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestClient_ProductEnablement_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetProduct(&ProductEnablementInput{
+		Service: "foo",
+	})
+	if err != ErrMissingProduct {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.EnableProduct(&ProductEnablementInput{
+		Product: ProductWebSockets,
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DisableProduct(&ProductEnablementInput{})
+	if err != ErrMissingProduct {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -1,6 +1,9 @@
 package fastly
 
-import "fmt"
+import (
+	"fmt"
+	"net/url"
+)
 
 // Purge is a response from a purge request.
 type Purge struct {
@@ -69,7 +72,7 @@ func (c *Client) PurgeKey(i *PurgeKeyInput) (*Purge, error) {
 		return nil, ErrMissingKey
 	}
 
-	path := fmt.Sprintf("/service/%s/purge/%s", i.Service, i.Key)
+	path := fmt.Sprintf("/service/%s/purge/%s", i.Service, url.PathEscape(i.Key))
 	req, err := c.RawRequest("POST", path, nil)
 	if err != nil {
 		return nil, err
@@ -98,14 +101,25 @@ type PurgeAllInput struct {
 
 	// Soft performs a soft purge.
 	Soft bool
+
+	// Confirm must be set to purge everything, unless the client's
+	// AllowPurgeAll option is set.
+	Confirm bool
 }
 
-// PurgeAll instantly purges everything from a service.
+// PurgeAll instantly purges everything from a service. Because a full purge
+// sends all traffic to origin, it must be confirmed with the input's Confirm
+// flag or the client's AllowPurgeAll option, and returns
+// ErrPurgeAllNotConfirmed otherwise.
 func (c *Client) PurgeAll(i *PurgeAllInput) (*Purge, error) {
 	if i.Service == "" {
 		return nil, ErrMissingService
 	}
 
+	if !i.Confirm && !c.AllowPurgeAll {
+		return nil, ErrPurgeAllNotConfirmed
+	}
+
 	path := fmt.Sprintf("/service/%s/purge_all", i.Service)
 	req, err := c.RawRequest("POST", path, nil)
 	if err != nil {
@@ -23,3 +23,49 @@ func TestClient_Purge(t *testing.T) {
 		t.Error("bad id")
 	}
 }
+
+func TestClient_PurgeAll(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var purge *Purge
+	record(t, "purges/purge_all", func(c *Client) {
+		purge, err = c.PurgeAll(&PurgeAllInput{
+			Service: testServiceID,
+			Confirm: true,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if purge.Status != "ok" {
+		t.Error("bad status")
+	}
+
+	record(t, "purges/purge_all", func(c *Client) {
+		c.AllowPurgeAll = true
+		purge, err = c.PurgeAll(&PurgeAllInput{
+			Service: testServiceID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_PurgeAll_validation(t *testing.T) {
+	var err error
+	_, err = testClient.PurgeAll(&PurgeAllInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.PurgeAll(&PurgeAllInput{
+		Service: "foo",
+	})
+	if err != ErrPurgeAllNotConfirmed {
+		t.Errorf("bad error: %s", err)
+	}
+}
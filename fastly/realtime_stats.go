@@ -1,6 +1,9 @@
 package fastly
 
-import "fmt"
+import (
+	"fmt"
+	"sort"
+)
 
 // RealtimeStats is a response from Fastly's real-time analytics endpoint
 type RealtimeStatsResponse struct {
@@ -18,6 +21,75 @@ type RealtimeData struct {
 	Recorded   uint64            `mapstructure:"recorded"`
 }
 
+// DatacenterStats is the stats for a single Fastly POP, identified by its
+// datacenter code such as "LHR".
+type DatacenterStats struct {
+	Datacenter string
+	Stats      *Stats
+}
+
+// ByDatacenter returns the per-POP stats of a single second, sorted by
+// datacenter code.
+func (d *RealtimeData) ByDatacenter() []*DatacenterStats {
+	dcs := make([]*DatacenterStats, 0, len(d.Datacenter))
+	for dc, s := range d.Datacenter {
+		dcs = append(dcs, &DatacenterStats{Datacenter: dc, Stats: s})
+	}
+	sort.Sort(datacenterStatsByName(dcs))
+	return dcs
+}
+
+// DatacenterTotals sums the per-POP stats across every second in the
+// response, sorted by datacenter code.
+func (r *RealtimeStatsResponse) DatacenterTotals() []*DatacenterStats {
+	buckets := make(map[string][]*Stats)
+	for _, d := range r.Data {
+		for dc, s := range d.Datacenter {
+			buckets[dc] = append(buckets[dc], s)
+		}
+	}
+
+	dcs := make([]*DatacenterStats, 0, len(buckets))
+	for dc, stats := range buckets {
+		dcs = append(dcs, &DatacenterStats{Datacenter: dc, Stats: SumStats(stats)})
+	}
+	sort.Sort(datacenterStatsByName(dcs))
+	return dcs
+}
+
+// ErroringDatacenters returns the POPs whose 5xx error rate across the
+// response is at least minRate (between 0 and 1), worst first.
+func (r *RealtimeStatsResponse) ErroringDatacenters(minRate float64) []*DatacenterStats {
+	var dcs []*DatacenterStats
+	for _, dc := range r.DatacenterTotals() {
+		if dc.Stats.Requests > 0 && dc.Stats.ErrorRate() >= minRate {
+			dcs = append(dcs, dc)
+		}
+	}
+	sort.Stable(datacenterStatsByErrorRate(dcs))
+	return dcs
+}
+
+// datacenterStatsByName is a sortable list of datacenter stats.
+type datacenterStatsByName []*DatacenterStats
+
+// Len, Swap, and Less implement the sortable interface.
+func (s datacenterStatsByName) Len() int      { return len(s) }
+func (s datacenterStatsByName) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s datacenterStatsByName) Less(i, j int) bool {
+	return s[i].Datacenter < s[j].Datacenter
+}
+
+// datacenterStatsByErrorRate sorts datacenter stats by descending error rate.
+type datacenterStatsByErrorRate []*DatacenterStats
+
+// Len, Swap, and Less implement the sortable interface.
+func (s datacenterStatsByErrorRate) Len() int      { return len(s) }
+func (s datacenterStatsByErrorRate) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s datacenterStatsByErrorRate) Less(i, j int) bool {
+	return s[i].Stats.ErrorRate() > s[j].Stats.ErrorRate()
+}
+
 // GetRealtimeStatsInput is an input parameter to GetRealtimeStats function
 type GetRealtimeStatsInput struct {
 	Service   string
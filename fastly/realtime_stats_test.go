@@ -30,3 +30,51 @@ func TestStatsClient_GetRealtimeStats(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestStatsClient_GetRealtimeStats_datacenters(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var s *RealtimeStatsResponse
+	recordRealtimeStats(t, "realtime_stats/datacenters", func(c *RTSClient) {
+		s, err = c.GetRealtimeStats(&GetRealtimeStatsInput{
+			Service:   testServiceID,
+			Timestamp: 1501167749,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Data) != 2 {
+		t.Fatalf("bad data: %v", s.Data)
+	}
+
+	dcs := s.Data[0].ByDatacenter()
+	if len(dcs) != 3 || dcs[0].Datacenter != "IAD" || dcs[2].Datacenter != "SYD" {
+		t.Fatalf("bad datacenters: %v", dcs)
+	}
+	if dcs[1].Stats.MissHistogram[10] != 15 {
+		t.Errorf("bad miss_histogram: %v", dcs[1].Stats.MissHistogram)
+	}
+
+	totals := s.DatacenterTotals()
+	if totals[1].Datacenter != "LHR" || totals[1].Stats.Requests != 220 || totals[1].Stats.Status5xx != 6 {
+		t.Errorf("bad totals: %#v", totals[1].Stats)
+	}
+
+	agg := s.Data[0].Aggregated
+	if agg.ComputeRequests != 310 || agg.ComputeExecutionTimeMs != 412.5 || agg.ComputeRAMUsed != 104857600 {
+		t.Errorf("bad compute stats: %#v", agg)
+	}
+	if agg.ComputeBERequests != 155 || agg.ComputeBERequestErrors != 1 {
+		t.Errorf("bad compute backend stats: %#v", agg)
+	}
+
+	erroring := s.ErroringDatacenters(0.02)
+	if len(erroring) != 2 {
+		t.Fatalf("bad erroring datacenters: %v", erroring)
+	}
+	if erroring[0].Datacenter != "SYD" || erroring[1].Datacenter != "LHR" {
+		t.Errorf("bad order: %s, %s", erroring[0].Datacenter, erroring[1].Datacenter)
+	}
+}
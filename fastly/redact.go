@@ -0,0 +1,152 @@
+package fastly
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// RedactedValue replaces sensitive values in redacted output.
+const RedactedValue = "[REDACTED]"
+
+// Redact returns a copy of v, which must be a struct or a pointer to one,
+// with every field tagged `sensitive:"true"` that is set replaced by
+// RedactedValue. Secret keys, tokens, passwords and private keys on the input
+// and response types are tagged this way, so the result of Redact is safe to
+// write to logs. Other values are returned unchanged.
+func Redact(v interface{}) interface{} {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+			return v
+		}
+		cp := reflect.New(rv.Elem().Type())
+		cp.Elem().Set(rv.Elem())
+		redactStruct(cp.Elem())
+		return cp.Interface()
+	}
+	if rv.Kind() != reflect.Struct {
+		return v
+	}
+	cp := reflect.New(rv.Type()).Elem()
+	cp.Set(rv)
+	redactStruct(cp)
+	return cp.Interface()
+}
+
+// redactStruct replaces the set sensitive fields of the addressable struct v.
+func redactStruct(v reflect.Value) {
+	for _, f := range sensitiveFields(v) {
+		f.SetString(RedactedValue)
+	}
+}
+
+// sensitiveValues returns the set sensitive field values of i, which may be a
+// struct or a pointer to one.
+func sensitiveValues(i interface{}) []string {
+	v := reflect.ValueOf(i)
+	if v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	var values []string
+	for _, f := range sensitiveFields(v) {
+		values = append(values, f.String())
+	}
+	return values
+}
+
+// sensitiveFields returns the non-empty string fields of the struct v which
+// are tagged as sensitive.
+func sensitiveFields(v reflect.Value) []reflect.Value {
+	var fields []reflect.Value
+	t := v.Type()
+	for n := 0; n < t.NumField(); n++ {
+		if t.Field(n).Tag.Get("sensitive") != "true" {
+			continue
+		}
+		if f := v.Field(n); f.Kind() == reflect.String && f.String() != "" {
+			fields = append(fields, f)
+		}
+	}
+	return fields
+}
+
+// redactError removes the API key and the sensitive field values of the
+// request input i from the error bodies of an *HTTPError, in case the API
+// echoes a rejected value back. Other errors are returned unchanged.
+func (c *Client) redactError(err error, i interface{}) error {
+	e, ok := err.(*HTTPError)
+	if !ok {
+		return err
+	}
+
+	secrets := sensitiveValues(i)
+	if c.apiKey != "" {
+		secrets = append(secrets, c.apiKey)
+	}
+	if len(secrets) == 0 {
+		return err
+	}
+
+	for _, o := range e.Errors {
+		for _, s := range secrets {
+			o.Title = strings.Replace(o.Title, s, RedactedValue, -1)
+			o.Detail = strings.Replace(o.Detail, s, RedactedValue, -1)
+		}
+	}
+	return e
+}
+
+// redactedString formats v, a pointer to a struct, like the %+v verb but with
+// its sensitive fields redacted. It backs the String methods of the types
+// with sensitive fields, so printing them does not leak credentials.
+func redactedString(v interface{}) string {
+	return formatRedacted(v, false)
+}
+
+// redactedGoString is like redactedString, for the %#v verb.
+func redactedGoString(v interface{}) string {
+	return formatRedacted(v, true)
+}
+
+// formatRedacted formats the fields of the redacted copy of v one by one, as
+// formatting the struct itself would call its String method again.
+func formatRedacted(v interface{}, goSyntax bool) string {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() {
+		return "<nil>"
+	}
+
+	s := reflect.ValueOf(Redact(v)).Elem()
+	t := s.Type()
+
+	var b bytes.Buffer
+	sep, verb := " ", "%s:%+v"
+	if goSyntax {
+		sep, verb = ", ", "%s:%#v"
+		fmt.Fprintf(&b, "&%s{", t)
+	} else {
+		b.WriteString("&{")
+	}
+	wrote := false
+	for n := 0; n < t.NumField(); n++ {
+		if !s.Field(n).CanInterface() {
+			continue
+		}
+		if wrote {
+			b.WriteString(sep)
+		}
+		fmt.Fprintf(&b, verb, t.Field(n).Name, s.Field(n).Interface())
+		wrote = true
+	}
+	b.WriteByte('}')
+	return b.String()
+}
@@ -1,24 +1,69 @@
 package fastly
 
 import (
+	"bytes"
+	"context"
 	"io"
 	"net/http"
 	"net/url"
 	"strings"
+	"time"
 )
 
-// RequestOptions is the list of options to pass to the request.
+// RequestOptions is the list of options to pass to the request. The same
+// options are honored by every verb, including the form and JSON variants,
+// which set their own Body and Content-Type.
 type RequestOptions struct {
 	// Params is a map of key-value pairs that will be added to the Request.
 	Params map[string]string
 
+	// Query holds query parameters which may have more than one value. They
+	// are added after Params.
+	Query url.Values
+
 	// Headers is a map of key-value pairs that will be added to the Request.
 	Headers map[string]string
 
+	// Header holds headers which may have more than one value. They are added
+	// after Headers.
+	Header http.Header
+
 	// Body is an io.Reader object that will be streamed or uploaded with the
 	// Request. BodyLength is the final size of the Body.
 	Body       io.Reader
 	BodyLength int64
+
+	// Context, if set, is the context of the request. Cancelling it aborts
+	// the request.
+	Context context.Context
+
+	// Timeout, if set, limits the time the request may take, including reading
+	// the response body.
+	Timeout time.Duration
+}
+
+// withBody returns a copy of ro, which may be nil, with the given body and
+// Content-Type and Accept headers. The caller's options are left unchanged.
+func (ro *RequestOptions) withBody(body []byte, contentType string) *RequestOptions {
+	cp := &RequestOptions{}
+	if ro != nil {
+		*cp = *ro
+	}
+
+	cp.Headers = make(map[string]string, len(cp.Headers)+2)
+	if ro != nil {
+		for k, v := range ro.Headers {
+			cp.Headers[k] = v
+		}
+	}
+	cp.Headers["Content-Type"] = contentType
+	if contentType != "application/x-www-form-urlencoded" {
+		cp.Headers["Accept"] = contentType
+	}
+
+	cp.Body = bytes.NewReader(body)
+	cp.BodyLength = int64(len(body))
+	return cp
 }
 
 // RawRequest accepts a verb, URL, and RequestOptions struct and returns the
@@ -29,15 +74,27 @@ func (c *Client) RawRequest(verb, p string, ro *RequestOptions) (*http.Request,
 		ro = new(RequestOptions)
 	}
 
-	// Append the path to the URL.
+	// Append the path to the URL. Segments escaped with url.PathEscape, such
+	// as names containing a slash, stay escaped. A path which is not validly
+	// escaped, such as a purge key containing a bare "%", is used as is.
 	u := *c.url
-	u.Path = strings.TrimRight(c.url.Path, "/") + "/" + strings.TrimLeft(p, "/")
+	p = strings.TrimRight(c.url.EscapedPath(), "/") + "/" + strings.TrimLeft(p, "/")
+	if unescaped, err := url.PathUnescape(p); err == nil {
+		u.Path, u.RawPath = unescaped, p
+	} else {
+		u.Path, u.RawPath = p, ""
+	}
 
 	// Add the token and other params.
 	var params = make(url.Values)
 	for k, v := range ro.Params {
 		params.Add(k, v)
 	}
+	for k, vs := range ro.Query {
+		for _, v := range vs {
+			params.Add(k, v)
+		}
+	}
 	u.RawQuery = params.Encode()
 
 	// Create the request object.
@@ -58,15 +115,38 @@ func (c *Client) RawRequest(verb, p string, ro *RequestOptions) (*http.Request,
 	for k, v := range ro.Headers {
 		request.Header.Add(k, v)
 	}
+	for k, vs := range ro.Header {
+		for _, v := range vs {
+			request.Header.Add(k, v)
+		}
+	}
 
 	// Add Content-Length if we have it.
 	if ro.BodyLength > 0 {
 		request.ContentLength = ro.BodyLength
 	}
 
+	if ro.Context != nil {
+		request = request.WithContext(ro.Context)
+	}
+
 	return request, nil
 }
 
+// cancelOnClose cancels a request's context once its response body is closed,
+// so a Timeout also covers reading the body.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+// Close closes the body and cancels the context.
+func (b *cancelOnClose) Close() error {
+	err := b.ReadCloser.Close()
+	b.cancel()
+	return err
+}
+
 // SimpleGet combines the RawRequest and Request methods,
 // but doesn't add any parameters or change any encoding in the URL
 // passed to it. It's mostly for calling the URLs given to us
@@ -0,0 +1,311 @@
+package fastly
+
+import "fmt"
+
+const (
+	// ForceTLSRedirectDefaultName is the name given to the objects created by
+	// ForceTLSRedirect when no name is set.
+	ForceTLSRedirectDefaultName = "Force TLS"
+
+	// DisableCachingDefaultName is the name given to the objects created by
+	// DisableCaching when no name is set.
+	DisableCachingDefaultName = "Disable caching"
+
+	// PassAllRequestsDefaultName is the name given to the objects created by
+	// PassAllRequests when no name is set.
+	PassAllRequestsDefaultName = "Pass all requests"
+
+	// ServeStaleDefaultName is the name given to the objects created by
+	// ServeStale when no name is set.
+	ServeStaleDefaultName = "Serve stale"
+)
+
+// RequestPolicyInput is used as input to the request policy helpers, such as
+// ForceTLSRedirect.
+type RequestPolicyInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the objects created. Optional, defaults to the
+	// helper's default name, such as ForceTLSRedirectDefaultName.
+	Name string
+
+	// Statement limits the policy to the requests it matches, through a
+	// condition named Name. Optional; by default the policy applies to every
+	// request.
+	Statement string
+}
+
+// RequestPolicy is the set of objects created by a request policy helper.
+// Fields which the policy does not need are nil.
+type RequestPolicy struct {
+	Settings       *Settings
+	Condition      *Condition
+	RequestSetting *RequestSetting
+	CacheSetting   *CacheSetting
+}
+
+// ForceTLSRedirect creates or updates a request setting which redirects
+// plain HTTP requests to HTTPS with a 301, before they reach the cache or the
+// origin.
+func (c *Client) ForceTLSRedirect(i *RequestPolicyInput) (*RequestPolicy, error) {
+	return c.applyRequestPolicy(i, ForceTLSRedirectDefaultName, "REQUEST", func(name, cond string) (*RequestPolicy, error) {
+		rs, err := c.upsertRequestSetting(&CreateRequestSettingInput{
+			Service:          i.Service,
+			Version:          i.Version,
+			Name:             name,
+			ForceSSL:         CBool(true),
+			RequestCondition: cond,
+		})
+		return &RequestPolicy{RequestSetting: rs}, err
+	})
+}
+
+// DisableCaching creates or updates a cache setting which stops responses
+// from being stored. Unlike PassAllRequests, requests still look up the cache,
+// so Fastly remembers the responses as uncacheable (hit-for-pass) and sends
+// concurrent requests for them straight to the origin instead of collapsing
+// them into a queue behind the first. A Statement is used as a CACHE
+// condition, so it can also test the origin response, such as
+// beresp.http.Cache-Control.
+func (c *Client) DisableCaching(i *RequestPolicyInput) (*RequestPolicy, error) {
+	return c.applyRequestPolicy(i, DisableCachingDefaultName, "CACHE", func(name, cond string) (*RequestPolicy, error) {
+		cs, err := c.upsertCacheSetting(&CreateCacheSettingInput{
+			Service:        i.Service,
+			Version:        i.Version,
+			Name:           name,
+			Action:         CacheSettingActionPass,
+			CacheCondition: cond,
+		})
+		return &RequestPolicy{CacheSetting: cs}, err
+	})
+}
+
+// PassAllRequests creates or updates a request setting which sends requests
+// to the origin without looking up the cache, and so without request
+// collapsing. It is meant for traffic which can never be cached, such as an
+// API; for responses which are only sometimes uncacheable, DisableCaching
+// keeps the cache lookup.
+func (c *Client) PassAllRequests(i *RequestPolicyInput) (*RequestPolicy, error) {
+	return c.applyRequestPolicy(i, PassAllRequestsDefaultName, "REQUEST", func(name, cond string) (*RequestPolicy, error) {
+		rs, err := c.upsertRequestSetting(&CreateRequestSettingInput{
+			Service:          i.Service,
+			Version:          i.Version,
+			Name:             name,
+			Action:           RequestSettingActionPass,
+			RequestCondition: cond,
+		})
+		return &RequestPolicy{RequestSetting: rs}, err
+	})
+}
+
+// DefaultStaleIfErrorTTL is the time, in seconds, ServeStale keeps serving an
+// object after it expires when no StaleIfErrorTTL is set. It matches the
+// API's default for general.stale_if_error_ttl.
+const DefaultStaleIfErrorTTL = 43200
+
+// ServeStaleInput is used as input to the ServeStale function.
+type ServeStaleInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name and Statement are used like those of a RequestPolicyInput. A
+	// Statement is used as a CACHE condition.
+	Name      string
+	Statement string
+
+	// StaleIfErrorTTL is how long, in seconds, an expired object may still be
+	// served while the origin is failing. Optional, defaults to
+	// DefaultStaleIfErrorTTL.
+	StaleIfErrorTTL uint
+
+	// TTL is how long, in seconds, objects are fresh. Optional, defaults to
+	// the version's default TTL.
+	TTL uint
+}
+
+// ServeStale configures a version to serve stale objects when the origin is
+// down or returns an error. Serving stale needs two objects which agree: the
+// version's settings must enable stale_if_error, and objects must be kept in
+// the cache past their TTL, which the cache setting does by giving them a
+// stale TTL. ServeStale updates both with the same StaleIfErrorTTL, keeping
+// the settings' default TTL and host.
+func (c *Client) ServeStale(i *ServeStaleInput) (*RequestPolicy, error) {
+	staleTTL := i.StaleIfErrorTTL
+	if staleTTL == 0 {
+		staleTTL = DefaultStaleIfErrorTTL
+	}
+
+	p := &RequestPolicyInput{
+		Service:   i.Service,
+		Version:   i.Version,
+		Name:      i.Name,
+		Statement: i.Statement,
+	}
+	return c.applyRequestPolicy(p, ServeStaleDefaultName, "CACHE", func(name, cond string) (*RequestPolicy, error) {
+		settings, err := c.GetSettings(&GetSettingsInput{
+			Service: i.Service,
+			Version: i.Version,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		ttl := i.TTL
+		if ttl == 0 {
+			ttl = settings.DefaultTTL
+		}
+
+		// UpdateSettings always sends the default TTL, so the current one is
+		// sent back to keep it.
+		settings, err = c.UpdateSettings(&UpdateSettingsInput{
+			Service:         i.Service,
+			Version:         i.Version,
+			DefaultTTL:      settings.DefaultTTL,
+			DefaultHost:     settings.DefaultHost,
+			StaleIfError:    CBool(true),
+			StaleIfErrorTTL: staleTTL,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		cs, err := c.upsertCacheSetting(&CreateCacheSettingInput{
+			Service:        i.Service,
+			Version:        i.Version,
+			Name:           name,
+			TTL:            ttl,
+			StaleTTL:       staleTTL,
+			CacheCondition: cond,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return &RequestPolicy{Settings: settings, CacheSetting: cs}, nil
+	})
+}
+
+// applyRequestPolicy validates the input, creates or updates the policy's
+// condition when a Statement is set, and then applies the policy's settings
+// with the names of the objects and the condition.
+func (c *Client) applyRequestPolicy(i *RequestPolicyInput, defaultName, condType string, apply func(name, cond string) (*RequestPolicy, error)) (*RequestPolicy, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	name := i.Name
+	if name == "" {
+		name = defaultName
+	}
+
+	var cond *Condition
+	if i.Statement != "" {
+		var err error
+		cond, err = c.upsertCondition(&CreateConditionInput{
+			Service:   i.Service,
+			Version:   i.Version,
+			Name:      name,
+			Statement: i.Statement,
+			Type:      condType,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	condName := ""
+	if cond != nil {
+		condName = cond.Name
+	}
+	p, err := apply(name, condName)
+	if err != nil {
+		return nil, err
+	}
+	p.Condition = cond
+	return p, nil
+}
+
+// upsertCondition updates the condition with the input's name, or creates it
+// if it does not exist. A condition of another type is an error.
+func (c *Client) upsertCondition(i *CreateConditionInput) (*Condition, error) {
+	cond, err := c.GetCondition(&GetConditionInput{
+		Service: i.Service,
+		Version: i.Version,
+		Name:    i.Name,
+	})
+	switch {
+	case err == nil:
+		if cond.Type != i.Type {
+			return nil, fmt.Errorf("condition %q is a %s condition, not a %s condition", i.Name, cond.Type, i.Type)
+		}
+		if cond.Statement == i.Statement {
+			return cond, nil
+		}
+		return c.UpdateCondition(&UpdateConditionInput{
+			Service:   i.Service,
+			Version:   i.Version,
+			Name:      i.Name,
+			Statement: i.Statement,
+		})
+	case isNotFound(err):
+		return c.CreateCondition(i)
+	}
+	return nil, err
+}
+
+// upsertRequestSetting updates the request setting with the input's name, or
+// creates it if it does not exist.
+func (c *Client) upsertRequestSetting(i *CreateRequestSettingInput) (*RequestSetting, error) {
+	_, err := c.GetRequestSetting(&GetRequestSettingInput{
+		Service: i.Service,
+		Version: i.Version,
+		Name:    i.Name,
+	})
+	switch {
+	case err == nil:
+		return c.UpdateRequestSetting(&UpdateRequestSettingInput{
+			Service:          i.Service,
+			Version:          i.Version,
+			Name:             i.Name,
+			ForceSSL:         i.ForceSSL,
+			Action:           i.Action,
+			RequestCondition: i.RequestCondition,
+		})
+	case isNotFound(err):
+		return c.CreateRequestSetting(i)
+	}
+	return nil, err
+}
+
+// upsertCacheSetting updates the cache setting with the input's name, or
+// creates it if it does not exist.
+func (c *Client) upsertCacheSetting(i *CreateCacheSettingInput) (*CacheSetting, error) {
+	_, err := c.GetCacheSetting(&GetCacheSettingInput{
+		Service: i.Service,
+		Version: i.Version,
+		Name:    i.Name,
+	})
+	switch {
+	case err == nil:
+		return c.UpdateCacheSetting(&UpdateCacheSettingInput{
+			Service:        i.Service,
+			Version:        i.Version,
+			Name:           i.Name,
+			Action:         i.Action,
+			TTL:            i.TTL,
+			StaleTTL:       i.StaleTTL,
+			CacheCondition: i.CacheCondition,
+		})
+	case isNotFound(err):
+		return c.CreateCacheSetting(i)
+	}
+	return nil, err
+}
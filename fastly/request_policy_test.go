@@ -0,0 +1,102 @@
+package fastly
+
+import "testing"
+
+func TestClient_RequestPolicies(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var tls, nocache, pass *RequestPolicy
+	record(t, "request_policy/apply", func(c *Client) {
+		if tls, err = c.ForceTLSRedirect(&RequestPolicyInput{
+			Service: testServiceID,
+			Version: 6,
+		}); err != nil {
+			return
+		}
+		if nocache, err = c.DisableCaching(&RequestPolicyInput{
+			Service:   testServiceID,
+			Version:   6,
+			Statement: `beresp.http.Cache-Control ~ "private"`,
+		}); err != nil {
+			return
+		}
+		pass, err = c.PassAllRequests(&RequestPolicyInput{
+			Service: testServiceID,
+			Version: 6,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if tls.Condition != nil || tls.CacheSetting != nil {
+		t.Errorf("bad force TLS policy: %#v", tls)
+	}
+	if !tls.RequestSetting.ForceSSL {
+		t.Errorf("expected force_ssl: %#v", tls.RequestSetting)
+	}
+
+	if nocache.Condition == nil || nocache.Condition.Type != "CACHE" {
+		t.Errorf("bad condition: %#v", nocache.Condition)
+	}
+	if cs := nocache.CacheSetting; cs.Action != CacheSettingActionPass || cs.CacheCondition != "Disable caching" {
+		t.Errorf("bad cache setting: %#v", cs)
+	}
+
+	if pass.RequestSetting.Action != RequestSettingActionPass {
+		t.Errorf("bad request setting: %#v", pass.RequestSetting)
+	}
+}
+
+func TestClient_ServeStale(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var p *RequestPolicy
+	record(t, "request_policy/serve_stale", func(c *Client) {
+		p, err = c.ServeStale(&ServeStaleInput{
+			Service:         testServiceID,
+			Version:         6,
+			StaleIfErrorTTL: 86400,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if s := p.Settings; !s.StaleIfError || s.StaleIfErrorTTL != 86400 || s.DefaultTTL != 3600 {
+		t.Errorf("bad settings: %#v", s)
+	}
+	if cs := p.CacheSetting; cs.TTL != 3600 || cs.StaleTTL != 86400 || cs.Name != ServeStaleDefaultName {
+		t.Errorf("bad cache setting: %#v", cs)
+	}
+	if p.Condition != nil {
+		t.Errorf("bad condition: %#v", p.Condition)
+	}
+}
+
+func TestClient_RequestPolicies_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ForceTLSRedirect(&RequestPolicyInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.PassAllRequests(&RequestPolicyInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ServeStale(&ServeStaleInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -1,9 +1,15 @@
 package fastly
 
 import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 func TestClient_RawRequest(t *testing.T) {
@@ -53,3 +59,123 @@ func TestClient_RawRequest(t *testing.T) {
 		}
 	}
 }
+
+func TestClient_RawRequest_options(t *testing.T) {
+	c, err := NewClientForEndpoint("", "https://api.fastly.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	r, err := c.RawRequest("GET", "/service", &RequestOptions{
+		Params:  map[string]string{"page": "1"},
+		Query:   url.Values{"filter": {"a", "b"}},
+		Headers: map[string]string{"Fastly-Soft-Purge": "1"},
+		Header:  http.Header{"Surrogate-Key": {"one", "two"}},
+		Context: ctx,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q := r.URL.Query(); q.Get("page") != "1" || !reflect.DeepEqual(q["filter"], []string{"a", "b"}) {
+		t.Errorf("bad query: %s", r.URL.RawQuery)
+	}
+	if r.Header.Get("Fastly-Soft-Purge") != "1" || !reflect.DeepEqual(r.Header["Surrogate-Key"], []string{"one", "two"}) {
+		t.Errorf("bad headers: %v", r.Header)
+	}
+	if r.Context() != ctx {
+		t.Error("expected the request context to be set")
+	}
+}
+
+func TestClient_Request_timeout(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/slow" {
+			select {
+			case <-r.Context().Done():
+			case <-time.After(5 * time.Second):
+			}
+			return
+		}
+		w.Write([]byte("{}"))
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := c.Get("/slow", &RequestOptions{Timeout: 50 * time.Millisecond}); err == nil {
+		t.Error("expected the request to time out")
+	}
+
+	resp, err := c.Get("/fast", &RequestOptions{Timeout: time.Second})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if b, err := ioutil.ReadAll(resp.Body); err != nil || string(b) != "{}" {
+		t.Errorf("bad body: %q, %v", b, err)
+	}
+}
+
+func TestClient_RequestForm_options(t *testing.T) {
+	var got http.Header
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header
+		w.Write([]byte("{}"))
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ro := &RequestOptions{Headers: map[string]string{"X-Test": "1"}}
+	resp, err := c.PostForm("/form", &CreateServiceInput{Name: "test"}, ro)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if got.Get("X-Test") != "1" || got.Get("Content-Type") != "application/x-www-form-urlencoded" {
+		t.Errorf("bad headers: %v", got)
+	}
+	if len(ro.Headers) != 1 || ro.Body != nil {
+		t.Errorf("request options were modified: %+v", ro)
+	}
+}
+
+func TestClient_RawRequest_escapedNames(t *testing.T) {
+	c, err := NewClientForEndpoint("", "https://api.fastly.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, tc := range []struct {
+		name string
+		want string
+	}{
+		{"my logger/v2", "my%20logger%2Fv2"},
+		{"100%", "100%25"},
+		{"a?b#c", "a%3Fb%23c"},
+		{"café", "caf%C3%A9"},
+		{"../admin", "..%2Fadmin"},
+	} {
+		p := "/service/foo/version/1/logging/syslog/" + url.PathEscape(tc.name)
+		r, err := c.RawRequest("GET", p, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := "https://api.fastly.com/service/foo/version/1/logging/syslog/" + tc.want; r.URL.String() != want {
+			t.Errorf("bad url for %q: got %s, want %s", tc.name, r.URL, want)
+		}
+		if !strings.HasSuffix(r.URL.Path, "/"+tc.name) {
+			t.Errorf("bad path for %q: %s", tc.name, r.URL.Path)
+		}
+	}
+}
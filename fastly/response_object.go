@@ -1,8 +1,15 @@
 package fastly
 
 import (
+	"encoding/base64"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
 	"sort"
+	"strings"
 )
 
 // ResponseObject represents a response object response from the Fastly API.
@@ -79,6 +86,12 @@ type CreateResponseObjectInput struct {
 	ContentType      string `form:"content_type,omitempty"`
 	RequestCondition string `form:"request_condition,omitempty"`
 	CacheCondition   string `form:"cache_condition,omitempty"`
+
+	// ContentReader, if set, is read for the content instead of Content, such
+	// as to serve an error page or a favicon from a file. When ContentType is
+	// not set, it is detected from the content. Content of a type which is
+	// not text is sent base64 encoded.
+	ContentReader io.Reader `form:"-"`
 }
 
 // CreateResponseObject creates a new Fastly response object.
@@ -91,6 +104,16 @@ func (c *Client) CreateResponseObject(i *CreateResponseObjectInput) (*ResponseOb
 		return nil, ErrMissingVersion
 	}
 
+	if i.ContentReader != nil {
+		in := *i
+		content, err := readResponseObjectContent(in.ContentReader, &in.ContentType)
+		if err != nil {
+			return nil, err
+		}
+		in.Content = content
+		i = &in
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/response_object", i.Service, i.Version)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -130,7 +153,7 @@ func (c *Client) GetResponseObject(i *GetResponseObjectInput) (*ResponseObject,
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/response_object/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/response_object/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Get(path, nil)
 	if err != nil {
 		return nil, err
@@ -161,6 +184,12 @@ type UpdateResponseObjectInput struct {
 	ContentType      string `form:"content_type,omitempty"`
 	RequestCondition string `form:"request_condition,omitempty"`
 	CacheCondition   string `form:"cache_condition,omitempty"`
+
+	// ContentReader, if set, is read for the content instead of Content, such
+	// as to serve an error page or a favicon from a file. When ContentType is
+	// not set, it is detected from the content. Content of a type which is
+	// not text is sent base64 encoded.
+	ContentReader io.Reader `form:"-"`
 }
 
 // UpdateResponseObject updates a specific response object.
@@ -177,7 +206,17 @@ func (c *Client) UpdateResponseObject(i *UpdateResponseObjectInput) (*ResponseOb
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/response_object/%s", i.Service, i.Version, i.Name)
+	if i.ContentReader != nil {
+		in := *i
+		content, err := readResponseObjectContent(in.ContentReader, &in.ContentType)
+		if err != nil {
+			return nil, err
+		}
+		in.Content = content
+		i = &in
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/response_object/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
 		return nil, err
@@ -190,6 +229,72 @@ func (c *Client) UpdateResponseObject(i *UpdateResponseObjectInput) (*ResponseOb
 	return b, nil
 }
 
+// readResponseObjectContent reads the content of a response object from r and
+// sets contentType, if empty, to the detected type. Response objects hold
+// their content as a string, so a payload whose type is not text, such as a
+// favicon, is sent base64 encoded, as with SyntheticBase64, and
+// ResponseObject.DecodedContent returns the original bytes.
+func readResponseObjectContent(r io.Reader, contentType *string) (string, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	if *contentType == "" {
+		*contentType = http.DetectContentType(b)
+	}
+	if !isTextContentType(*contentType) {
+		return base64.StdEncoding.EncodeToString(b), nil
+	}
+	return string(b), nil
+}
+
+// DecodedContent returns the content of the response object as bytes. The
+// content of a type which is not text is base64 encoded when it is read from
+// a ContentReader, and is decoded.
+func (r *ResponseObject) DecodedContent() ([]byte, error) {
+	if isTextContentType(r.ContentType) {
+		return []byte(r.Content), nil
+	}
+	return base64.StdEncoding.DecodeString(r.Content)
+}
+
+// isTextContentType reports whether content of the MIME type t is text: a
+// text type, a type with a charset, or a JSON, XML or JavaScript type. An
+// empty type is treated as text, as that is how content set directly is sent.
+func isTextContentType(t string) bool {
+	if t == "" {
+		return true
+	}
+	mt, params, err := mime.ParseMediaType(t)
+	if err != nil {
+		return true
+	}
+	if _, ok := params["charset"]; ok {
+		return true
+	}
+	switch {
+	case strings.HasPrefix(mt, "text/"),
+		strings.HasSuffix(mt, "+json"),
+		strings.HasSuffix(mt, "+xml"),
+		mt == "application/json",
+		mt == "application/javascript",
+		mt == "application/xml":
+		return true
+	}
+	return false
+}
+
+// SyntheticBase64 reads a payload from r and returns a VCL synthetic.base64
+// statement which serves it. Binary payloads, such as a favicon, can be
+// served this way from a vcl_error snippet.
+func SyntheticBase64(r io.Reader) (string, error) {
+	b, err := ioutil.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("synthetic.base64 {\"%s\"};", base64.StdEncoding.EncodeToString(b)), nil
+}
+
 // DeleteResponseObjectInput is the input parameter to DeleteResponseObject.
 type DeleteResponseObjectInput struct {
 	// Service is the ID of the service. Version is the specific configuration
@@ -215,7 +320,7 @@ func (c *Client) DeleteResponseObject(i *DeleteResponseObjectInput) error {
 		return ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/response_object/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/response_object/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Delete(path, nil)
 	if err != nil {
 		return err
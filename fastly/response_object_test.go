@@ -1,6 +1,14 @@
 package fastly
 
-import "testing"
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
 
 func TestClient_ResponseObjects(t *testing.T) {
 	t.Parallel()
@@ -250,3 +258,91 @@ func TestClient_DeleteResponseObject_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_CreateResponseObject_contentReader(t *testing.T) {
+	t.Parallel()
+
+	var ro *ResponseObject
+	var err error
+	record(t, "response_objects/create_reader", func(c *Client) {
+		ro, err = c.CreateResponseObject(&CreateResponseObjectInput{
+			Service:       "7i6HN3TK9wS159v2gPAZ8A",
+			Version:       7,
+			Name:          "maintenance-page",
+			Status:        503,
+			Response:      "Service Unavailable",
+			ContentReader: strings.NewReader("<!DOCTYPE html><html><body>Service unavailable</body></html>"),
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ro.ContentType != "text/html; charset=utf-8" {
+		t.Errorf("bad content_type: %q", ro.ContentType)
+	}
+	if b, err := ro.DecodedContent(); err != nil || !strings.Contains(string(b), "Service unavailable") {
+		t.Errorf("bad decoded content: %q, %v", b, err)
+	}
+}
+
+func TestClient_CreateResponseObject_binaryContent(t *testing.T) {
+	var mu sync.Mutex
+	stored := make(map[string]string)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Method == "POST" {
+			r.ParseForm()
+			stored["name"] = r.PostForm.Get("name")
+			stored["content"] = r.PostForm.Get("content")
+			stored["content_type"] = r.PostForm.Get("content_type")
+		}
+		b, _ := json.Marshal(stored)
+		w.Write(b)
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	favicon := []byte{0x00, 0x00, 0x01, 0x00, 0x01, 0x00, 0x10, 0x10, 0xff, 0xfe}
+	if _, err := c.CreateResponseObject(&CreateResponseObjectInput{
+		Service:       "foo",
+		Version:       1,
+		Name:          "favicon",
+		ContentReader: bytes.NewReader(favicon),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	if stored["content_type"] != "image/x-icon" || stored["content"] != "AAABAAEAEBD//g==" {
+		t.Errorf("bad stored content: %q", stored)
+	}
+	mu.Unlock()
+
+	ro, err := c.GetResponseObject(&GetResponseObjectInput{Service: "foo", Version: 1, Name: "favicon"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := ro.DecodedContent()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(b, favicon) {
+		t.Errorf("bad round trip: %v", b)
+	}
+}
+
+func TestSyntheticBase64(t *testing.T) {
+	s, err := SyntheticBase64(bytes.NewReader([]byte{0x00, 0x00, 0x01, 0x00}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s != `synthetic.base64 {"AAABAA=="};` {
+		t.Errorf("bad statement: %s", s)
+	}
+}
@@ -0,0 +1,362 @@
+package fastly
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// VersionReferenceError is returned by RollbackToVersion when the version to
+// roll back to references resources which no longer work, so activating it
+// would break traffic.
+type VersionReferenceError struct {
+	Service string
+	Version int
+
+	// Problems describes each broken reference, such as a dictionary whose
+	// items were deleted.
+	Problems []string
+}
+
+// Error implements the error interface.
+func (e *VersionReferenceError) Error() string {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "version %d of service %s has %d broken reference(s):", e.Version, e.Service, len(e.Problems))
+	for _, p := range e.Problems {
+		fmt.Fprintf(&b, "\n    %s", p)
+	}
+	return b.String()
+}
+
+// RollbackToVersionInput is used as input to the RollbackToVersion function.
+type RollbackToVersionInput struct {
+	// Service is the ID of the service. Version is the version to roll back
+	// to. Both fields are required.
+	Service string
+	Version int
+
+	// Wait waits for the version to become active with WaitForVersionActive,
+	// using its default timeout.
+	Wait bool
+}
+
+// Rollback is the outcome of RollbackToVersion.
+type Rollback struct {
+	// From is the version that was active, and To the version activated.
+	From int
+	To   int
+
+	// Diff is the text diff from the version that was active to the version
+	// activated. It is nil if the version was already active.
+	Diff *Diff
+}
+
+// RollbackToVersion activates an earlier version of a service, such as the
+// last known good version during an incident. Activation swaps the whole
+// configuration at once, so traffic is not interrupted, but an old version
+// can reference resources which have changed since: before activating, the
+// dictionaries and ACLs its VCL, snippets and conditions use must be defined,
+// the certificates of its TLS backends must not have expired, and the TLS
+// certificates activated for its domains must still exist and not have
+// expired, or a *VersionReferenceError lists what is broken and nothing is
+// activated. Only the domains of a Compute service are checked. The returned
+// Rollback reports what differs from the version which was active. Rolling
+// back to the active version does nothing.
+func (c *Client) RollbackToVersion(i *RollbackToVersionInput) (*Rollback, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	s, err := c.GetService(&GetServiceInput{ID: i.Service})
+	if err != nil {
+		return nil, err
+	}
+	r := &Rollback{From: int(s.ActiveVersion), To: i.Version}
+	if r.From == r.To {
+		return r, nil
+	}
+
+	v, err := c.GetVersion(&GetVersionInput{Service: i.Service, Version: i.Version})
+	if err != nil {
+		return nil, err
+	}
+	if v.DeletedAt != nil {
+		return nil, fmt.Errorf("version %d of service %s is deleted", i.Version, i.Service)
+	}
+
+	problems, err := c.versionReferenceProblems(i.Service, i.Version, s.Type)
+	if err != nil {
+		return nil, err
+	}
+	if len(problems) > 0 {
+		return nil, &VersionReferenceError{Service: i.Service, Version: i.Version, Problems: problems}
+	}
+
+	if r.From != 0 {
+		if r.Diff, err = c.GetDiff(&GetDiffInput{Service: i.Service, From: r.From, To: r.To}); err != nil {
+			return nil, err
+		}
+	}
+
+	if _, err := c.ActivateVersion(&ActivateVersionInput{Service: i.Service, Version: i.Version}); err != nil {
+		return nil, err
+	}
+
+	if i.Wait {
+		if _, err := c.WaitForVersionActive(&WaitForVersionActiveInput{Service: i.Service, Version: i.Version}); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// versionReferenceProblems returns the broken references of a version: the
+// dictionaries and ACLs its VCL, snippets and conditions use which it does
+// not define, its backends with expired certificates, and its domains whose
+// TLS certificates are gone or expired. A Compute service has no VCL, so only
+// its domains are checked.
+func (c *Client) versionReferenceProblems(service string, version int, t ServiceType) ([]string, error) {
+	now := time.Now()
+	if t == ServiceTypeWasm {
+		return c.domainTLSProblems(service, version, now)
+	}
+
+	problems, err := c.vclReferenceProblems(service, version)
+	if err != nil {
+		return nil, err
+	}
+
+	backends, err := c.ListBackends(&ListBackendsInput{Service: service, Version: version})
+	if err != nil {
+		return nil, err
+	}
+	for _, b := range backends {
+		for _, cert := range []struct{ field, pem string }{
+			{"ssl_ca_cert", b.SSLCACert},
+			{"ssl_client_cert", b.SSLClientCert},
+		} {
+			if p := expiredCertificateProblem(cert.pem, now); p != "" {
+				problems = append(problems, fmt.Sprintf("backend %q %s %s", b.Name, cert.field, p))
+			}
+		}
+	}
+
+	tlsProblems, err := c.domainTLSProblems(service, version, now)
+	if err != nil {
+		return nil, err
+	}
+	return append(problems, tlsProblems...), nil
+}
+
+var (
+	// vclDictionaryReference matches the dictionary of a table function call.
+	vclDictionaryReference = regexp.MustCompile(`\btable\.(?:lookup\w*|contains)\(\s*([A-Za-z0-9_]+)`)
+
+	// vclACLReference matches the ACL an address is matched against: the
+	// right of a "~" which is a name rather than a regular expression.
+	vclACLReference = regexp.MustCompile(`~\s*([A-Za-z_][A-Za-z0-9_]*)\b`)
+
+	// vclDeclaration matches the tables and ACLs declared in VCL itself.
+	vclDeclaration = regexp.MustCompile(`(?m)^\s*(table|acl)\s+([A-Za-z0-9_]+)`)
+)
+
+// vclReferenceProblems returns the dictionaries and ACLs used by the custom
+// VCL, snippets and conditions of a version which neither the version nor
+// its VCL defines. The content of dynamic snippets is not versioned, so it is
+// not checked.
+func (c *Client) vclReferenceProblems(service string, version int) ([]string, error) {
+	type source struct{ name, content string }
+	var sources []source
+
+	vcls, err := c.ListVCLs(&ListVCLsInput{Service: service, Version: version})
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range vcls {
+		sources = append(sources, source{fmt.Sprintf("VCL %q", v.Name), v.Content})
+	}
+
+	snippets, err := c.ListSnippets(&ListSnippetsInput{Service: service, Version: version})
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range snippets {
+		if !s.Dynamic {
+			sources = append(sources, source{fmt.Sprintf("snippet %q", s.Name), s.Content})
+		}
+	}
+
+	conditions, err := c.ListConditions(&ListConditionsInput{Service: service, Version: version})
+	if err != nil {
+		return nil, err
+	}
+	for _, cond := range conditions {
+		sources = append(sources, source{fmt.Sprintf("condition %q", cond.Name), cond.Statement})
+	}
+
+	defined := map[string]map[string]bool{"dictionary": {}, "ACL": {}}
+	dictionaries, err := c.ListDictionaries(&ListDictionariesInput{Service: service, Version: version})
+	if err != nil {
+		return nil, err
+	}
+	for _, d := range dictionaries {
+		defined["dictionary"][d.Name] = true
+	}
+	acls, err := c.ListACLs(&ListACLsInput{Service: service, Version: version})
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range acls {
+		defined["ACL"][a.Name] = true
+	}
+	for n := range sources {
+		sources[n].content = stripVCLLiterals(sources[n].content)
+		for _, m := range vclDeclaration.FindAllStringSubmatch(sources[n].content, -1) {
+			if m[1] == "table" {
+				defined["dictionary"][m[2]] = true
+			} else {
+				defined["ACL"][m[2]] = true
+			}
+		}
+	}
+
+	var problems []string
+	for _, src := range sources {
+		seen := make(map[string]bool)
+		for _, ref := range []struct {
+			kind string
+			re   *regexp.Regexp
+		}{
+			{"dictionary", vclDictionaryReference},
+			{"ACL", vclACLReference},
+		} {
+			for _, m := range ref.re.FindAllStringSubmatch(src.content, -1) {
+				name := m[1]
+				if defined[ref.kind][name] || seen[ref.kind+" "+name] {
+					continue
+				}
+				seen[ref.kind+" "+name] = true
+				problems = append(problems, fmt.Sprintf("%s %q used by %s is not defined", ref.kind, name, src.name))
+			}
+		}
+	}
+	return problems, nil
+}
+
+// stripVCLLiterals removes the comments of VCL source and empties its string
+// literals, such as the regular expression of `req.url ~ "~user"`, so that
+// only code is matched for references. Newlines are kept, so declarations
+// still start a line.
+func stripVCLLiterals(src string) string {
+	var b bytes.Buffer
+	for n := 0; n < len(src); {
+		rest := src[n:]
+		switch {
+		case strings.HasPrefix(rest, "/*"):
+			end := strings.Index(rest[2:], "*/")
+			if end < 0 {
+				return b.String()
+			}
+			b.WriteString(strings.Repeat("\n", strings.Count(rest[:end+2], "\n")))
+			n += end + 4
+		case rest[0] == '#', strings.HasPrefix(rest, "//"):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				return b.String()
+			}
+			n += end
+		case strings.HasPrefix(rest, `{"`):
+			end := strings.Index(rest[2:], `"}`)
+			if end < 0 {
+				return b.String()
+			}
+			b.WriteString(`""`)
+			n += end + 4
+		case rest[0] == '"':
+			end := strings.IndexAny(rest[1:], "\"\n")
+			if end < 0 {
+				return b.String()
+			}
+			b.WriteString(`""`)
+			n += end + 1
+			if src[n] == '"' {
+				n++
+			}
+		default:
+			b.WriteByte(rest[0])
+			n++
+		}
+	}
+	return b.String()
+}
+
+// domainTLSProblems returns the domains of a version with a TLS activation
+// whose certificate no longer exists or has expired at now. Domains without
+// an activation are not served over TLS by Fastly and are not checked.
+func (c *Client) domainTLSProblems(service string, version int, now time.Time) ([]string, error) {
+	domains, err := c.ListDomains(&ListDomainsInput{Service: service, Version: version})
+	if err != nil {
+		return nil, err
+	}
+
+	var problems []string
+	for _, d := range domains {
+		activations, err := c.ListTLSActivations(&ListTLSActivationsInput{FilterDomain: d.Name})
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range activations {
+			if a.Certificate == nil || a.Certificate.ID == "" {
+				continue
+			}
+			cert, err := c.GetCustomTLSCertificate(&GetCustomTLSCertificateInput{ID: a.Certificate.ID})
+			if isNotFound(err) {
+				problems = append(problems, fmt.Sprintf("domain %q TLS certificate %s no longer exists", d.Name, a.Certificate.ID))
+				continue
+			}
+			if err != nil {
+				return nil, err
+			}
+			notAfter, err := time.Parse(time.RFC3339, cert.NotAfter)
+			if err != nil {
+				return nil, err
+			}
+			if now.After(notAfter) {
+				problems = append(problems, fmt.Sprintf("domain %q TLS certificate %q expired at %s", d.Name, cert.Name, notAfter.Format(time.RFC3339)))
+			}
+		}
+	}
+	return problems, nil
+}
+
+// expiredCertificateProblem describes the first certificate in the PEM bundle
+// data which has expired at now, or returns "" if none has. Data which cannot
+// be parsed is left for the API to reject.
+func expiredCertificateProblem(data string, now time.Time) string {
+	rest := []byte(data)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return ""
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			continue
+		}
+		if now.After(cert.NotAfter) {
+			return fmt.Sprintf("certificate %q expired at %s", cert.Subject.CommonName, cert.NotAfter.Format(time.RFC3339))
+		}
+	}
+}
@@ -0,0 +1,124 @@
+package fastly
+
+import (
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestClient_RollbackToVersion(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var r *Rollback
+	record(t, "rollback/activate", func(c *Client) {
+		r, err = c.RollbackToVersion(&RollbackToVersionInput{
+			Service: testServiceID,
+			Version: 10,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.From != 12 || r.To != 10 {
+		t.Errorf("bad rollback: %#v", r)
+	}
+	if r.Diff == nil || !strings.Contains(r.Diff.Diff, "backend origin") {
+		t.Errorf("bad diff: %#v", r.Diff)
+	}
+}
+
+func TestClient_RollbackToVersion_brokenReferences(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	record(t, "rollback/broken", func(c *Client) {
+		_, err = c.RollbackToVersion(&RollbackToVersionInput{
+			Service: testServiceID,
+			Version: 9,
+		})
+	})
+	rerr, ok := err.(*VersionReferenceError)
+	if !ok {
+		t.Fatalf("expected a *VersionReferenceError, got %v", err)
+	}
+	if len(rerr.Problems) != 5 {
+		t.Fatalf("expected 5 problems, got %q", rerr.Problems)
+	}
+	for n, want := range []string{
+		`dictionary "legacy" used by VCL "main" is not defined`,
+		`ACL "blocklist" used by condition "is_blocked" is not defined`,
+		`backend "origin" ssl_ca_cert certificate "expired-ca.example.com" expired`,
+		`domain "shop.example.com" TLS certificate "shop.example.com" expired`,
+		`domain "www.example.com" TLS certificate gOnEuPo3ZT5xQHhmEhyQ2a no longer exists`,
+	} {
+		if !strings.Contains(rerr.Problems[n], want) {
+			t.Errorf("expected problem %d to contain %q, got %q", n, want, rerr.Problems[n])
+		}
+	}
+}
+
+func TestClient_RollbackToVersion_wasm(t *testing.T) {
+	t.Parallel()
+
+	// A Compute service has no VCL, snippets or conditions to check, so only
+	// its domains are looked up.
+	var err error
+	var r *Rollback
+	record(t, "rollback/wasm", func(c *Client) {
+		r, err = c.RollbackToVersion(&RollbackToVersionInput{
+			Service: testServiceID,
+			Version: 10,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.From != 12 || r.To != 10 {
+		t.Errorf("bad rollback: %#v", r)
+	}
+}
+
+func TestStripVCLLiterals(t *testing.T) {
+	cases := []struct {
+		src  string
+		refs []string
+	}{
+		{"set req.http.X = table.lookup(a, req.url); # table.lookup(gone, req.url)", []string{"a"}},
+		{"if (client.ip ~ office) { // client.ip ~ gone\n}", []string{"office"}},
+		{`if (req.http.User ~ "~user" || req.url ~ {"~/legacy"}) {}`, nil},
+		{"/* table.lookup(gone, req.url)\nclient.ip ~ gone */ table.lookup(b, \"http://x\")", []string{"b"}},
+	}
+	for _, tc := range cases {
+		stripped := stripVCLLiterals(tc.src)
+		var refs []string
+		for _, re := range []*regexp.Regexp{vclDictionaryReference, vclACLReference} {
+			for _, m := range re.FindAllStringSubmatch(stripped, -1) {
+				refs = append(refs, m[1])
+			}
+		}
+		if !reflect.DeepEqual(refs, tc.refs) {
+			t.Errorf("bad references in %q: %q", stripped, refs)
+		}
+	}
+
+	if s := stripVCLLiterals("/* a\nb */\ntable t {\n  \"k\": \"v\",\n}"); s != "\n\ntable t {\n  \"\": \"\",\n}" {
+		t.Errorf("expected newlines to be kept: %q", s)
+	}
+}
+
+func TestClient_RollbackToVersion_validation(t *testing.T) {
+	var err error
+	_, err = testClient.RollbackToVersion(&RollbackToVersionInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.RollbackToVersion(&RollbackToVersionInput{
+		Service: "foo",
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"net/url"
 	"sort"
 	"time"
 )
@@ -13,28 +14,51 @@ const (
 	S3RedundancyReduced  S3Redundancy = "reduced_redundancy"
 )
 
+type S3ServerSideEncryption string
+
+const (
+	S3ServerSideEncryptionAES S3ServerSideEncryption = "AES256"
+	S3ServerSideEncryptionKMS S3ServerSideEncryption = "aws:kms"
+)
+
 // S3 represents a S3 response from the Fastly API.
 type S3 struct {
 	ServiceID string `mapstructure:"service_id"`
 	Version   int    `mapstructure:"version"`
 
-	Name              string       `mapstructure:"name"`
-	BucketName        string       `mapstructure:"bucket_name"`
-	Domain            string       `mapstructure:"domain"`
-	AccessKey         string       `mapstructure:"access_key"`
-	SecretKey         string       `mapstructure:"secret_key"`
-	Path              string       `mapstructure:"path"`
-	Period            uint         `mapstructure:"period"`
-	GzipLevel         uint         `mapstructure:"gzip_level"`
-	Format            string       `mapstructure:"format"`
-	FormatVersion     uint         `mapstructure:"format_version"`
-	ResponseCondition string       `mapstructure:"response_condition"`
-	MessageType       string       `mapstructure:"message_type"`
-	TimestampFormat   string       `mapstructure:"timestamp_format"`
-	Redundancy        S3Redundancy `mapstructure:"redundancy"`
-	CreatedAt         *time.Time   `mapstructure:"created_at"`
-	UpdatedAt         *time.Time   `mapstructure:"updated_at"`
-	DeletedAt         *time.Time   `mapstructure:"deleted_at"`
+	Name                         string                 `mapstructure:"name"`
+	BucketName                   string                 `mapstructure:"bucket_name"`
+	Domain                       string                 `mapstructure:"domain"`
+	AccessKey                    string                 `mapstructure:"access_key"`
+	SecretKey                    string                 `mapstructure:"secret_key" sensitive:"true"`
+	IAMRole                      string                 `mapstructure:"iam_role"`
+	Path                         string                 `mapstructure:"path"`
+	Period                       uint                   `mapstructure:"period"`
+	GzipLevel                    uint                   `mapstructure:"gzip_level"`
+	FileMaxBytes                 uint                   `mapstructure:"file_max_bytes"`
+	CompressionCodec             CompressionCodec       `mapstructure:"compression_codec"`
+	Format                       string                 `mapstructure:"format"`
+	FormatVersion                uint                   `mapstructure:"format_version"`
+	ResponseCondition            string                 `mapstructure:"response_condition"`
+	MessageType                  string                 `mapstructure:"message_type"`
+	TimestampFormat              string                 `mapstructure:"timestamp_format"`
+	PublicKey                    string                 `mapstructure:"public_key"`
+	Redundancy                   S3Redundancy           `mapstructure:"redundancy"`
+	ServerSideEncryption         S3ServerSideEncryption `mapstructure:"server_side_encryption"`
+	ServerSideEncryptionKMSKeyID string                 `mapstructure:"server_side_encryption_kms_key_id"`
+	CreatedAt                    *time.Time             `mapstructure:"created_at"`
+	UpdatedAt                    *time.Time             `mapstructure:"updated_at"`
+	DeletedAt                    *time.Time             `mapstructure:"deleted_at"`
+}
+
+// String formats the S3 endpoint with its secret key redacted.
+func (s *S3) String() string {
+	return redactedString(s)
+}
+
+// GoString is like String, for the %#v verb.
+func (s *S3) GoString() string {
+	return redactedGoString(s)
 }
 
 // s3sByName is a sortable list of S3s.
@@ -87,21 +111,27 @@ type CreateS3Input struct {
 	Service string
 	Version int
 
-	Name              string       `form:"name,omitempty"`
-	BucketName        string       `form:"bucket_name,omitempty"`
-	Domain            string       `form:"domain,omitempty"`
-	AccessKey         string       `form:"access_key,omitempty"`
-	SecretKey         string       `form:"secret_key,omitempty"`
-	Path              string       `form:"path,omitempty"`
-	Period            uint         `form:"period,omitempty"`
-	GzipLevel         uint         `form:"gzip_level,omitempty"`
-	Format            string       `form:"format,omitempty"`
-	MessageType       string       `form:"message_type,omitempty"`
-	FormatVersion     uint         `form:"format_version,omitempty"`
-	ResponseCondition string       `form:"response_condition,omitempty"`
-	TimestampFormat   string       `form:"timestamp_format,omitempty"`
-	Redundancy        S3Redundancy `form:"redundancy,omitempty"`
-        Placement         string       `form:"placement,omitempty"`
+	Name                         string                 `form:"name,omitempty"`
+	BucketName                   string                 `form:"bucket_name,omitempty"`
+	Domain                       string                 `form:"domain,omitempty"`
+	AccessKey                    string                 `form:"access_key,omitempty"`
+	SecretKey                    string                 `form:"secret_key,omitempty" sensitive:"true"`
+	IAMRole                      string                 `form:"iam_role,omitempty"`
+	Path                         string                 `form:"path,omitempty"`
+	Period                       uint                   `form:"period,omitempty"`
+	GzipLevel                    uint                   `form:"gzip_level,omitempty"`
+	FileMaxBytes                 uint                   `form:"file_max_bytes,omitempty"`
+	CompressionCodec             CompressionCodec       `form:"compression_codec,omitempty"`
+	Format                       string                 `form:"format,omitempty"`
+	MessageType                  string                 `form:"message_type,omitempty"`
+	FormatVersion                uint                   `form:"format_version,omitempty"`
+	ResponseCondition            string                 `form:"response_condition,omitempty"`
+	TimestampFormat              string                 `form:"timestamp_format,omitempty"`
+	PublicKey                    string                 `form:"public_key,omitempty"`
+	Redundancy                   S3Redundancy           `form:"redundancy,omitempty"`
+	ServerSideEncryption         S3ServerSideEncryption `form:"server_side_encryption,omitempty"`
+	ServerSideEncryptionKMSKeyID string                 `form:"server_side_encryption_kms_key_id,omitempty"`
+	Placement                    string                 `form:"placement,omitempty"`
 }
 
 // CreateS3 creates a new Fastly S3.
@@ -114,8 +144,24 @@ func (c *Client) CreateS3(i *CreateS3Input) (*S3, error) {
 		return nil, ErrMissingVersion
 	}
 
+	if i.IAMRole != "" && (i.AccessKey != "" || i.SecretKey != "") {
+		return nil, ErrIAMRoleWithKeys
+	}
+
+	if i.CompressionCodec != "" && i.GzipLevel != 0 {
+		return nil, ErrCompressionCodecGzipLevel
+	}
+
+	if err := validateLoggingBatching(i.GzipLevel, i.FileMaxBytes); err != nil {
+		return nil, err
+	}
+
+	if i.ServerSideEncryption == S3ServerSideEncryptionKMS && i.ServerSideEncryptionKMSKeyID == "" {
+		return nil, ErrMissingKMSKeyID
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/logging/s3", i.Service, i.Version)
-	resp, err := c.PostForm(path, i, nil)
+	resp, err := c.PostForm(path, c.withLoggingDefaults(i), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -152,7 +198,7 @@ func (c *Client) GetS3(i *GetS3Input) (*S3, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/s3/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/logging/s3/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Get(path, nil)
 	if err != nil {
 		return nil, err
@@ -175,20 +221,26 @@ type UpdateS3Input struct {
 	// Name is the name of the S3 to update.
 	Name string
 
-	NewName           string       `form:"name,omitempty"`
-	BucketName        string       `form:"bucket_name,omitempty"`
-	Domain            string       `form:"domain,omitempty"`
-	AccessKey         string       `form:"access_key,omitempty"`
-	SecretKey         string       `form:"secret_key,omitempty"`
-	Path              string       `form:"path,omitempty"`
-	Period            uint         `form:"period,omitempty"`
-	GzipLevel         uint         `form:"gzip_level,omitempty"`
-	Format            string       `form:"format,omitempty"`
-	FormatVersion     uint         `form:"format_version,omitempty"`
-	ResponseCondition string       `form:"response_condition,omitempty"`
-	MessageType       string       `form:"message_type,omitempty"`
-	TimestampFormat   string       `form:"timestamp_format,omitempty"`
-	Redundancy        S3Redundancy `form:"redundancy,omitempty"`
+	NewName                      string                 `form:"name,omitempty"`
+	BucketName                   string                 `form:"bucket_name,omitempty"`
+	Domain                       string                 `form:"domain,omitempty"`
+	AccessKey                    string                 `form:"access_key,omitempty"`
+	SecretKey                    string                 `form:"secret_key,omitempty" sensitive:"true"`
+	IAMRole                      string                 `form:"iam_role,omitempty"`
+	Path                         string                 `form:"path,omitempty"`
+	Period                       uint                   `form:"period,omitempty"`
+	GzipLevel                    uint                   `form:"gzip_level,omitempty"`
+	FileMaxBytes                 uint                   `form:"file_max_bytes,omitempty"`
+	CompressionCodec             CompressionCodec       `form:"compression_codec,omitempty"`
+	Format                       string                 `form:"format,omitempty"`
+	FormatVersion                uint                   `form:"format_version,omitempty"`
+	ResponseCondition            string                 `form:"response_condition,omitempty"`
+	MessageType                  string                 `form:"message_type,omitempty"`
+	TimestampFormat              string                 `form:"timestamp_format,omitempty"`
+	PublicKey                    string                 `form:"public_key,omitempty"`
+	Redundancy                   S3Redundancy           `form:"redundancy,omitempty"`
+	ServerSideEncryption         S3ServerSideEncryption `form:"server_side_encryption,omitempty"`
+	ServerSideEncryptionKMSKeyID string                 `form:"server_side_encryption_kms_key_id,omitempty"`
 }
 
 // UpdateS3 updates a specific S3.
@@ -205,7 +257,23 @@ func (c *Client) UpdateS3(i *UpdateS3Input) (*S3, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/s3/%s", i.Service, i.Version, i.Name)
+	if i.IAMRole != "" && (i.AccessKey != "" || i.SecretKey != "") {
+		return nil, ErrIAMRoleWithKeys
+	}
+
+	if i.CompressionCodec != "" && i.GzipLevel != 0 {
+		return nil, ErrCompressionCodecGzipLevel
+	}
+
+	if err := validateLoggingBatching(i.GzipLevel, i.FileMaxBytes); err != nil {
+		return nil, err
+	}
+
+	if i.ServerSideEncryption == S3ServerSideEncryptionKMS && i.ServerSideEncryptionKMSKeyID == "" {
+		return nil, ErrMissingKMSKeyID
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/logging/s3/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
 		return nil, err
@@ -243,7 +311,7 @@ func (c *Client) DeleteS3(i *DeleteS3Input) error {
 		return ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/s3/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/logging/s3/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Delete(path, nil)
 	if err != nil {
 		return err
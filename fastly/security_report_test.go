@@ -0,0 +1,61 @@
+package fastly
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClient_GetSecurityReport(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var r *SecurityReport
+	record(t, "users/security_report", func(c *Client) {
+		r, err = c.GetSecurityReport(&GetSecurityReportInput{
+			CustomerID: "zwBncFVs2Ixrhd8xxxxxx",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Users) != 3 {
+		t.Fatalf("bad users: %v", r.Users)
+	}
+	if r.WithoutTwoFactor != 1 {
+		t.Errorf("bad without_two_factor: %d", r.WithoutTwoFactor)
+	}
+	if r.Locked != 1 {
+		t.Errorf("bad locked: %d", r.Locked)
+	}
+
+	admin := r.Users[0]
+	if admin.User.Login != "admin@example.com" || !admin.TwoFactorEnabled {
+		t.Errorf("bad user: %#v", admin)
+	}
+	if admin.LastLogin == nil || !admin.LastLogin.Equal(time.Date(2020, 5, 4, 9, 0, 0, 0, time.UTC)) {
+		t.Errorf("bad last_login: %v", admin.LastLogin)
+	}
+	if admin.Tokens != 2 {
+		t.Errorf("bad tokens: %d", admin.Tokens)
+	}
+	if admin.LastTokenUse == nil || !admin.LastTokenUse.Equal(time.Date(2020, 5, 4, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("bad last_token_use: %v", admin.LastTokenUse)
+	}
+
+	former := r.Users[1]
+	if former.User.Login != "former@example.com" || former.LastLogin != nil {
+		t.Errorf("bad user: %#v", former)
+	}
+
+	ops := r.Users[2]
+	if ops.TwoFactorEnabled || ops.Tokens != 1 || ops.LastTokenUse != nil {
+		t.Errorf("bad user: %#v", ops)
+	}
+}
+
+func TestClient_GetSecurityReport_validation(t *testing.T) {
+	_, err := testClient.GetSecurityReport(&GetSecurityReportInput{})
+	if err != ErrMissingCustomerID {
+		t.Errorf("bad error: %s", err)
+	}
+}
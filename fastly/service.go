@@ -3,29 +3,44 @@ package fastly
 import (
 	"fmt"
 	"sort"
+	"strings"
+	"time"
+)
+
+// ServiceType is the kind of a service.
+type ServiceType string
+
+const (
+	// ServiceTypeVCL is a service configured with VCL.
+	ServiceTypeVCL ServiceType = "vcl"
+
+	// ServiceTypeWasm is a Compute service running a WebAssembly package.
+	ServiceTypeWasm ServiceType = "wasm"
 )
 
 // Service represents a single service for the Fastly account.
 type Service struct {
-	ID            string     `mapstructure:"id"`
-	Name          string     `mapstructure:"name"`
-	Comment       string     `mapstructure:"comment"`
-	CustomerID    string     `mapstructure:"customer_id"`
-	CreatedAt     string     `mapstructure:"created_at"`
-	UpdatedAt     string     `mapstructure:"updated_at"`
-	DeletedAt     string     `mapstructure:"deleted_at"`
-	ActiveVersion uint       `mapstructure:"version"`
-	Versions      []*Version `mapstructure:"versions"`
+	ID            string      `mapstructure:"id"`
+	Name          string      `mapstructure:"name"`
+	Type          ServiceType `mapstructure:"type"`
+	Comment       string      `mapstructure:"comment"`
+	CustomerID    string      `mapstructure:"customer_id"`
+	CreatedAt     string      `mapstructure:"created_at"`
+	UpdatedAt     string      `mapstructure:"updated_at"`
+	DeletedAt     *time.Time  `mapstructure:"deleted_at"`
+	ActiveVersion uint        `mapstructure:"version"`
+	Versions      []*Version  `mapstructure:"versions"`
 }
 
 type ServiceDetail struct {
-	ID            string     `mapstructure:"id"`
-	Name          string     `mapstructure:"name"`
-	Comment       string     `mapstructure:"comment"`
-	CustomerID    string     `mapstructure:"customer_id"`
-	ActiveVersion Version    `mapstructure:"active_version"`
-	Version       Version    `mapstructure:"version"`
-	Versions      []*Version `mapstructure:"versions"`
+	ID            string      `mapstructure:"id"`
+	Name          string      `mapstructure:"name"`
+	Type          ServiceType `mapstructure:"type"`
+	Comment       string      `mapstructure:"comment"`
+	CustomerID    string      `mapstructure:"customer_id"`
+	ActiveVersion Version     `mapstructure:"active_version"`
+	Version       Version     `mapstructure:"version"`
+	Versions      []*Version  `mapstructure:"versions"`
 }
 
 // servicesByName is a sortable list of services.
@@ -39,11 +54,57 @@ func (s servicesByName) Less(i, j int) bool {
 }
 
 // ListServicesInput is used as input to the ListServices function.
-type ListServicesInput struct{}
+type ListServicesInput struct {
+	// Page and PerPage select a single page of results. When neither is set,
+	// the API returns every service in one response.
+	Page    int
+	PerPage int
+
+	// Sort is the field to sort by on the server, such as "created", and
+	// Direction is DirectionAscend or DirectionDescend. When Sort is not set,
+	// services are sorted by name locally.
+	Sort      string
+	Direction string
+
+	// NamePrefix, CustomerID and Type limit the returned services to those
+	// whose name starts with the prefix, which belong to the customer, or
+	// which are of the type. They are applied to each page of results.
+	// Optional.
+	NamePrefix string
+	CustomerID string
+	Type       ServiceType
+
+	// IncludeDeleted keeps services the API returns with a DeletedAt time. By
+	// default they are left out.
+	IncludeDeleted bool
+
+	// Starred limits the returned services to those the current user has
+	// starred with CreateStar. Optional.
+	Starred bool
+}
 
-// ListServices returns the full list of services for the current account.
+// ListServices returns the list of services for the current account. A nil
+// input lists every service.
 func (c *Client) ListServices(i *ListServicesInput) ([]*Service, error) {
-	resp, err := c.Get("/service", nil)
+	if i == nil {
+		i = &ListServicesInput{}
+	}
+
+	var starred map[string]bool
+	if i.Starred {
+		stars, err := c.ListStars()
+		if err != nil {
+			return nil, err
+		}
+		starred = make(map[string]bool, len(stars))
+		for _, st := range stars {
+			starred[st.ServiceID] = true
+		}
+	}
+
+	resp, err := c.Get("/service", &RequestOptions{
+		Params: paginationParams(i.Page, i.PerPage, i.Sort, i.Direction),
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -52,14 +113,45 @@ func (c *Client) ListServices(i *ListServicesInput) ([]*Service, error) {
 	if err := decodeJSON(&s, resp.Body); err != nil {
 		return nil, err
 	}
-	sort.Stable(servicesByName(s))
+
+	for _, svc := range s {
+		c.setServiceType(svc.ID, svc.Type)
+	}
+
+	if i.NamePrefix != "" || i.CustomerID != "" || i.Type != "" || !i.IncludeDeleted || i.Starred {
+		filtered := s[:0]
+		for _, svc := range s {
+			if svc.DeletedAt != nil && !i.IncludeDeleted {
+				continue
+			}
+			if !strings.HasPrefix(svc.Name, i.NamePrefix) {
+				continue
+			}
+			if i.CustomerID != "" && svc.CustomerID != i.CustomerID {
+				continue
+			}
+			if i.Type != "" && svc.Type != i.Type {
+				continue
+			}
+			if i.Starred && !starred[svc.ID] {
+				continue
+			}
+			filtered = append(filtered, svc)
+		}
+		s = filtered
+	}
+
+	if i.Sort == "" {
+		sort.Stable(servicesByName(s))
+	}
 	return s, nil
 }
 
 // CreateServiceInput is used as input to the CreateService function.
 type CreateServiceInput struct {
-	Name    string `form:"name,omitempty"`
-	Comment string `form:"comment,omitempty"`
+	Name    string      `form:"name,omitempty"`
+	Type    ServiceType `form:"type,omitempty"`
+	Comment string      `form:"comment,omitempty"`
 }
 
 // CreateService creates a new service with the given information.
@@ -73,6 +165,7 @@ func (c *Client) CreateService(i *CreateServiceInput) (*Service, error) {
 	if err := decodeJSON(&s, resp.Body); err != nil {
 		return nil, err
 	}
+	c.setServiceType(s.ID, s.Type)
 	return s, nil
 }
 
@@ -99,6 +192,7 @@ func (c *Client) GetService(i *GetServiceInput) (*Service, error) {
 	if err := decodeJSON(&s, resp.Body); err != nil {
 		return nil, err
 	}
+	c.setServiceType(s.ID, s.Type)
 
 	return s, nil
 }
@@ -120,6 +214,7 @@ func (c *Client) GetServiceDetails(i *GetServiceInput) (*ServiceDetail, error) {
 	if err := decodeJSON(&s, resp.Body); err != nil {
 		return nil, err
 	}
+	c.setServiceType(s.ID, s.Type)
 
 	return s, nil
 }
@@ -148,6 +243,7 @@ func (c *Client) UpdateService(i *UpdateServiceInput) (*Service, error) {
 	if err := decodeJSON(&s, resp.Body); err != nil {
 		return nil, err
 	}
+	c.setServiceType(s.ID, s.Type)
 	return s, nil
 }
 
@@ -167,6 +263,7 @@ func (c *Client) DeleteService(i *DeleteServiceInput) error {
 	if err != nil {
 		return err
 	}
+	c.VersionCache.Invalidate(i.ID)
 
 	var r *statusResp
 	if err := decodeJSON(&r, resp.Body); err != nil {
@@ -178,6 +275,46 @@ func (c *Client) DeleteService(i *DeleteServiceInput) error {
 	return nil
 }
 
+// DeleteServiceSafelyInput is used as input to the DeleteServiceSafely
+// function.
+type DeleteServiceSafelyInput struct {
+	ID string
+
+	// Force deactivates the service's active version, if any, before deleting
+	// the service. Without it, a service with an active version is not deleted.
+	Force bool
+}
+
+// DeleteServiceSafely deletes the service with the given input, but refuses
+// to delete a service that is still serving traffic. If the service has an
+// active version, ErrServiceHasActiveVersion is returned unless Force is set,
+// in which case the version is deactivated first.
+func (c *Client) DeleteServiceSafely(i *DeleteServiceSafelyInput) error {
+	if i.ID == "" {
+		return ErrMissingID
+	}
+
+	s, err := c.GetService(&GetServiceInput{ID: i.ID})
+	if err != nil {
+		return err
+	}
+
+	if s.ActiveVersion != 0 {
+		if !i.Force {
+			return ErrServiceHasActiveVersion
+		}
+
+		if _, err := c.DeactivateVersion(&DeactivateVersionInput{
+			Service: i.ID,
+			Version: int(s.ActiveVersion),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return c.DeleteService(&DeleteServiceInput{ID: i.ID})
+}
+
 // SearchServiceInput is used as input to the SearchService function.
 type SearchServiceInput struct {
 	Name string
@@ -203,6 +340,7 @@ func (c *Client) SearchService(i *SearchServiceInput) (*Service, error) {
 	if err := decodeJSON(&s, resp.Body); err != nil {
 		return nil, err
 	}
+	c.setServiceType(s.ID, s.Type)
 
 	return s, nil
 }
@@ -0,0 +1,212 @@
+package fastly
+
+import (
+	"strings"
+	"time"
+)
+
+// serviceAccessRanks orders the service authorization permissions, from the
+// least to the most access.
+var serviceAccessRanks = map[string]int{
+	ServiceAuthorizationPermissionReadOnly:    1,
+	ServiceAuthorizationPermissionPurgeSelect: 2,
+	ServiceAuthorizationPermissionPurgeAll:    3,
+	ServiceAuthorizationPermissionFull:        4,
+}
+
+// tokenScopePermissions are the service permissions granted by each token
+// scope.
+var tokenScopePermissions = map[string]string{
+	"global":       ServiceAuthorizationPermissionFull,
+	"purge_all":    ServiceAuthorizationPermissionPurgeAll,
+	"purge_select": ServiceAuthorizationPermissionPurgeSelect,
+	"global:read":  ServiceAuthorizationPermissionReadOnly,
+}
+
+// ServiceAccess is the access matrix of a single service: the users and the
+// API tokens which can act on it, and with which permission.
+type ServiceAccess struct {
+	ServiceID   string
+	ServiceName string
+	Users       []*ServiceUserAccess
+	Tokens      []*ServiceTokenAccess
+}
+
+// ServiceUserAccess is the access a user has to a service.
+type ServiceUserAccess struct {
+	User *User
+
+	// Permission is one of the ServiceAuthorizationPermission values.
+	Permission string
+
+	// Authorization is the service authorization the permission comes from,
+	// or nil if it comes from the user's role.
+	Authorization *ServiceAuthorization
+}
+
+// CanModify reports whether the user can change and activate the service.
+func (a *ServiceUserAccess) CanModify() bool {
+	return a.Permission == ServiceAuthorizationPermissionFull
+}
+
+// ServiceTokenAccess is the access an API token has to a service: the lesser
+// of its scope and the access of the user it belongs to.
+type ServiceTokenAccess struct {
+	Token *Token
+	User  *User
+
+	// Permission is one of the ServiceAuthorizationPermission values.
+	Permission string
+}
+
+// CanModify reports whether the token can change and activate the service.
+func (a *ServiceTokenAccess) CanModify() bool {
+	return a.Permission == ServiceAuthorizationPermissionFull
+}
+
+// GetServiceAccessReportInput is used as input to the GetServiceAccessReport
+// function.
+type GetServiceAccessReportInput struct {
+	// CustomerID is the ID of the customer (required).
+	CustomerID string
+
+	// ServiceIDs limits the report to the given services. Optional; by
+	// default every service of the account is reported.
+	ServiceIDs []string
+}
+
+// GetServiceAccessReport joins the account's users, API tokens and service
+// authorizations into the access matrix of each service, sorted by service
+// name, to answer who and what can change a service in a compliance review.
+// A user's access comes from their role unless their services are limited,
+// in which case it comes from their service authorizations. Expired tokens,
+// and tokens whose user is not listed, are left out. The report reflects the
+// permissions documented by the API; it is assembled from four requests, so
+// changes made while it runs may not be seen.
+func (c *Client) GetServiceAccessReport(i *GetServiceAccessReportInput) ([]*ServiceAccess, error) {
+	if i.CustomerID == "" {
+		return nil, ErrMissingCustomerID
+	}
+
+	services, err := c.ListServices(&ListServicesInput{CustomerID: i.CustomerID})
+	if err != nil {
+		return nil, err
+	}
+	users, err := c.ListCustomerUsers(&ListCustomerUsersInput{CustomerID: i.CustomerID})
+	if err != nil {
+		return nil, err
+	}
+	tokens, err := c.ListCustomerTokens(&ListCustomerTokensInput{CustomerID: i.CustomerID})
+	if err != nil {
+		return nil, err
+	}
+	auths, err := c.ListServiceAuthorizations(&ListServiceAuthorizationsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	wanted := make(map[string]bool, len(i.ServiceIDs))
+	for _, id := range i.ServiceIDs {
+		wanted[id] = true
+	}
+
+	// authorizations is keyed by user ID, then by service ID.
+	authorizations := make(map[string]map[string]*ServiceAuthorization)
+	for _, a := range auths {
+		if a.User == nil || a.Service == nil {
+			continue
+		}
+		if authorizations[a.User.ID] == nil {
+			authorizations[a.User.ID] = make(map[string]*ServiceAuthorization)
+		}
+		authorizations[a.User.ID][a.Service.ID] = a
+	}
+
+	now := time.Now()
+	var report []*ServiceAccess
+	for _, s := range services {
+		if len(wanted) > 0 && !wanted[s.ID] {
+			continue
+		}
+
+		access := &ServiceAccess{ServiceID: s.ID, ServiceName: s.Name}
+		byUser := make(map[string]*ServiceUserAccess)
+		for _, u := range users {
+			ua := userServiceAccess(u, authorizations[u.ID][s.ID])
+			if ua == nil {
+				continue
+			}
+			access.Users = append(access.Users, ua)
+			byUser[u.ID] = ua
+		}
+
+		for _, t := range tokens {
+			ua := byUser[t.UserID]
+			if ua == nil || (t.ExpiresAt != nil && !t.ExpiresAt.After(now)) {
+				continue
+			}
+			if len(t.Services) > 0 && !containsString(t.Services, s.ID) {
+				continue
+			}
+			if p := lesserPermission(ua.Permission, tokenPermission(t)); p != "" {
+				access.Tokens = append(access.Tokens, &ServiceTokenAccess{Token: t, User: ua.User, Permission: p})
+			}
+		}
+		report = append(report, access)
+	}
+	return report, nil
+}
+
+// userServiceAccess returns the access of the user u to a service, given the
+// user's authorization for it, which may be nil. It returns nil if the user
+// has no access.
+func userServiceAccess(u *User, auth *ServiceAuthorization) *ServiceUserAccess {
+	if u.LimitServices && u.Role != UserRoleSuperuser {
+		if auth == nil || serviceAccessRanks[auth.Permission] == 0 {
+			return nil
+		}
+		return &ServiceUserAccess{User: u, Permission: auth.Permission, Authorization: auth}
+	}
+
+	switch u.Role {
+	case UserRoleSuperuser, UserRoleEngineer:
+		return &ServiceUserAccess{User: u, Permission: ServiceAuthorizationPermissionFull}
+	case UserRoleUser, UserRoleBilling:
+		return &ServiceUserAccess{User: u, Permission: ServiceAuthorizationPermissionReadOnly}
+	}
+	return nil
+}
+
+// tokenPermission returns the most access granted by the scopes of the token
+// t, or "" if they grant none.
+func tokenPermission(t *Token) string {
+	var p string
+	for _, scope := range strings.Fields(t.Scope) {
+		if sp := tokenScopePermissions[scope]; serviceAccessRanks[sp] > serviceAccessRanks[p] {
+			p = sp
+		}
+	}
+	return p
+}
+
+// lesserPermission returns whichever of the permissions a and b grants less
+// access, or "" if either grants none.
+func lesserPermission(a, b string) string {
+	if serviceAccessRanks[a] == 0 || serviceAccessRanks[b] == 0 {
+		return ""
+	}
+	if serviceAccessRanks[a] < serviceAccessRanks[b] {
+		return a
+	}
+	return b
+}
+
+// containsString reports whether s is one of the strings in l.
+func containsString(l []string, s string) bool {
+	for _, v := range l {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,65 @@
+package fastly
+
+import "testing"
+
+func TestClient_GetServiceAccessReport(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var report []*ServiceAccess
+	record(t, "service_access/report", func(c *Client) {
+		report, err = c.GetServiceAccessReport(&GetServiceAccessReportInput{
+			CustomerID: "x4xCwxxJxGCx123Rx5xTx",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report) != 2 || report[0].ServiceName != "api" || report[1].ServiceName != "www" {
+		t.Fatalf("bad report: %#v", report)
+	}
+
+	users := func(a *ServiceAccess) map[string]string {
+		m := make(map[string]string)
+		for _, u := range a.Users {
+			m[u.User.ID] = u.Permission
+		}
+		return m
+	}
+	tokens := func(a *ServiceAccess) map[string]string {
+		m := make(map[string]string)
+		for _, t := range a.Tokens {
+			m[t.Token.ID] = t.Permission
+		}
+		return m
+	}
+
+	// The engineer is limited to the api service, and the purger to www.
+	api, www := report[0], report[1]
+	if u := users(api); len(u) != 3 || u["uAdmin"] != "full" || u["uEng"] != "full" || u["uView"] != "read_only" {
+		t.Errorf("bad api users: %v", u)
+	}
+	if u := users(www); len(u) != 3 || u["uAdmin"] != "full" || u["uPurge"] != "purge_select" || u["uView"] != "read_only" {
+		t.Errorf("bad www users: %v", u)
+	}
+	if a := api.Users[1]; a.User.ID != "uEng" || a.Authorization == nil || !a.CanModify() {
+		t.Errorf("bad engineer access: %#v", a)
+	}
+
+	// The expired token is left out, and the deploy token only reaches the
+	// services its user can.
+	if tk := tokens(api); len(tk) != 2 || tk["tDeploy"] != "full" || tk["tRead"] != "read_only" {
+		t.Errorf("bad api tokens: %v", tk)
+	}
+	if tk := tokens(www); len(tk) != 2 || tk["tPurge"] != "purge_select" || tk["tRead"] != "read_only" {
+		t.Errorf("bad www tokens: %v", tk)
+	}
+}
+
+func TestClient_GetServiceAccessReport_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetServiceAccessReport(&GetServiceAccessReportInput{})
+	if err != ErrMissingCustomerID {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -0,0 +1,81 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+const (
+	// ServiceAuthorizationPermissionFull can change, activate and purge the
+	// service.
+	ServiceAuthorizationPermissionFull = "full"
+
+	// ServiceAuthorizationPermissionReadOnly can only view the service.
+	ServiceAuthorizationPermissionReadOnly = "read_only"
+
+	// ServiceAuthorizationPermissionPurgeSelect can view the service and
+	// purge it by URL or surrogate key.
+	ServiceAuthorizationPermissionPurgeSelect = "purge_select"
+
+	// ServiceAuthorizationPermissionPurgeAll can view the service and purge
+	// all of it.
+	ServiceAuthorizationPermissionPurgeAll = "purge_all"
+)
+
+// ServiceAuthorization grants a user whose services are limited access to a
+// single service, with one of the ServiceAuthorizationPermission values.
+type ServiceAuthorization struct {
+	ID         string                       `jsonapi:"primary,service_authorization"`
+	Permission string                       `jsonapi:"attr,permission,omitempty"`
+	CreatedAt  string                       `jsonapi:"attr,created_at,omitempty"`
+	UpdatedAt  string                       `jsonapi:"attr,updated_at,omitempty"`
+	User       *ServiceAuthorizationUser    `jsonapi:"relation,user,omitempty"`
+	Service    *ServiceAuthorizationService `jsonapi:"relation,service,omitempty"`
+}
+
+// ServiceAuthorizationUser is the user a service authorization is for.
+type ServiceAuthorizationUser struct {
+	ID string `jsonapi:"primary,user"`
+}
+
+// ServiceAuthorizationService is the service a service authorization is for.
+type ServiceAuthorizationService struct {
+	ID string `jsonapi:"primary,service"`
+}
+
+// serviceAuthorizationType is used for reflection because JSONAPI wants to
+// know what it's decoding into.
+var serviceAuthorizationType = reflect.TypeOf(new(ServiceAuthorization))
+
+// ListServiceAuthorizationsInput is used as input to the
+// ListServiceAuthorizations function.
+type ListServiceAuthorizationsInput struct {
+	// MaxResults is the number of authorizations to return per request.
+	// Optional.
+	MaxResults int
+}
+
+// ListServiceAuthorizations returns every service authorization for the
+// account, following every page of results.
+func (c *Client) ListServiceAuthorizations(i *ListServiceAuthorizationsInput) ([]*ServiceAuthorization, error) {
+	params := map[string]string{}
+	if i.MaxResults != 0 {
+		params["page[size]"] = strconv.Itoa(i.MaxResults)
+	}
+
+	data, err := c.getAllJSONAPIPages("/service-authorizations", params, serviceAuthorizationType)
+	if err != nil {
+		return nil, err
+	}
+
+	auths := make([]*ServiceAuthorization, len(data))
+	for i := range data {
+		typed, ok := data[i].(*ServiceAuthorization)
+		if !ok {
+			return nil, fmt.Errorf("got back a non-ServiceAuthorization response")
+		}
+		auths[i] = typed
+	}
+	return auths, nil
+}
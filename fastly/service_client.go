@@ -0,0 +1,665 @@
+package fastly
+
+// ServiceClient is a handle on a single service, returned by Client.Service.
+// Its methods take the same inputs as the Client methods of the same name, and
+// fill in Service, and Version when the handle is pinned to a version and the
+// input has none. Inputs are copied, not modified.
+type ServiceClient struct {
+	client  *Client
+	id      string
+	version int
+}
+
+// Service returns a handle on the service with the given ID, for code which
+// manages a single service.
+func (c *Client) Service(id string) *ServiceClient {
+	return &ServiceClient{client: c, id: id}
+}
+
+// AtVersion returns a copy of the handle pinned to a version of the service.
+func (s *ServiceClient) AtVersion(version int) *ServiceClient {
+	return &ServiceClient{client: s.client, id: s.id, version: version}
+}
+
+// ID returns the ID of the service.
+func (s *ServiceClient) ID() string {
+	return s.id
+}
+
+// Version returns the version the handle is pinned to, or 0.
+func (s *ServiceClient) Version() int {
+	return s.version
+}
+
+// Client returns the client the handle was created from.
+func (s *ServiceClient) Client() *Client {
+	return s.client
+}
+
+// ListVersions calls Client.ListVersions for the service.
+func (s *ServiceClient) ListVersions(i *ListVersionsInput) ([]*Version, error) {
+	in := *i
+	in.Service = s.id
+	return s.client.ListVersions(&in)
+}
+
+// LatestVersion calls Client.LatestVersion for the service.
+func (s *ServiceClient) LatestVersion(i *LatestVersionInput) (*Version, error) {
+	in := *i
+	in.Service = s.id
+	return s.client.LatestVersion(&in)
+}
+
+// ActiveVersion calls Client.ActiveVersion for the service.
+func (s *ServiceClient) ActiveVersion(i *ActiveVersionInput) (*Version, error) {
+	in := *i
+	in.Service = s.id
+	return s.client.ActiveVersion(&in)
+}
+
+// CreateVersion calls Client.CreateVersion for the service.
+func (s *ServiceClient) CreateVersion(i *CreateVersionInput) (*Version, error) {
+	in := *i
+	in.Service = s.id
+	return s.client.CreateVersion(&in)
+}
+
+// GetVersion calls Client.GetVersion for the service.
+func (s *ServiceClient) GetVersion(i *GetVersionInput) (*Version, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.GetVersion(&in)
+}
+
+// UpdateVersion calls Client.UpdateVersion for the service.
+func (s *ServiceClient) UpdateVersion(i *UpdateVersionInput) (*Version, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.UpdateVersion(&in)
+}
+
+// ActivateVersion calls Client.ActivateVersion for the service.
+func (s *ServiceClient) ActivateVersion(i *ActivateVersionInput) (*Version, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.ActivateVersion(&in)
+}
+
+// DeactivateVersion calls Client.DeactivateVersion for the service.
+func (s *ServiceClient) DeactivateVersion(i *DeactivateVersionInput) (*Version, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.DeactivateVersion(&in)
+}
+
+// WaitForVersionActive calls Client.WaitForVersionActive for the service.
+func (s *ServiceClient) WaitForVersionActive(i *WaitForVersionActiveInput) (*Service, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.WaitForVersionActive(&in)
+}
+
+// CloneVersion calls Client.CloneVersion for the service.
+func (s *ServiceClient) CloneVersion(i *CloneVersionInput) (*Version, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.CloneVersion(&in)
+}
+
+// ValidateVersion calls Client.ValidateVersion for the service.
+func (s *ServiceClient) ValidateVersion(i *ValidateVersionInput) (bool, string, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.ValidateVersion(&in)
+}
+
+// LockVersion calls Client.LockVersion for the service.
+func (s *ServiceClient) LockVersion(i *LockVersionInput) (*Version, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.LockVersion(&in)
+}
+
+// GetVersionDetail calls Client.GetVersionDetail for the service.
+func (s *ServiceClient) GetVersionDetail(i *GetVersionDetailInput) (*VersionDetail, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.GetVersionDetail(&in)
+}
+
+// ListBackends calls Client.ListBackends for the service.
+func (s *ServiceClient) ListBackends(i *ListBackendsInput) ([]*Backend, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.ListBackends(&in)
+}
+
+// CreateBackend calls Client.CreateBackend for the service.
+func (s *ServiceClient) CreateBackend(i *CreateBackendInput) (*Backend, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.CreateBackend(&in)
+}
+
+// GetBackend calls Client.GetBackend for the service.
+func (s *ServiceClient) GetBackend(i *GetBackendInput) (*Backend, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.GetBackend(&in)
+}
+
+// UpdateBackend calls Client.UpdateBackend for the service.
+func (s *ServiceClient) UpdateBackend(i *UpdateBackendInput) (*Backend, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.UpdateBackend(&in)
+}
+
+// DeleteBackend calls Client.DeleteBackend for the service.
+func (s *ServiceClient) DeleteBackend(i *DeleteBackendInput) error {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.DeleteBackend(&in)
+}
+
+// ListDomains calls Client.ListDomains for the service.
+func (s *ServiceClient) ListDomains(i *ListDomainsInput) ([]*Domain, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.ListDomains(&in)
+}
+
+// CreateDomain calls Client.CreateDomain for the service.
+func (s *ServiceClient) CreateDomain(i *CreateDomainInput) (*Domain, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.CreateDomain(&in)
+}
+
+// GetDomain calls Client.GetDomain for the service.
+func (s *ServiceClient) GetDomain(i *GetDomainInput) (*Domain, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.GetDomain(&in)
+}
+
+// UpdateDomain calls Client.UpdateDomain for the service.
+func (s *ServiceClient) UpdateDomain(i *UpdateDomainInput) (*Domain, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.UpdateDomain(&in)
+}
+
+// DeleteDomain calls Client.DeleteDomain for the service.
+func (s *ServiceClient) DeleteDomain(i *DeleteDomainInput) error {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.DeleteDomain(&in)
+}
+
+// ListConditions calls Client.ListConditions for the service.
+func (s *ServiceClient) ListConditions(i *ListConditionsInput) ([]*Condition, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.ListConditions(&in)
+}
+
+// CreateCondition calls Client.CreateCondition for the service.
+func (s *ServiceClient) CreateCondition(i *CreateConditionInput) (*Condition, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.CreateCondition(&in)
+}
+
+// GetCondition calls Client.GetCondition for the service.
+func (s *ServiceClient) GetCondition(i *GetConditionInput) (*Condition, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.GetCondition(&in)
+}
+
+// UpdateCondition calls Client.UpdateCondition for the service.
+func (s *ServiceClient) UpdateCondition(i *UpdateConditionInput) (*Condition, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.UpdateCondition(&in)
+}
+
+// DeleteCondition calls Client.DeleteCondition for the service.
+func (s *ServiceClient) DeleteCondition(i *DeleteConditionInput) error {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.DeleteCondition(&in)
+}
+
+// ListHeaders calls Client.ListHeaders for the service.
+func (s *ServiceClient) ListHeaders(i *ListHeadersInput) ([]*Header, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.ListHeaders(&in)
+}
+
+// CreateHeader calls Client.CreateHeader for the service.
+func (s *ServiceClient) CreateHeader(i *CreateHeaderInput) (*Header, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.CreateHeader(&in)
+}
+
+// GetHeader calls Client.GetHeader for the service.
+func (s *ServiceClient) GetHeader(i *GetHeaderInput) (*Header, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.GetHeader(&in)
+}
+
+// UpdateHeader calls Client.UpdateHeader for the service.
+func (s *ServiceClient) UpdateHeader(i *UpdateHeaderInput) (*Header, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.UpdateHeader(&in)
+}
+
+// DeleteHeader calls Client.DeleteHeader for the service.
+func (s *ServiceClient) DeleteHeader(i *DeleteHeaderInput) error {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.DeleteHeader(&in)
+}
+
+// ListSnippets calls Client.ListSnippets for the service.
+func (s *ServiceClient) ListSnippets(i *ListSnippetsInput) ([]*Snippet, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.ListSnippets(&in)
+}
+
+// CreateSnippet calls Client.CreateSnippet for the service.
+func (s *ServiceClient) CreateSnippet(i *CreateSnippetInput) (*Snippet, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.CreateSnippet(&in)
+}
+
+// GetSnippet calls Client.GetSnippet for the service.
+func (s *ServiceClient) GetSnippet(i *GetSnippetInput) (*Snippet, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.GetSnippet(&in)
+}
+
+// UpdateSnippet calls Client.UpdateSnippet for the service.
+func (s *ServiceClient) UpdateSnippet(i *UpdateSnippetInput) (*Snippet, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.UpdateSnippet(&in)
+}
+
+// DeleteSnippet calls Client.DeleteSnippet for the service.
+func (s *ServiceClient) DeleteSnippet(i *DeleteSnippetInput) error {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.DeleteSnippet(&in)
+}
+
+// ListDirectors calls Client.ListDirectors for the service.
+func (s *ServiceClient) ListDirectors(i *ListDirectorsInput) ([]*Director, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.ListDirectors(&in)
+}
+
+// CreateDirector calls Client.CreateDirector for the service.
+func (s *ServiceClient) CreateDirector(i *CreateDirectorInput) (*Director, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.CreateDirector(&in)
+}
+
+// GetDirector calls Client.GetDirector for the service.
+func (s *ServiceClient) GetDirector(i *GetDirectorInput) (*Director, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.GetDirector(&in)
+}
+
+// UpdateDirector calls Client.UpdateDirector for the service.
+func (s *ServiceClient) UpdateDirector(i *UpdateDirectorInput) (*Director, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.UpdateDirector(&in)
+}
+
+// DeleteDirector calls Client.DeleteDirector for the service.
+func (s *ServiceClient) DeleteDirector(i *DeleteDirectorInput) error {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.DeleteDirector(&in)
+}
+
+// ListRequestSettings calls Client.ListRequestSettings for the service.
+func (s *ServiceClient) ListRequestSettings(i *ListRequestSettingsInput) ([]*RequestSetting, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.ListRequestSettings(&in)
+}
+
+// CreateRequestSetting calls Client.CreateRequestSetting for the service.
+func (s *ServiceClient) CreateRequestSetting(i *CreateRequestSettingInput) (*RequestSetting, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.CreateRequestSetting(&in)
+}
+
+// GetRequestSetting calls Client.GetRequestSetting for the service.
+func (s *ServiceClient) GetRequestSetting(i *GetRequestSettingInput) (*RequestSetting, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.GetRequestSetting(&in)
+}
+
+// UpdateRequestSetting calls Client.UpdateRequestSetting for the service.
+func (s *ServiceClient) UpdateRequestSetting(i *UpdateRequestSettingInput) (*RequestSetting, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.UpdateRequestSetting(&in)
+}
+
+// DeleteRequestSetting calls Client.DeleteRequestSetting for the service.
+func (s *ServiceClient) DeleteRequestSetting(i *DeleteRequestSettingInput) error {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.DeleteRequestSetting(&in)
+}
+
+// ListCacheSettings calls Client.ListCacheSettings for the service.
+func (s *ServiceClient) ListCacheSettings(i *ListCacheSettingsInput) ([]*CacheSetting, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.ListCacheSettings(&in)
+}
+
+// CreateCacheSetting calls Client.CreateCacheSetting for the service.
+func (s *ServiceClient) CreateCacheSetting(i *CreateCacheSettingInput) (*CacheSetting, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.CreateCacheSetting(&in)
+}
+
+// GetCacheSetting calls Client.GetCacheSetting for the service.
+func (s *ServiceClient) GetCacheSetting(i *GetCacheSettingInput) (*CacheSetting, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.GetCacheSetting(&in)
+}
+
+// UpdateCacheSetting calls Client.UpdateCacheSetting for the service.
+func (s *ServiceClient) UpdateCacheSetting(i *UpdateCacheSettingInput) (*CacheSetting, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.UpdateCacheSetting(&in)
+}
+
+// DeleteCacheSetting calls Client.DeleteCacheSetting for the service.
+func (s *ServiceClient) DeleteCacheSetting(i *DeleteCacheSettingInput) error {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.DeleteCacheSetting(&in)
+}
+
+// ListGzips calls Client.ListGzips for the service.
+func (s *ServiceClient) ListGzips(i *ListGzipsInput) ([]*Gzip, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.ListGzips(&in)
+}
+
+// CreateGzip calls Client.CreateGzip for the service.
+func (s *ServiceClient) CreateGzip(i *CreateGzipInput) (*Gzip, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.CreateGzip(&in)
+}
+
+// GetGzip calls Client.GetGzip for the service.
+func (s *ServiceClient) GetGzip(i *GetGzipInput) (*Gzip, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.GetGzip(&in)
+}
+
+// UpdateGzip calls Client.UpdateGzip for the service.
+func (s *ServiceClient) UpdateGzip(i *UpdateGzipInput) (*Gzip, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.UpdateGzip(&in)
+}
+
+// DeleteGzip calls Client.DeleteGzip for the service.
+func (s *ServiceClient) DeleteGzip(i *DeleteGzipInput) error {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.DeleteGzip(&in)
+}
+
+// ListVCLs calls Client.ListVCLs for the service.
+func (s *ServiceClient) ListVCLs(i *ListVCLsInput) ([]*VCL, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.ListVCLs(&in)
+}
+
+// CreateVCL calls Client.CreateVCL for the service.
+func (s *ServiceClient) CreateVCL(i *CreateVCLInput) (*VCL, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.CreateVCL(&in)
+}
+
+// GetVCL calls Client.GetVCL for the service.
+func (s *ServiceClient) GetVCL(i *GetVCLInput) (*VCL, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.GetVCL(&in)
+}
+
+// UpdateVCL calls Client.UpdateVCL for the service.
+func (s *ServiceClient) UpdateVCL(i *UpdateVCLInput) (*VCL, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.UpdateVCL(&in)
+}
+
+// DeleteVCL calls Client.DeleteVCL for the service.
+func (s *ServiceClient) DeleteVCL(i *DeleteVCLInput) error {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.DeleteVCL(&in)
+}
+
+// ActivateVCL calls Client.ActivateVCL for the service.
+func (s *ServiceClient) ActivateVCL(i *ActivateVCLInput) (*VCL, error) {
+	in := *i
+	in.Service = s.id
+	if in.Version == 0 {
+		in.Version = s.version
+	}
+	return s.client.ActivateVCL(&in)
+}
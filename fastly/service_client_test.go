@@ -0,0 +1,81 @@
+package fastly
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+func TestServiceClient(t *testing.T) {
+	var mu sync.Mutex
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		paths = append(paths, r.Method+" "+r.URL.Path)
+		mu.Unlock()
+
+		switch r.URL.Path {
+		case "/service/foo/version":
+			w.Write([]byte(`[{"number":1},{"number":2}]`))
+		case "/service/foo/version/3/backend", "/service/foo/version/1/backend":
+			w.Write([]byte(`[{"name":"origin"}]`))
+		case "/service/foo/version/3/domain/www.example.com":
+			w.Write([]byte(`{"status":"ok"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := c.Service("foo")
+	if s.ID() != "foo" || s.Version() != 0 || s.Client() != c {
+		t.Errorf("bad handle: %+v", s)
+	}
+	if vs, err := s.ListVersions(&ListVersionsInput{}); err != nil || len(vs) != 2 {
+		t.Errorf("bad versions: %v, %v", vs, err)
+	}
+	if _, err := s.ListBackends(&ListBackendsInput{}); err != ErrMissingVersion {
+		t.Errorf("bad error: %v", err)
+	}
+
+	v3 := s.AtVersion(3)
+	if s.Version() != 0 || v3.Version() != 3 {
+		t.Errorf("expected AtVersion to copy the handle")
+	}
+	in := &ListBackendsInput{}
+	if bs, err := v3.ListBackends(in); err != nil || len(bs) != 1 || bs[0].Name != "origin" {
+		t.Errorf("bad backends: %v, %v", bs, err)
+	}
+	if in.Service != "" || in.Version != 0 {
+		t.Errorf("expected the input to be copied: %+v", in)
+	}
+	if _, err := v3.ListBackends(&ListBackendsInput{Version: 1}); err != nil {
+		t.Error(err)
+	}
+	if err := v3.DeleteDomain(&DeleteDomainInput{Name: "www.example.com"}); err != nil {
+		t.Error(err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	want := []string{
+		"GET /service/foo/version",
+		"GET /service/foo/version/3/backend",
+		"GET /service/foo/version/1/backend",
+		"DELETE /service/foo/version/3/domain/www.example.com",
+	}
+	if len(paths) != len(want) {
+		t.Fatalf("bad requests: %v", paths)
+	}
+	for n := range want {
+		if paths[n] != want[n] {
+			t.Errorf("bad request %d: %s, expected %s", n, paths[n], want[n])
+		}
+	}
+}
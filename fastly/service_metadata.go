@@ -0,0 +1,116 @@
+package fastly
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// serviceMetadataPrefix starts the lines of a service comment which hold
+// metadata, such as "@owner: edge-team".
+const serviceMetadataPrefix = "@"
+
+// Metadata returns the metadata stored in the service's comment by
+// SetServiceMetadata, as a map of keys to values. It returns an empty map if
+// the comment has none.
+func (s *Service) Metadata() map[string]string {
+	_, meta := parseServiceComment(s.Comment)
+	return meta
+}
+
+// CreatedTime and UpdatedTime parse the service's CreatedAt and UpdatedAt
+// timestamps. They return an error if the API did not return a timestamp.
+func (s *Service) CreatedTime() (time.Time, error) {
+	return time.Parse(time.RFC3339, s.CreatedAt)
+}
+
+func (s *Service) UpdatedTime() (time.Time, error) {
+	return time.Parse(time.RFC3339, s.UpdatedAt)
+}
+
+// SetServiceMetadataInput is used as input to the SetServiceMetadata function.
+type SetServiceMetadataInput struct {
+	// ID is the ID of the service (required).
+	ID string
+
+	// Metadata are the keys and values to set, such as "owner" or "pager".
+	// Keys with an empty value are removed. Keys not given are kept. Keys may
+	// not contain ":" or line breaks, and values may not contain line breaks.
+	Metadata map[string]string
+}
+
+// SetServiceMetadata merges metadata into a service's comment, which Fastly
+// shows to every user of the account, so inventory systems can record
+// ownership and similar details on the service itself. Metadata are stored as
+// "@key: value" lines after any other text in the comment, which is kept.
+func (c *Client) SetServiceMetadata(i *SetServiceMetadataInput) (*Service, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	s, err := c.GetService(&GetServiceInput{ID: i.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	text, meta := parseServiceComment(s.Comment)
+	for k, v := range i.Metadata {
+		k = strings.TrimSpace(k)
+		if k == "" || strings.ContainsAny(k, ":\r\n") || strings.ContainsAny(v, "\r\n") {
+			return nil, ErrInvalidServiceMetadata
+		}
+		if v = strings.TrimSpace(v); v == "" {
+			delete(meta, k)
+			continue
+		}
+		meta[k] = v
+	}
+
+	comment := formatServiceComment(text, meta)
+	if comment == s.Comment {
+		return s, nil
+	}
+	// UpdateService does not send an empty comment, so a comment whose last
+	// metadata was removed is left as a single space.
+	if comment == "" {
+		comment = " "
+	}
+	return c.UpdateService(&UpdateServiceInput{ID: i.ID, Comment: comment})
+}
+
+// parseServiceComment splits a service comment into its free text and its
+// metadata lines.
+func parseServiceComment(comment string) (string, map[string]string) {
+	meta := make(map[string]string)
+	var text []string
+	for _, line := range strings.Split(comment, "\n") {
+		if strings.HasPrefix(line, serviceMetadataPrefix) {
+			kv := strings.SplitN(line[len(serviceMetadataPrefix):], ":", 2)
+			if len(kv) == 2 && strings.TrimSpace(kv[0]) != "" {
+				meta[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+				continue
+			}
+		}
+		text = append(text, line)
+	}
+	return strings.TrimRight(strings.Join(text, "\n"), "\n "), meta
+}
+
+// formatServiceComment joins free text and metadata into a service comment,
+// with the metadata keys sorted so the comment only changes when they do.
+func formatServiceComment(text string, meta map[string]string) string {
+	keys := make([]string, 0, len(meta))
+	for k := range meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	lines := []string{}
+	if text != "" {
+		lines = append(lines, text)
+	}
+	for _, k := range keys {
+		lines = append(lines, serviceMetadataPrefix+k+": "+meta[k])
+	}
+	return strings.Join(lines, "\n")
+}
@@ -1,6 +1,9 @@
 package fastly
 
-import "testing"
+import (
+	"testing"
+	"time"
+)
 
 func TestClient_Services(t *testing.T) {
 	t.Parallel()
@@ -76,7 +79,7 @@ func TestClient_Services(t *testing.T) {
 		t.Errorf("Bad updated at: empty")
 	}
 
-	if ns.DeletedAt != "" {
+	if ns.DeletedAt != nil {
 		t.Errorf("Bad deleted at: %s", ns.DeletedAt)
 	}
 
@@ -167,3 +170,155 @@ func TestClient_DeleteService_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_DeleteServiceSafely(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	record(t, "services/delete_safely", func(c *Client) {
+		err = c.DeleteServiceSafely(&DeleteServiceSafelyInput{
+			ID: "2aBqYv4HSbKDWWrPkRktDH",
+		})
+	})
+	if err != ErrServiceHasActiveVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	record(t, "services/delete_safely", func(c *Client) {
+		err = c.DeleteServiceSafely(&DeleteServiceSafelyInput{
+			ID:    "2aBqYv4HSbKDWWrPkRktDH",
+			Force: true,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_DeleteServiceSafely_validation(t *testing.T) {
+	var err error
+	err = testClient.DeleteServiceSafely(&DeleteServiceSafelyInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_ListServices_filters(t *testing.T) {
+	t.Parallel()
+
+	var ss []*Service
+	var err error
+	record(t, "services/list_filtered", func(c *Client) {
+		ss, err = c.ListServices(&ListServicesInput{
+			Page:       1,
+			PerPage:    3,
+			Sort:       "created",
+			Direction:  DirectionDescend,
+			NamePrefix: "prod-",
+			Type:       ServiceTypeVCL,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ss) != 1 {
+		t.Fatalf("bad services: %v", ss)
+	}
+	if ss[0].Name != "prod-www" {
+		t.Errorf("bad name: %q", ss[0].Name)
+	}
+	if ss[0].Type != ServiceTypeVCL {
+		t.Errorf("bad type: %q", ss[0].Type)
+	}
+}
+
+func TestClient_ListServices_nilInput(t *testing.T) {
+	t.Parallel()
+
+	var ss []*Service
+	var err error
+	record(t, "services/list", func(c *Client) {
+		ss, err = c.ListServices(nil)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ss) < 1 {
+		t.Errorf("bad services: %v", ss)
+	}
+}
+
+func TestClient_ListServices_starred(t *testing.T) {
+	t.Parallel()
+
+	var ss []*Service
+	var err error
+	record(t, "services/list_starred", func(c *Client) {
+		ss, err = c.ListServices(&ListServicesInput{
+			Starred: true,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ss) != 2 || ss[0].Name != "prod-www" || ss[1].Name != "staging-www" {
+		t.Errorf("bad services: %v", ss)
+	}
+}
+
+func TestClient_SetServiceMetadata(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var s *Service
+	record(t, "services/set_metadata", func(c *Client) {
+		s, err = c.SetServiceMetadata(&SetServiceMetadataInput{
+			ID: testServiceID,
+			Metadata: map[string]string{
+				"owner": "edge-team",
+				"pager": "edge-oncall",
+			},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	meta := s.Metadata()
+	if meta["owner"] != "edge-team" || meta["pager"] != "edge-oncall" {
+		t.Errorf("bad metadata: %v", meta)
+	}
+	ut, err := s.UpdatedTime()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ut.Year() != 2017 || ut.Month() != time.July || ut.Day() != 21 {
+		t.Errorf("bad updated_at: %v", ut)
+	}
+}
+
+func TestServiceComment_metadata(t *testing.T) {
+	text, meta := parseServiceComment("Main website\n\n@owner: web-team\n@not metadata\n")
+	if text != "Main website\n\n@not metadata" {
+		t.Errorf("bad text: %q", text)
+	}
+	if len(meta) != 1 || meta["owner"] != "web-team" {
+		t.Errorf("bad metadata: %v", meta)
+	}
+
+	meta["cost-center"] = "42"
+	comment := formatServiceComment("Main website", meta)
+	if comment != "Main website\n@cost-center: 42\n@owner: web-team" {
+		t.Errorf("bad comment: %q", comment)
+	}
+	if got := formatServiceComment("", map[string]string{"owner": "a"}); got != "@owner: a" {
+		t.Errorf("bad comment: %q", got)
+	}
+}
+
+func TestClient_SetServiceMetadata_validation(t *testing.T) {
+	var err error
+	_, err = testClient.SetServiceMetadata(&SetServiceMetadataInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
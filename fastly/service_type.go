@@ -0,0 +1,40 @@
+package fastly
+
+// setServiceType records the type of a service, as seen in a response.
+// Services with no type are not recorded.
+func (c *Client) setServiceType(id string, t ServiceType) {
+	if id == "" || t == "" {
+		return
+	}
+	c.serviceTypesMu.Lock()
+	defer c.serviceTypesMu.Unlock()
+	if c.serviceTypes == nil {
+		c.serviceTypes = make(map[string]ServiceType)
+	}
+	c.serviceTypes[id] = t
+}
+
+// serviceType returns the recorded type of a service, or an empty string if
+// it has not been seen.
+func (c *Client) serviceType(id string) ServiceType {
+	c.serviceTypesMu.Lock()
+	defer c.serviceTypesMu.Unlock()
+	return c.serviceTypes[id]
+}
+
+// requireVCLService returns ErrNotVCLService if the service is known to be a
+// Compute service. With CheckServiceTypes, the type of a service not seen yet
+// is looked up first.
+func (c *Client) requireVCLService(id string) error {
+	t := c.serviceType(id)
+	if t == "" && c.CheckServiceTypes {
+		if _, err := c.GetService(&GetServiceInput{ID: id}); err != nil {
+			return err
+		}
+		t = c.serviceType(id)
+	}
+	if t == ServiceTypeWasm {
+		return ErrNotVCLService
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package fastly
+
+import "testing"
+
+func TestClient_requireVCLService(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	record(t, "services/list_filtered", func(c *Client) {
+		if _, err = c.ListServices(&ListServicesInput{
+			Page:      1,
+			PerPage:   3,
+			Sort:      "created",
+			Direction: DirectionDescend,
+		}); err != nil {
+			return
+		}
+		_, err = c.CreateSnippet(&CreateSnippetInput{
+			Service: "5r1HNb2KGwS7h3v2gPBZ1E",
+			Version: 3,
+			Name:    "test-snippet",
+		})
+	})
+	if err != ErrNotVCLService {
+		t.Errorf("bad error: %v", err)
+	}
+
+	record(t, "services/get_wasm", func(c *Client) {
+		c.CheckServiceTypes = true
+		err = c.DeleteCondition(&DeleteConditionInput{
+			Service: "5r1HNb2KGwS7h3v2gPBZ1E",
+			Version: 3,
+			Name:    "test-condition",
+		})
+	})
+	if err != ErrNotVCLService {
+		t.Errorf("bad error: %v", err)
+	}
+}
@@ -7,8 +7,10 @@ type Settings struct {
 	ServiceID string `mapstructure:"service_id"`
 	Version   int    `mapstructure:"version"`
 
-	DefaultTTL  uint   `mapstructure:"general.default_ttl"`
-	DefaultHost string `mapstructure:"general.default_host"`
+	DefaultTTL      uint   `mapstructure:"general.default_ttl"`
+	DefaultHost     string `mapstructure:"general.default_host"`
+	StaleIfError    bool   `mapstructure:"general.stale_if_error"`
+	StaleIfErrorTTL uint   `mapstructure:"general.stale_if_error_ttl"`
 }
 
 // GetSettingsInput is used as input to the GetSettings function.
@@ -49,8 +51,10 @@ type UpdateSettingsInput struct {
 	Service string
 	Version int
 
-	DefaultTTL  uint   `form:"general.default_ttl"`
-	DefaultHost string `form:"general.default_host,omitempty"`
+	DefaultTTL      uint         `form:"general.default_ttl"`
+	DefaultHost     string       `form:"general.default_host,omitempty"`
+	StaleIfError    *Compatibool `form:"general.stale_if_error,omitempty"`
+	StaleIfErrorTTL uint         `form:"general.stale_if_error_ttl,omitempty"`
 }
 
 // UpdateSettings updates a specific backend.
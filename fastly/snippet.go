@@ -0,0 +1,358 @@
+package fastly
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"time"
+)
+
+// SnippetType is the location in the generated VCL where a snippet is
+// included.
+type SnippetType string
+
+const (
+	SnippetTypeInit    SnippetType = "init"
+	SnippetTypeRecv    SnippetType = "recv"
+	SnippetTypeHash    SnippetType = "hash"
+	SnippetTypeHit     SnippetType = "hit"
+	SnippetTypeMiss    SnippetType = "miss"
+	SnippetTypePass    SnippetType = "pass"
+	SnippetTypeFetch   SnippetType = "fetch"
+	SnippetTypeError   SnippetType = "error"
+	SnippetTypeDeliver SnippetType = "deliver"
+	SnippetTypeLog     SnippetType = "log"
+	SnippetTypeNone    SnippetType = "none"
+)
+
+// SnippetPriorityStep is the gap ReorderSnippets leaves between the priorities
+// it assigns, so a snippet can later be slotted in without renumbering.
+const SnippetPriorityStep = 10
+
+// Snippet represents a VCL snippet response from the Fastly API.
+type Snippet struct {
+	ServiceID string `mapstructure:"service_id"`
+	Version   int    `mapstructure:"version"`
+
+	ID        string      `mapstructure:"id"`
+	Name      string      `mapstructure:"name"`
+	Dynamic   bool        `mapstructure:"dynamic"`
+	Type      SnippetType `mapstructure:"type"`
+	Content   string      `mapstructure:"content"`
+	Priority  int         `mapstructure:"priority"`
+	CreatedAt *time.Time  `mapstructure:"created_at"`
+	UpdatedAt *time.Time  `mapstructure:"updated_at"`
+	DeletedAt *time.Time  `mapstructure:"deleted_at"`
+}
+
+// snippetsByName is a sortable list of snippets.
+type snippetsByName []*Snippet
+
+// Len, Swap, and Less implement the sortable interface.
+func (s snippetsByName) Len() int      { return len(s) }
+func (s snippetsByName) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s snippetsByName) Less(i, j int) bool {
+	return s[i].Name < s[j].Name
+}
+
+// ListSnippetsInput is used as input to the ListSnippets function.
+type ListSnippetsInput struct {
+	// Service is the ID of the service (required).
+	Service string
+
+	// Version is the specific configuration version (required).
+	Version int
+
+	// IncludeDeleted keeps snippets the API returns with a DeletedAt time. By
+	// default they are left out.
+	IncludeDeleted bool
+}
+
+// ListSnippets returns the list of snippets for the configuration version.
+func (c *Client) ListSnippets(i *ListSnippetsInput) ([]*Snippet, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if err := c.requireVCLService(i.Service); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/snippet", i.Service, i.Version)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ss []*Snippet
+	if err := decodeJSON(&ss, resp.Body); err != nil {
+		return nil, err
+	}
+	if !i.IncludeDeleted {
+		kept := make([]*Snippet, 0, len(ss))
+		for _, s := range ss {
+			if s.DeletedAt == nil {
+				kept = append(kept, s)
+			}
+		}
+		ss = kept
+	}
+	sort.Stable(snippetsByName(ss))
+	return ss, nil
+}
+
+// CreateSnippetInput is used as input to the CreateSnippet function.
+type CreateSnippetInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	Name     string       `form:"name,omitempty"`
+	Dynamic  *Compatibool `form:"dynamic,omitempty"`
+	Type     SnippetType  `form:"type,omitempty"`
+	Content  string       `form:"content,omitempty"`
+	Priority int          `form:"priority,omitempty"`
+}
+
+// CreateSnippet creates a new Fastly VCL snippet.
+func (c *Client) CreateSnippet(i *CreateSnippetInput) (*Snippet, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if err := c.requireVCLService(i.Service); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/snippet", i.Service, i.Version)
+	resp, err := c.PostForm(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var s *Snippet
+	if err := decodeJSON(&s, resp.Body); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// GetSnippetInput is used as input to the GetSnippet function.
+type GetSnippetInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the snippet to fetch.
+	Name string
+}
+
+// GetSnippet gets the snippet with the given parameters.
+func (c *Client) GetSnippet(i *GetSnippetInput) (*Snippet, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if err := c.requireVCLService(i.Service); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/snippet/%s", i.Service, i.Version, url.PathEscape(i.Name))
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var s *Snippet
+	if err := decodeJSON(&s, resp.Body); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// UpdateSnippetInput is used as input to the UpdateSnippet function.
+type UpdateSnippetInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the snippet to update.
+	Name string
+
+	NewName  string      `form:"name,omitempty"`
+	Type     SnippetType `form:"type,omitempty"`
+	Content  string      `form:"content,omitempty"`
+	Priority int         `form:"priority,omitempty"`
+}
+
+// UpdateSnippet updates a specific snippet.
+func (c *Client) UpdateSnippet(i *UpdateSnippetInput) (*Snippet, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return nil, ErrMissingName
+	}
+
+	if err := c.requireVCLService(i.Service); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/snippet/%s", i.Service, i.Version, url.PathEscape(i.Name))
+	resp, err := c.PutForm(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var s *Snippet
+	if err := decodeJSON(&s, resp.Body); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// DeleteSnippetInput is the input parameter to DeleteSnippet.
+type DeleteSnippetInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Name is the name of the snippet to delete (required).
+	Name string
+}
+
+// DeleteSnippet deletes the given snippet.
+func (c *Client) DeleteSnippet(i *DeleteSnippetInput) error {
+	if i.Service == "" {
+		return ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return ErrMissingVersion
+	}
+
+	if i.Name == "" {
+		return ErrMissingName
+	}
+
+	if err := c.requireVCLService(i.Service); err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/snippet/%s", i.Service, i.Version, url.PathEscape(i.Name))
+	resp, err := c.Delete(path, nil)
+	if err != nil {
+		return err
+	}
+
+	var r *statusResp
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return err
+	}
+	if !r.Ok() {
+		return fmt.Errorf("Not Ok")
+	}
+	return nil
+}
+
+// ReorderSnippetsInput is the input parameter to ReorderSnippets.
+type ReorderSnippetsInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Names is the names of the snippets in the order they should run, first
+	// to last (required). Snippets that are not named keep their priority.
+	Names []string
+}
+
+// ReorderSnippets rewrites the priorities of the named snippets so they run in
+// the given order. Priorities are assigned in steps of SnippetPriorityStep and
+// a snippet is only updated if its priority changes. The full, updated list of
+// snippets is returned.
+func (c *Client) ReorderSnippets(i *ReorderSnippetsInput) ([]*Snippet, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	if len(i.Names) == 0 {
+		return nil, ErrMissingNames
+	}
+
+	ss, err := c.ListSnippets(&ListSnippetsInput{
+		Service: i.Service,
+		Version: i.Version,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	byName := make(map[string]*Snippet, len(ss))
+	for _, s := range ss {
+		byName[s.Name] = s
+	}
+
+	seen := make(map[string]bool, len(i.Names))
+	for _, name := range i.Names {
+		if _, ok := byName[name]; !ok {
+			return nil, fmt.Errorf("Unknown snippet %q", name)
+		}
+		if seen[name] {
+			return nil, fmt.Errorf("Duplicate snippet %q", name)
+		}
+		seen[name] = true
+	}
+
+	for n, name := range i.Names {
+		priority := (n + 1) * SnippetPriorityStep
+		if byName[name].Priority == priority {
+			continue
+		}
+
+		s, err := c.UpdateSnippet(&UpdateSnippetInput{
+			Service:  i.Service,
+			Version:  i.Version,
+			Name:     name,
+			Priority: priority,
+		})
+		if err != nil {
+			return nil, err
+		}
+		byName[name] = s
+	}
+
+	for n, s := range ss {
+		ss[n] = byName[s.Name]
+	}
+	return ss, nil
+}
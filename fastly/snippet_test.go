@@ -0,0 +1,307 @@
+package fastly
+
+import "testing"
+
+func TestClient_Snippets(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var tv *Version
+	record(t, "snippets/version", func(c *Client) {
+		tv = testVersion(t, c)
+	})
+
+	// Create
+	var s *Snippet
+	record(t, "snippets/create", func(c *Client) {
+		s, err = c.CreateSnippet(&CreateSnippetInput{
+			Service:  testServiceID,
+			Version:  tv.Number,
+			Name:     "test-snippet",
+			Type:     SnippetTypeRecv,
+			Content:  "set req.http.X-Test = \"1\";",
+			Priority: 10,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Ensure deleted
+	defer func() {
+		record(t, "snippets/cleanup", func(c *Client) {
+			c.DeleteSnippet(&DeleteSnippetInput{
+				Service: testServiceID,
+				Version: tv.Number,
+				Name:    "test-snippet",
+			})
+
+			c.DeleteSnippet(&DeleteSnippetInput{
+				Service: testServiceID,
+				Version: tv.Number,
+				Name:    "new-test-snippet",
+			})
+		})
+	}()
+
+	if s.Name != "test-snippet" {
+		t.Errorf("bad name: %q", s.Name)
+	}
+	if s.Type != SnippetTypeRecv {
+		t.Errorf("bad type: %q", s.Type)
+	}
+	if s.Content != "set req.http.X-Test = \"1\";" {
+		t.Errorf("bad content: %q", s.Content)
+	}
+	if s.Priority != 10 {
+		t.Errorf("bad priority: %d", s.Priority)
+	}
+
+	// List
+	var ss []*Snippet
+	record(t, "snippets/list", func(c *Client) {
+		ss, err = c.ListSnippets(&ListSnippetsInput{
+			Service: testServiceID,
+			Version: tv.Number,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ss) < 1 {
+		t.Errorf("bad snippets: %v", ss)
+	}
+
+	// Get
+	var ns *Snippet
+	record(t, "snippets/get", func(c *Client) {
+		ns, err = c.GetSnippet(&GetSnippetInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Name:    "test-snippet",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Name != ns.Name {
+		t.Errorf("bad name: %q", s.Name)
+	}
+	if s.Type != ns.Type {
+		t.Errorf("bad type: %q", s.Type)
+	}
+	if s.Content != ns.Content {
+		t.Errorf("bad content: %q", s.Content)
+	}
+	if s.Priority != ns.Priority {
+		t.Errorf("bad priority: %d", s.Priority)
+	}
+
+	// Update
+	var us *Snippet
+	record(t, "snippets/update", func(c *Client) {
+		us, err = c.UpdateSnippet(&UpdateSnippetInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Name:    "test-snippet",
+			NewName: "new-test-snippet",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if us.Name != "new-test-snippet" {
+		t.Errorf("bad name: %q", us.Name)
+	}
+
+	// Delete
+	record(t, "snippets/delete", func(c *Client) {
+		err = c.DeleteSnippet(&DeleteSnippetInput{
+			Service: testServiceID,
+			Version: tv.Number,
+			Name:    "new-test-snippet",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_ReorderSnippets(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var ss []*Snippet
+	record(t, "snippets/reorder", func(c *Client) {
+		ss, err = c.ReorderSnippets(&ReorderSnippetsInput{
+			Service: testServiceID,
+			Version: 807,
+			Names:   []string{"snippet-b", "snippet-a", "snippet-c"},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	priorities := map[string]int{}
+	for _, s := range ss {
+		priorities[s.Name] = s.Priority
+	}
+	if priorities["snippet-b"] != 10 || priorities["snippet-a"] != 20 || priorities["snippet-c"] != 30 {
+		t.Errorf("bad priorities: %v", priorities)
+	}
+
+	record(t, "snippets/reorder", func(c *Client) {
+		_, err = c.ReorderSnippets(&ReorderSnippetsInput{
+			Service: testServiceID,
+			Version: 807,
+			Names:   []string{"snippet-a", "snippet-missing"},
+		})
+	})
+	if err == nil {
+		t.Error("expected error for unknown snippet")
+	}
+}
+
+func TestClient_ListSnippets_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListSnippets(&ListSnippetsInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ListSnippets(&ListSnippetsInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_CreateSnippet_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateSnippet(&CreateSnippetInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateSnippet(&CreateSnippetInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetSnippet_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetSnippet(&GetSnippetInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetSnippet(&GetSnippetInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetSnippet(&GetSnippetInput{
+		Service: "foo",
+		Version: 1,
+		Name:    "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdateSnippet_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateSnippet(&UpdateSnippetInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateSnippet(&UpdateSnippetInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateSnippet(&UpdateSnippetInput{
+		Service: "foo",
+		Version: 1,
+		Name:    "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_DeleteSnippet_validation(t *testing.T) {
+	var err error
+	err = testClient.DeleteSnippet(&DeleteSnippetInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteSnippet(&DeleteSnippetInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteSnippet(&DeleteSnippetInput{
+		Service: "foo",
+		Version: 1,
+		Name:    "",
+	})
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_ReorderSnippets_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ReorderSnippets(&ReorderSnippetsInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ReorderSnippets(&ReorderSnippetsInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ReorderSnippets(&ReorderSnippetsInput{
+		Service: "foo",
+		Version: 1,
+	})
+	if err != ErrMissingNames {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -0,0 +1,125 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/google/jsonapi"
+)
+
+// Star is a service a user has marked as a favorite.
+type Star struct {
+	ID        string
+	ServiceID string
+	UserID    string
+	CreatedAt string
+}
+
+// starPayload is the JSON:API shape of a star.
+type starPayload struct {
+	ID        string           `jsonapi:"primary,star"`
+	CreatedAt string           `jsonapi:"attr,created_at,omitempty"`
+	Service   *starServiceLink `jsonapi:"relation,service"`
+	User      *starUserLink    `jsonapi:"relation,user"`
+}
+
+// starServiceLink and starUserLink are the relationships of a star.
+type starServiceLink struct {
+	ID string `jsonapi:"primary,service"`
+}
+
+type starUserLink struct {
+	ID string `jsonapi:"primary,user"`
+}
+
+// starPayloadType is used for reflection because JSONAPI wants to know what
+// it's decoding into.
+var starPayloadType = reflect.TypeOf(new(starPayload))
+
+// star converts the payload to a Star.
+func (p *starPayload) star() *Star {
+	s := &Star{ID: p.ID, CreatedAt: p.CreatedAt}
+	if p.Service != nil {
+		s.ServiceID = p.Service.ID
+	}
+	if p.User != nil {
+		s.UserID = p.User.ID
+	}
+	return s
+}
+
+// ListStars returns the services starred by the current user, following every
+// page of results.
+func (c *Client) ListStars() ([]*Star, error) {
+	data, err := c.getAllJSONAPIPages("/stars", nil, starPayloadType)
+	if err != nil {
+		return nil, err
+	}
+
+	stars := make([]*Star, len(data))
+	for i := range data {
+		typed, ok := data[i].(*starPayload)
+		if !ok {
+			return nil, fmt.Errorf("got back a non-Star response")
+		}
+		stars[i] = typed.star()
+	}
+	return stars, nil
+}
+
+// CreateStarInput is used as input to the CreateStar function.
+type CreateStarInput struct {
+	// Service is the ID of the service to star and is required.
+	Service string
+
+	// User is the ID of the user starring the service. Optional; the default
+	// is the current user.
+	User string
+}
+
+// CreateStar marks a service as a favorite of a user.
+func (c *Client) CreateStar(i *CreateStarInput) (*Star, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	user := i.User
+	if user == "" {
+		u, err := c.GetCurrentUser()
+		if err != nil {
+			return nil, err
+		}
+		user = u.ID
+	}
+
+	resp, err := c.PostJSONAPI("/stars", &starPayload{
+		Service: &starServiceLink{ID: i.Service},
+		User:    &starUserLink{ID: user},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var p starPayload
+	if err := jsonapi.UnmarshalPayload(resp.Body, &p); err != nil {
+		return nil, err
+	}
+	return p.star(), nil
+}
+
+// DeleteStarInput is used as input to the DeleteStar function.
+type DeleteStarInput struct {
+	// ID is the ID of the star and is required.
+	ID string
+}
+
+// DeleteStar removes a star from a service.
+func (c *Client) DeleteStar(i *DeleteStarInput) error {
+	if i.ID == "" {
+		return ErrMissingID
+	}
+
+	path := fmt.Sprintf("/stars/%s", i.ID)
+	_, err := c.Delete(path, nil)
+	return err
+}
@@ -0,0 +1,60 @@
+package fastly
+
+import "testing"
+
+func TestClient_Stars(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var stars []*Star
+	record(t, "stars/list", func(c *Client) {
+		stars, err = c.ListStars()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stars) != 2 {
+		t.Fatalf("bad stars: %v", stars)
+	}
+	if stars[0].ServiceID != "3x6HNbQKGwS1h3v2gPBZ9C" || stars[0].UserID != "6TGNjlv1QUstI5iMxxxxxx" {
+		t.Errorf("bad star: %#v", stars[0])
+	}
+
+	var s *Star
+	record(t, "stars/create", func(c *Client) {
+		s, err = c.CreateStar(&CreateStarInput{
+			Service: testServiceID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.ID != "6wBmZGy7fJ1Z3Jt0c8CXzN" || s.ServiceID != testServiceID {
+		t.Errorf("bad star: %#v", s)
+	}
+
+	record(t, "stars/delete", func(c *Client) {
+		err = c.DeleteStar(&DeleteStarInput{
+			ID: s.ID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClient_CreateStar_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateStar(&CreateStarInput{})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_DeleteStar_validation(t *testing.T) {
+	var err error
+	err = testClient.DeleteStar(&DeleteStarInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -1,9 +1,15 @@
 package fastly
 
-import "fmt"
+import (
+	"fmt"
+	"net/url"
+	"sync"
+)
 
 // Stats represent metrics of a Fastly service
 type Stats struct {
+	ServiceID                 string      `mapstructure:"service_id"`               // ID of the service the sample is for.
+	StartTime                 uint64      `mapstructure:"start_time"`               // Start of the sample period, as a Unix timestamp.
 	Requests                  uint64      `mapstructure:"requests"`                 // Number of requests processed.
 	Hits                      uint64      `mapstructure:"hits"`                     // Number of cache hits.
 	HitsTime                  float64     `mapstructure:"hits_time"`                // Total amount of time spent processing cache hits (in seconds).
@@ -76,6 +82,27 @@ type Stats struct {
 	MissHistogram             map[int]int `mapstructure:"miss_histogram"`           // Number of requests to origin in time buckets of 10s of milliseconds
 	BilledHeaderBytes         uint64      `mapstructure:"billed_header_bytes"`
 	BilledBodyBytes           uint64      `mapstructure:"billed_body_bytes"`
+
+	ComputeRequests              uint64  `mapstructure:"compute_requests"`                // Number of requests received by Compute.
+	ComputeExecutionTimeMs       float64 `mapstructure:"compute_execution_time_ms"`       // Amount of active CPU time used to process requests (in milliseconds).
+	ComputeRAMUsed               uint64  `mapstructure:"compute_ram_used"`                // Amount of RAM used by Compute (in bytes).
+	ComputeRequestTimeMs         float64 `mapstructure:"compute_request_time_ms"`         // Total actual amount of time used to process requests, including active CPU time (in milliseconds).
+	ComputeRequestHeaderBytes    uint64  `mapstructure:"compute_req_header_bytes"`        // Total header bytes received by Compute.
+	ComputeRequestBodyBytes      uint64  `mapstructure:"compute_req_body_bytes"`          // Total body bytes received by Compute.
+	ComputeResponseHeaderBytes   uint64  `mapstructure:"compute_resp_header_bytes"`       // Total header bytes sent from Compute to the end user.
+	ComputeResponseBodyBytes     uint64  `mapstructure:"compute_resp_body_bytes"`         // Total body bytes sent from Compute to the end user.
+	ComputeBERequests            uint64  `mapstructure:"compute_bereqs"`                  // Number of backend requests started by Compute.
+	ComputeBERequestHeaderBytes  uint64  `mapstructure:"compute_bereq_header_bytes"`      // Total header bytes sent to backends by Compute.
+	ComputeBERequestBodyBytes    uint64  `mapstructure:"compute_bereq_body_bytes"`        // Total body bytes sent to backends by Compute.
+	ComputeBEResponseHeaderBytes uint64  `mapstructure:"compute_beresp_header_bytes"`     // Total header bytes received from backends by Compute.
+	ComputeBEResponseBodyBytes   uint64  `mapstructure:"compute_beresp_body_bytes"`       // Total body bytes received from backends by Compute.
+	ComputeBERequestErrors       uint64  `mapstructure:"compute_bereq_errors"`            // Number of backend request errors, including timeouts.
+	ComputeResourceLimitExceeded uint64  `mapstructure:"compute_resource_limit_exceeded"` // Number of times a guest exceeded its resource limit.
+	ComputeHeapLimitExceeded     uint64  `mapstructure:"compute_heap_limit_exceeded"`     // Number of times a guest exceeded its heap limit.
+	ComputeStackLimitExceeded    uint64  `mapstructure:"compute_stack_limit_exceeded"`    // Number of times a guest exceeded its stack limit.
+	ComputeGlobalsLimitExceeded  uint64  `mapstructure:"compute_globals_limit_exceeded"`  // Number of times a guest exceeded its globals limit.
+	ComputeGuestErrors           uint64  `mapstructure:"compute_guest_errors"`            // Number of times a service experienced a guest code error.
+	ComputeResponseStatus5xx     uint64  `mapstructure:"compute_resp_status_5xx"`         // Number of "Server Error" codes delivered by Compute.
 }
 
 // GetStatsInput is an input to the GetStats function.
@@ -88,7 +115,51 @@ type GetStatsInput struct {
 	From    string
 	To      string
 	By      string
-	Region  string
+	Region  Region
+
+	// Cache, if set, makes the request conditional on the data having changed
+	// since it was last fetched for the same query, returning the cached
+	// response if it has not. Optional.
+	Cache *StatsCache
+}
+
+// StatsCache caches GetStats responses keyed by their query, so polling the
+// same window sends a conditional request and reuses the cached response when
+// the API reports it unchanged. It is safe for concurrent use, and its zero
+// value is an empty cache.
+type StatsCache struct {
+	mu      sync.Mutex
+	entries map[string]*statsCacheEntry
+}
+
+// statsCacheEntry is a cached response along with the validators needed to
+// revalidate it.
+type statsCacheEntry struct {
+	lastModified string
+	etag         string
+	response     *StatsResponse
+}
+
+// NewStatsCache returns an empty StatsCache.
+func NewStatsCache() *StatsCache {
+	return &StatsCache{entries: make(map[string]*statsCacheEntry)}
+}
+
+// get returns the cached entry for key, if any.
+func (sc *StatsCache) get(key string) *statsCacheEntry {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	return sc.entries[key]
+}
+
+// set stores an entry for key.
+func (sc *StatsCache) set(key string, e *statsCacheEntry) {
+	sc.mu.Lock()
+	defer sc.mu.Unlock()
+	if sc.entries == nil {
+		sc.entries = make(map[string]*statsCacheEntry)
+	}
+	sc.entries[key] = e
 }
 
 // StatsResponse is a response from the service stats API endpoint
@@ -101,6 +172,9 @@ type StatsResponse struct {
 
 // GetStats returns stats data based on GetStatsInput
 func (c *Client) GetStats(i *GetStatsInput) (*StatsResponse, error) {
+	if err := c.ValidateRegion(i.Region); err != nil {
+		return nil, err
+	}
 
 	p := "/stats"
 
@@ -112,15 +186,40 @@ func (c *Client) GetStats(i *GetStatsInput) (*StatsResponse, error) {
 		p = fmt.Sprintf("%s/field/%s", p, i.Field)
 	}
 
-	r, err := c.Get(p, &RequestOptions{
+	ro := &RequestOptions{
 		Params: map[string]string{
 			"from":   i.From,
 			"to":     i.To,
 			"by":     i.By,
-			"region": i.Region,
+			"region": string(i.Region),
 		},
-	})
+	}
+
+	var key string
+	var cached *statsCacheEntry
+	if i.Cache != nil {
+		q := make(url.Values)
+		for k, v := range ro.Params {
+			q.Set(k, v)
+		}
+		key = p + "?" + q.Encode()
+
+		if cached = i.Cache.get(key); cached != nil {
+			ro.Headers = make(map[string]string)
+			if cached.lastModified != "" {
+				ro.Headers["If-Modified-Since"] = cached.lastModified
+			}
+			if cached.etag != "" {
+				ro.Headers["If-None-Match"] = cached.etag
+			}
+		}
+	}
+
+	r, err := c.Get(p, ro)
 	if err != nil {
+		if herr, ok := err.(*HTTPError); ok && herr.StatusCode == 304 && cached != nil {
+			return cached.response, nil
+		}
 		return nil, err
 	}
 
@@ -129,6 +228,17 @@ func (c *Client) GetStats(i *GetStatsInput) (*StatsResponse, error) {
 		return nil, err
 	}
 
+	if i.Cache != nil {
+		lastModified, etag := r.Header.Get("Last-Modified"), r.Header.Get("ETag")
+		if lastModified != "" || etag != "" {
+			i.Cache.set(key, &statsCacheEntry{
+				lastModified: lastModified,
+				etag:         etag,
+				response:     sr,
+			})
+		}
+	}
+
 	return sr, nil
 }
 
@@ -163,17 +273,21 @@ type GetUsageInput struct {
 	From   string
 	To     string
 	By     string
-	Region string
+	Region Region
 }
 
 // GetUsage returns usage information aggregated across all Fastly services and grouped by region.
 func (c *Client) GetUsage(i *GetUsageInput) (*UsageResponse, error) {
+	if err := c.ValidateRegion(i.Region); err != nil {
+		return nil, err
+	}
+
 	r, err := c.Get("/stats/usage", &RequestOptions{
 		Params: map[string]string{
 			"from":   i.From,
 			"to":     i.To,
 			"by":     i.By,
-			"region": i.Region,
+			"region": string(i.Region),
 		},
 	})
 	if err != nil {
@@ -204,12 +318,16 @@ type ServicesByRegionsUsage map[string]*ServicesUsage
 // GetUsageByService returns usage information aggregated by service and
 // grouped by service and region.
 func (c *Client) GetUsageByService(i *GetUsageInput) (*UsageByServiceResponse, error) {
+	if err := c.ValidateRegion(i.Region); err != nil {
+		return nil, err
+	}
+
 	r, err := c.Get("/stats/usage_by_service", &RequestOptions{
 		Params: map[string]string{
 			"from":   i.From,
 			"to":     i.To,
 			"by":     i.By,
-			"region": i.Region,
+			"region": string(i.Region),
 		},
 	})
 	if err != nil {
@@ -0,0 +1,243 @@
+package fastly
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+)
+
+// CacheHitRatio returns the ratio of cache hits to cacheable requests (hits
+// and misses), between 0 and 1. Unlike HitRatio, it is computed from the
+// counters, so it is also correct for aggregated stats.
+func (s *Stats) CacheHitRatio() float64 {
+	if s.Hits+s.Miss == 0 {
+		return 0
+	}
+	return float64(s.Hits) / float64(s.Hits+s.Miss)
+}
+
+// ErrorRate returns the ratio of 5xx responses to requests, between 0 and 1.
+func (s *Stats) ErrorRate() float64 {
+	if s.Requests == 0 {
+		return 0
+	}
+	return float64(s.Status5xx) / float64(s.Requests)
+}
+
+// ClientErrorRate returns the ratio of 4xx responses to requests, between 0
+// and 1.
+func (s *Stats) ClientErrorRate() float64 {
+	if s.Requests == 0 {
+		return 0
+	}
+	return float64(s.Status4xx) / float64(s.Requests)
+}
+
+// SumStats sums a list of stats buckets into a single bucket. Counters and
+// times are added and miss histograms merged. StartTime is the earliest start
+// time, ServiceID is kept only if every bucket has the same one, and HitRatio
+// is recomputed from the summed counters. It returns nil for an empty list.
+func SumStats(stats []*Stats) *Stats {
+	if len(stats) == 0 {
+		return nil
+	}
+
+	sum := &Stats{
+		ServiceID: stats[0].ServiceID,
+		StartTime: stats[0].StartTime,
+	}
+	out := reflect.ValueOf(sum).Elem()
+	for _, s := range stats {
+		if s.ServiceID != sum.ServiceID {
+			sum.ServiceID = ""
+		}
+		if s.StartTime < sum.StartTime {
+			sum.StartTime = s.StartTime
+		}
+
+		in := reflect.ValueOf(s).Elem()
+		for f := 0; f < in.NumField(); f++ {
+			if !summableStatsField(f) {
+				continue
+			}
+			switch in.Field(f).Kind() {
+			case reflect.Uint64:
+				out.Field(f).SetUint(out.Field(f).Uint() + in.Field(f).Uint())
+			case reflect.Float64:
+				out.Field(f).SetFloat(out.Field(f).Float() + in.Field(f).Float())
+			}
+		}
+
+		for k, v := range s.MissHistogram {
+			if sum.MissHistogram == nil {
+				sum.MissHistogram = make(map[int]int)
+			}
+			sum.MissHistogram[k] += v
+		}
+	}
+	sum.HitRatio = sum.CacheHitRatio()
+	return sum
+}
+
+// AverageStats returns the mean of a list of stats buckets. Counters are
+// rounded down to whole numbers. It returns nil for an empty list.
+func AverageStats(stats []*Stats) *Stats {
+	avg := SumStats(stats)
+	if avg == nil {
+		return nil
+	}
+
+	n := uint64(len(stats))
+	v := reflect.ValueOf(avg).Elem()
+	for f := 0; f < v.NumField(); f++ {
+		if !summableStatsField(f) {
+			continue
+		}
+		switch v.Field(f).Kind() {
+		case reflect.Uint64:
+			v.Field(f).SetUint(v.Field(f).Uint() / n)
+		case reflect.Float64:
+			v.Field(f).SetFloat(v.Field(f).Float() / float64(n))
+		}
+	}
+	for k := range avg.MissHistogram {
+		avg.MissHistogram[k] /= int(n)
+	}
+	avg.HitRatio = avg.CacheHitRatio()
+	return avg
+}
+
+// PercentileStats returns the p-th percentile (0 to 100) of a single field
+// across a list of stats buckets, using the nearest-rank method. Field is the
+// API name of the field, such as "requests" or "miss_time".
+func PercentileStats(stats []*Stats, field string, p float64) (float64, error) {
+	if len(stats) == 0 {
+		return 0, fmt.Errorf("no stats")
+	}
+	if p < 0 || p > 100 {
+		return 0, fmt.Errorf("percentile %v out of range", p)
+	}
+
+	idx := -1
+	t := reflect.TypeOf(Stats{})
+	for f := 0; f < t.NumField(); f++ {
+		k := t.Field(f).Type.Kind()
+		if t.Field(f).Tag.Get("mapstructure") == field && summableStatsField(f) && (k == reflect.Uint64 || k == reflect.Float64) {
+			idx = f
+			break
+		}
+	}
+	if idx < 0 {
+		return 0, fmt.Errorf("Unknown stats field %q", field)
+	}
+
+	values := make([]float64, len(stats))
+	for n, s := range stats {
+		v := reflect.ValueOf(s).Elem().Field(idx)
+		if v.Kind() == reflect.Uint64 {
+			values[n] = float64(v.Uint())
+		} else {
+			values[n] = v.Float()
+		}
+	}
+	sort.Float64s(values)
+
+	rank := int(math.Ceil(p / 100 * float64(len(values))))
+	if rank < 1 {
+		rank = 1
+	}
+	return values[rank-1], nil
+}
+
+// MergeStatsByTime sums several series of stats buckets, such as the results
+// of GetStats for each region, into a single series. Buckets with the same
+// StartTime are summed with SumStats, and the result is sorted by StartTime.
+func MergeStatsByTime(series ...[]*Stats) []*Stats {
+	buckets := make(map[uint64][]*Stats)
+	for _, s := range series {
+		for _, b := range s {
+			buckets[b.StartTime] = append(buckets[b.StartTime], b)
+		}
+	}
+
+	times := make([]uint64, 0, len(buckets))
+	for t := range buckets {
+		times = append(times, t)
+	}
+	sort.Sort(uint64s(times))
+
+	merged := make([]*Stats, len(times))
+	for n, t := range times {
+		merged[n] = SumStats(buckets[t])
+	}
+	return merged
+}
+
+// statsBucketSeconds is the length in seconds of the buckets for each value
+// of By accepted by the stats API.
+var statsBucketSeconds = map[string]uint64{
+	"minute": 60,
+	"hour":   60 * 60,
+	"day":    24 * 60 * 60,
+}
+
+// DownsampleStats groups stats buckets into larger buckets, such as minute
+// buckets from GetStats into hour or day buckets, so long ranges can be
+// charted from a single query. By is "minute", "hour" or "day", as in
+// GetStatsInput. Buckets are aligned to UTC boundaries and combined with
+// SumStats: counters and times are summed, and HitRatio is recomputed from
+// the summed hits and misses rather than averaged. Buckets of different
+// services are kept apart. The result is sorted by StartTime, and its first
+// and last buckets cover only the part of the range which was given.
+func DownsampleStats(stats []*Stats, by string) ([]*Stats, error) {
+	size, ok := statsBucketSeconds[by]
+	if !ok {
+		return nil, fmt.Errorf("unknown stats bucket size %q", by)
+	}
+
+	type key struct {
+		start   uint64
+		service string
+	}
+	buckets := make(map[key][]*Stats)
+	var keys []key
+	for _, s := range stats {
+		k := key{start: s.StartTime - s.StartTime%size, service: s.ServiceID}
+		if _, ok := buckets[k]; !ok {
+			keys = append(keys, k)
+		}
+		buckets[k] = append(buckets[k], s)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i].start != keys[j].start {
+			return keys[i].start < keys[j].start
+		}
+		return keys[i].service < keys[j].service
+	})
+
+	out := make([]*Stats, len(keys))
+	for n, k := range keys {
+		out[n] = SumStats(buckets[k])
+		out[n].StartTime = k.start
+	}
+	return out, nil
+}
+
+// summableStatsField reports whether the Stats field at index f is a counter
+// or time which can be summed, as opposed to an identifier or ratio.
+func summableStatsField(f int) bool {
+	switch reflect.TypeOf(Stats{}).Field(f).Name {
+	case "ServiceID", "StartTime", "HitRatio":
+		return false
+	}
+	return true
+}
+
+// uint64s is a sortable list of uint64s.
+type uint64s []uint64
+
+// Len, Swap, and Less implement the sortable interface.
+func (s uint64s) Len() int           { return len(s) }
+func (s uint64s) Swap(i, j int)      { s[i], s[j] = s[j], s[i] }
+func (s uint64s) Less(i, j int) bool { return s[i] < s[j] }
@@ -0,0 +1,149 @@
+package fastly
+
+import "testing"
+
+func testStatsSeries() []*Stats {
+	return []*Stats{
+		{ServiceID: testServiceID, StartTime: 120, Requests: 100, Hits: 90, Miss: 10, MissTime: 0.5, Status4xx: 4, Status5xx: 1, MissHistogram: map[int]int{10: 6, 20: 4}},
+		{ServiceID: testServiceID, StartTime: 60, Requests: 200, Hits: 150, Miss: 50, MissTime: 1.5, Status4xx: 10, Status5xx: 9, MissHistogram: map[int]int{10: 50}},
+		{ServiceID: testServiceID, StartTime: 180, Requests: 300, Hits: 240, Miss: 60, MissTime: 2, Status5xx: 2},
+	}
+}
+
+func TestStats_derivedMetrics(t *testing.T) {
+	s := &Stats{Requests: 200, Hits: 150, Miss: 50, Status4xx: 10, Status5xx: 9}
+	if r := s.CacheHitRatio(); r != 0.75 {
+		t.Errorf("bad cache hit ratio: %v", r)
+	}
+	if r := s.ErrorRate(); r != 0.045 {
+		t.Errorf("bad error rate: %v", r)
+	}
+	if r := s.ClientErrorRate(); r != 0.05 {
+		t.Errorf("bad client error rate: %v", r)
+	}
+
+	empty := &Stats{}
+	if empty.CacheHitRatio() != 0 || empty.ErrorRate() != 0 || empty.ClientErrorRate() != 0 {
+		t.Errorf("bad empty stats: %v", empty)
+	}
+}
+
+func TestSumStats(t *testing.T) {
+	sum := SumStats(testStatsSeries())
+	if sum.Requests != 600 || sum.Hits != 480 || sum.Miss != 120 {
+		t.Errorf("bad counters: %#v", sum)
+	}
+	if sum.MissTime != 4 {
+		t.Errorf("bad miss_time: %v", sum.MissTime)
+	}
+	if sum.HitRatio != 0.8 {
+		t.Errorf("bad hit_ratio: %v", sum.HitRatio)
+	}
+	if sum.StartTime != 60 || sum.ServiceID != testServiceID {
+		t.Errorf("bad identity: %q %d", sum.ServiceID, sum.StartTime)
+	}
+	if sum.MissHistogram[10] != 56 || sum.MissHistogram[20] != 4 {
+		t.Errorf("bad miss_histogram: %v", sum.MissHistogram)
+	}
+
+	if SumStats(nil) != nil {
+		t.Error("expected nil for no stats")
+	}
+}
+
+func TestAverageStats(t *testing.T) {
+	avg := AverageStats(testStatsSeries())
+	if avg.Requests != 200 || avg.Status5xx != 4 {
+		t.Errorf("bad counters: %#v", avg)
+	}
+	if avg.HitRatio != 0.8 {
+		t.Errorf("bad hit_ratio: %v", avg.HitRatio)
+	}
+}
+
+func TestPercentileStats(t *testing.T) {
+	for _, tc := range []struct {
+		p        float64
+		expected float64
+	}{
+		{0, 100},
+		{50, 200},
+		{95, 300},
+		{100, 300},
+	} {
+		v, err := PercentileStats(testStatsSeries(), "requests", tc.p)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if v != tc.expected {
+			t.Errorf("p%v: expected %v, got %v", tc.p, tc.expected, v)
+		}
+	}
+
+	if _, err := PercentileStats(testStatsSeries(), "miss_histogram", 50); err == nil {
+		t.Error("expected error for non-numeric field")
+	}
+	if _, err := PercentileStats(testStatsSeries(), "requests", 101); err == nil {
+		t.Error("expected error for out of range percentile")
+	}
+}
+
+func TestMergeStatsByTime(t *testing.T) {
+	usa := []*Stats{{StartTime: 60, Requests: 10}, {StartTime: 120, Requests: 20}}
+	europe := []*Stats{{StartTime: 120, Requests: 5}, {StartTime: 180, Requests: 7}}
+
+	merged := MergeStatsByTime(usa, europe)
+	if len(merged) != 3 {
+		t.Fatalf("bad merged: %v", merged)
+	}
+	for i, expected := range []uint64{10, 25, 7} {
+		if merged[i].Requests != expected {
+			t.Errorf("bucket %d: expected %d requests, got %d", i, expected, merged[i].Requests)
+		}
+	}
+	if merged[0].StartTime != 60 || merged[2].StartTime != 180 {
+		t.Errorf("bad order: %d, %d", merged[0].StartTime, merged[2].StartTime)
+	}
+}
+
+func TestDownsampleStats(t *testing.T) {
+	minutes := []*Stats{
+		{ServiceID: testServiceID, StartTime: 3540, Requests: 10, Hits: 10},
+		{ServiceID: testServiceID, StartTime: 3600, Requests: 100, Hits: 90, Miss: 10, MissTime: 0.5},
+		{ServiceID: testServiceID, StartTime: 3660, Requests: 200, Hits: 110, Miss: 90, MissTime: 1.5},
+		{ServiceID: "other", StartTime: 3600, Requests: 5, Hits: 5},
+	}
+
+	hours, err := DownsampleStats(minutes, "hour")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(hours) != 3 {
+		t.Fatalf("expected 3 buckets, got %d", len(hours))
+	}
+	if hours[0].StartTime != 0 || hours[0].Requests != 10 {
+		t.Errorf("bad first bucket: %#v", hours[0])
+	}
+	if hours[2].ServiceID != "other" || hours[2].Requests != 5 {
+		t.Errorf("expected services to be kept apart: %#v", hours[2])
+	}
+	h := hours[1]
+	if h.StartTime != 3600 || h.Requests != 300 || h.MissTime != 2 {
+		t.Errorf("bad summed bucket: %#v", h)
+	}
+	if h.HitRatio != 2.0/3 {
+		t.Errorf("expected hit_ratio to be recomputed, got %v", h.HitRatio)
+	}
+
+	days, err := DownsampleStats(minutes, "day")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(days) != 2 || days[0].StartTime != 0 {
+		t.Errorf("bad day buckets: %#v", days)
+	}
+
+	if _, err := DownsampleStats(minutes, "week"); err == nil {
+		t.Error("expected an unknown bucket size to be rejected")
+	}
+}
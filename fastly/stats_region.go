@@ -0,0 +1,86 @@
+package fastly
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Region is a Fastly region which stats can be filtered by.
+type Region string
+
+// These are the regions Fastly reports stats for. Regions added since can be
+// used by converting their name to a Region.
+const (
+	RegionUSA            Region = "usa"
+	RegionEurope         Region = "europe"
+	RegionAsia           Region = "asia"
+	RegionAsiaIndia      Region = "asia_india"
+	RegionAsiaSouthKorea Region = "asia_south_korea"
+	RegionANZAC          Region = "anzac"
+	RegionLatAm          Region = "latam"
+	RegionSouthAfrica    Region = "south_africa"
+	RegionAfrica         Region = "africa_std"
+	RegionMexico         Region = "mexico"
+	RegionSouthAmerica   Region = "southamerica_std"
+)
+
+// knownRegions are the regions which are accepted without asking the API.
+var knownRegions = map[Region]bool{
+	RegionUSA:            true,
+	RegionEurope:         true,
+	RegionAsia:           true,
+	RegionAsiaIndia:      true,
+	RegionAsiaSouthKorea: true,
+	RegionANZAC:          true,
+	RegionLatAm:          true,
+	RegionSouthAfrica:    true,
+	RegionAfrica:         true,
+	RegionMexico:         true,
+	RegionSouthAmerica:   true,
+}
+
+// UnknownRegionError is returned by a stats query whose Region is not one of
+// the regions listed by GetRegions.
+type UnknownRegionError struct {
+	Region Region
+	Known  []string
+}
+
+// Error implements the error interface.
+func (e *UnknownRegionError) Error() string {
+	return fmt.Sprintf("unknown region %q; valid regions are %s", e.Region, strings.Join(e.Known, ", "))
+}
+
+// ValidateRegion returns an *UnknownRegionError if r is not a Fastly region.
+// The Region constants are accepted as is. Other regions are checked against
+// GetRegions, which is called once per client and cached, so typos fail
+// locally instead of returning empty stats. An empty region is valid.
+func (c *Client) ValidateRegion(r Region) error {
+	if r == "" || knownRegions[r] {
+		return nil
+	}
+
+	c.regionsMu.Lock()
+	defer c.regionsMu.Unlock()
+	if c.regions == nil {
+		rr, err := c.GetRegions()
+		if err != nil {
+			return err
+		}
+		c.regions = make(map[Region]bool, len(rr.Data))
+		for _, name := range rr.Data {
+			c.regions[Region(name)] = true
+		}
+	}
+
+	if c.regions[r] {
+		return nil
+	}
+	known := make([]string, 0, len(c.regions))
+	for name := range c.regions {
+		known = append(known, string(name))
+	}
+	sort.Strings(known)
+	return &UnknownRegionError{Region: r, Known: known}
+}
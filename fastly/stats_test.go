@@ -22,6 +22,69 @@ func TestClient_GetStats(t *testing.T) {
 
 }
 
+func TestClient_GetStats_cache(t *testing.T) {
+	t.Parallel()
+
+	cache := NewStatsCache()
+	input := &GetStatsInput{
+		Service: testServiceID,
+		From:    "1501113600",
+		To:      "1501113720",
+		By:      "minute",
+		Cache:   cache,
+	}
+
+	var err error
+	var first, second *StatsResponse
+	record(t, "stats/cached", func(c *Client) {
+		first, err = c.GetStats(input)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(first.Data) != 1 || first.Data[0].Requests != 120 {
+		t.Fatalf("bad stats: %v", first.Data)
+	}
+
+	// The second request is answered with 304 Not Modified, so the cached
+	// response is returned.
+	record(t, "stats/cached_not_modified", func(c *Client) {
+		second, err = c.GetStats(input)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second != first {
+		t.Errorf("expected cached response, got %v", second)
+	}
+}
+
+func TestClient_GetStats_zeroValueCache(t *testing.T) {
+	t.Parallel()
+
+	cache := &StatsCache{}
+	var err error
+	var stats *StatsResponse
+	record(t, "stats/cached", func(c *Client) {
+		stats, err = c.GetStats(&GetStatsInput{
+			Service: testServiceID,
+			From:    "1501113600",
+			To:      "1501113720",
+			By:      "minute",
+			Cache:   cache,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cache.entries) != 1 {
+		t.Errorf("expected the response to be cached, got %d entries", len(cache.entries))
+	}
+	if len(stats.Data) != 1 {
+		t.Errorf("bad stats: %v", stats.Data)
+	}
+}
+
 func TestClient_GetRegions(t *testing.T) {
 	t.Parallel()
 
@@ -70,3 +133,33 @@ func TestClient_GetServicesByRegionsUsage(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestClient_ValidateRegion(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	record(t, "stats/unknown_region", func(c *Client) {
+		if err = c.ValidateRegion(RegionEurope); err != nil {
+			return
+		}
+		if err = c.ValidateRegion("new_region"); err != nil {
+			return
+		}
+		_, err = c.GetStats(&GetStatsInput{
+			From:   "1 day ago",
+			To:     "now",
+			By:     "hour",
+			Region: "eurpoe",
+		})
+	})
+	rerr, ok := err.(*UnknownRegionError)
+	if !ok {
+		t.Fatalf("bad error: %v", err)
+	}
+	if rerr.Region != "eurpoe" {
+		t.Errorf("bad region: %q", rerr.Region)
+	}
+	if len(rerr.Known) != 7 || rerr.Known[0] != "anzac" {
+		t.Errorf("bad known regions: %v", rerr.Known)
+	}
+}
@@ -0,0 +1,164 @@
+package fastly
+
+import (
+	"sort"
+	"time"
+)
+
+// StatusIndicator is the overall health of the Fastly platform.
+type StatusIndicator string
+
+const (
+	// StatusIndicatorNone means all systems are operational.
+	StatusIndicatorNone StatusIndicator = "none"
+
+	// StatusIndicatorMinor, StatusIndicatorMajor and StatusIndicatorCritical
+	// are increasingly severe disruptions.
+	StatusIndicatorMinor    StatusIndicator = "minor"
+	StatusIndicatorMajor    StatusIndicator = "major"
+	StatusIndicatorCritical StatusIndicator = "critical"
+
+	// StatusIndicatorMaintenance means scheduled maintenance is in progress.
+	StatusIndicatorMaintenance StatusIndicator = "maintenance"
+)
+
+// ComponentStatus is the status of a single platform component.
+type ComponentStatus string
+
+// These are the statuses a component may have, from healthy to unavailable.
+// A component under maintenance is unavailable by plan.
+const (
+	ComponentStatusOperational         ComponentStatus = "operational"
+	ComponentStatusDegradedPerformance ComponentStatus = "degraded_performance"
+	ComponentStatusPartialOutage       ComponentStatus = "partial_outage"
+	ComponentStatusMajorOutage         ComponentStatus = "major_outage"
+	ComponentStatusUnderMaintenance    ComponentStatus = "under_maintenance"
+)
+
+// PlatformStatus is the overall status of the Fastly platform.
+type PlatformStatus struct {
+	Indicator   StatusIndicator `mapstructure:"indicator"`
+	Description string          `mapstructure:"description"`
+}
+
+// StatusComponent is a part of the Fastly platform, such as a POP or an API,
+// whose status is reported separately. Components may be grouped, in which
+// case GroupID is the ID of the group component.
+type StatusComponent struct {
+	ID          string          `mapstructure:"id"`
+	Name        string          `mapstructure:"name"`
+	Description string          `mapstructure:"description"`
+	Status      ComponentStatus `mapstructure:"status"`
+	Group       bool            `mapstructure:"group"`
+	GroupID     string          `mapstructure:"group_id"`
+	UpdatedAt   *time.Time      `mapstructure:"updated_at"`
+}
+
+// statusComponentsByName is a sortable list of status components.
+type statusComponentsByName []*StatusComponent
+
+// Len, Swap, and Less implement the sortable interface.
+func (s statusComponentsByName) Len() int      { return len(s) }
+func (s statusComponentsByName) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s statusComponentsByName) Less(i, j int) bool {
+	return s[i].Name < s[j].Name
+}
+
+// StatusIncident is an incident reported on the Fastly status page. Status is
+// the progress of the incident, such as "investigating" or "resolved", and
+// Impact is its severity, such as "minor" or "major".
+type StatusIncident struct {
+	ID         string                  `mapstructure:"id"`
+	Name       string                  `mapstructure:"name"`
+	Status     string                  `mapstructure:"status"`
+	Impact     string                  `mapstructure:"impact"`
+	Shortlink  string                  `mapstructure:"shortlink"`
+	CreatedAt  *time.Time              `mapstructure:"created_at"`
+	UpdatedAt  *time.Time              `mapstructure:"updated_at"`
+	ResolvedAt *time.Time              `mapstructure:"resolved_at"`
+	Updates    []*StatusIncidentUpdate `mapstructure:"incident_updates"`
+	Components []*StatusComponent      `mapstructure:"components"`
+}
+
+// StatusIncidentUpdate is a single update posted to an incident, newest
+// first.
+type StatusIncidentUpdate struct {
+	ID        string     `mapstructure:"id"`
+	Status    string     `mapstructure:"status"`
+	Body      string     `mapstructure:"body"`
+	CreatedAt *time.Time `mapstructure:"created_at"`
+}
+
+// StatusSummary is the status of the platform, every component and every
+// unresolved incident.
+type StatusSummary struct {
+	Status     *PlatformStatus    `mapstructure:"status"`
+	Components []*StatusComponent `mapstructure:"components"`
+	Incidents  []*StatusIncident  `mapstructure:"incidents"`
+}
+
+// Operational reports whether the platform has no unresolved incidents and
+// every component is operational. Deploy tooling can use it to hold
+// activations while Fastly is having an incident.
+func (s *StatusSummary) Operational() bool {
+	if len(s.Incidents) > 0 {
+		return false
+	}
+	for _, c := range s.Components {
+		if c.Status != ComponentStatusOperational {
+			return false
+		}
+	}
+	return true
+}
+
+// GetStatusSummary returns the current status of the platform, its components
+// and unresolved incidents. Components are sorted by name.
+func (c *StatusClient) GetStatusSummary() (*StatusSummary, error) {
+	resp, err := c.client.Get("/api/v2/summary.json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var s *StatusSummary
+	if err := decodeJSON(&s, resp.Body); err != nil {
+		return nil, err
+	}
+	sort.Stable(statusComponentsByName(s.Components))
+	return s, nil
+}
+
+// ListStatusComponents returns the status of every platform component, sorted
+// by name.
+func (c *StatusClient) ListStatusComponents() ([]*StatusComponent, error) {
+	resp, err := c.client.Get("/api/v2/components.json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *struct {
+		Components []*StatusComponent `mapstructure:"components"`
+	}
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	sort.Stable(statusComponentsByName(r.Components))
+	return r.Components, nil
+}
+
+// ListUnresolvedIncidents returns the incidents that are still ongoing, newest
+// first.
+func (c *StatusClient) ListUnresolvedIncidents() ([]*StatusIncident, error) {
+	resp, err := c.client.Get("/api/v2/incidents/unresolved.json", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var r *struct {
+		Incidents []*StatusIncident `mapstructure:"incidents"`
+	}
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	return r.Incidents, nil
+}
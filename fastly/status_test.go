@@ -0,0 +1,75 @@
+package fastly
+
+import "testing"
+
+func TestStatusClient_GetStatusSummary(t *testing.T) {
+	t.Parallel()
+
+	var s *StatusSummary
+	var err error
+	recordStatus(t, "status/summary", func(c *StatusClient) {
+		s, err = c.GetStatusSummary()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Status.Indicator != StatusIndicatorMinor {
+		t.Errorf("bad indicator: %q", s.Status.Indicator)
+	}
+	if len(s.Components) != 3 || s.Components[0].Name != "API" {
+		t.Errorf("bad components: %v", s.Components)
+	}
+	if s.Components[0].Status != ComponentStatusDegradedPerformance {
+		t.Errorf("bad component status: %q", s.Components[0].Status)
+	}
+	if len(s.Incidents) != 1 || s.Incidents[0].ResolvedAt != nil {
+		t.Errorf("bad incidents: %v", s.Incidents)
+	}
+	if s.Operational() {
+		t.Error("expected platform not to be operational")
+	}
+}
+
+func TestStatusClient_ListStatusComponents(t *testing.T) {
+	t.Parallel()
+
+	var cs []*StatusComponent
+	var err error
+	recordStatus(t, "status/components", func(c *StatusClient) {
+		cs, err = c.ListStatusComponents()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cs) != 3 {
+		t.Fatalf("bad components: %v", cs)
+	}
+	if cs[1].GroupID != "b7vgwdq2p1zm" {
+		t.Errorf("bad group id: %q", cs[1].GroupID)
+	}
+	if !cs[2].Group {
+		t.Errorf("expected %q to be a group", cs[2].Name)
+	}
+}
+
+func TestStatusClient_ListUnresolvedIncidents(t *testing.T) {
+	t.Parallel()
+
+	var is []*StatusIncident
+	var err error
+	recordStatus(t, "status/unresolved", func(c *StatusClient) {
+		is, err = c.ListUnresolvedIncidents()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(is) != 1 {
+		t.Fatalf("bad incidents: %v", is)
+	}
+	if is[0].Impact != "minor" || len(is[0].Updates) != 1 {
+		t.Errorf("bad incident: %v", is[0])
+	}
+	if is[0].CreatedAt == nil || is[0].CreatedAt.Minute() != 38 {
+		t.Errorf("bad created at: %v", is[0].CreatedAt)
+	}
+}
@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"net/url"
 	"sort"
 	"time"
 )
@@ -19,7 +20,7 @@ type Syslog struct {
 	IPV4              string     `mapstructure:"ipv4"`
 	TLSCACert         string     `mapstructure:"tls_ca_cert"`
 	TLSHostname       string     `mapstructure:"tls_hostname"`
-	Token             string     `mapstructure:"token"`
+	Token             string     `mapstructure:"token" sensitive:"true"`
 	Format            string     `mapstructure:"format"`
 	FormatVersion     uint       `mapstructure:"format_version"`
 	MessageType       string     `mapstructure:"message_type"`
@@ -29,6 +30,16 @@ type Syslog struct {
 	DeletedAt         *time.Time `mapstructure:"deleted_at"`
 }
 
+// String formats the syslog endpoint with its token redacted.
+func (s *Syslog) String() string {
+	return redactedString(s)
+}
+
+// GoString is like String, for the %#v verb.
+func (s *Syslog) GoString() string {
+	return redactedGoString(s)
+}
+
 // syslogsByName is a sortable list of syslogs.
 type syslogsByName []*Syslog
 
@@ -87,7 +98,7 @@ type CreateSyslogInput struct {
 	IPV4              string       `form:"ipv4,omitempty"`
 	TLSCACert         string       `form:"tls_ca_cert,omitempty"`
 	TLSHostname       string       `form:"tls_hostname,omitempty"`
-	Token             string       `form:"token,omitempty"`
+	Token             string       `form:"token,omitempty" sensitive:"true"`
 	Format            string       `form:"format,omitempty"`
 	FormatVersion     uint         `form:"format_version,omitempty"`
 	MessageType       string       `form:"message_type,omitempty"`
@@ -106,7 +117,7 @@ func (c *Client) CreateSyslog(i *CreateSyslogInput) (*Syslog, error) {
 	}
 
 	path := fmt.Sprintf("/service/%s/version/%d/logging/syslog", i.Service, i.Version)
-	resp, err := c.PostForm(path, i, nil)
+	resp, err := c.PostForm(path, c.withLoggingDefaults(i), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -143,7 +154,7 @@ func (c *Client) GetSyslog(i *GetSyslogInput) (*Syslog, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/syslog/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/logging/syslog/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Get(path, nil)
 	if err != nil {
 		return nil, err
@@ -174,7 +185,7 @@ type UpdateSyslogInput struct {
 	IPV4              string       `form:"ipv4,omitempty"`
 	TLSCACert         string       `form:"tls_ca_cert,omitempty"`
 	TLSHostname       string       `form:"tls_hostname,omitempty"`
-	Token             string       `form:"token,omitempty"`
+	Token             string       `form:"token,omitempty" sensitive:"true"`
 	Format            string       `form:"format,omitempty"`
 	FormatVersion     uint         `form:"format_version,omitempty"`
 	MessageType       string       `form:"message_type,omitempty"`
@@ -195,7 +206,7 @@ func (c *Client) UpdateSyslog(i *UpdateSyslogInput) (*Syslog, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/syslog/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/logging/syslog/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
 		return nil, err
@@ -233,7 +244,7 @@ func (c *Client) DeleteSyslog(i *DeleteSyslogInput) error {
 		return ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/logging/syslog/%s", i.Service, i.Version, i.Name)
+	path := fmt.Sprintf("/service/%s/version/%d/logging/syslog/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Delete(path, nil)
 	if err != nil {
 		return err
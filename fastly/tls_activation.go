@@ -0,0 +1,188 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/google/jsonapi"
+)
+
+// TLSActivation enables TLS for a domain using a certificate, a TLS
+// configuration and, optionally, a mutual authentication configuration.
+type TLSActivation struct {
+	ID                   string                `jsonapi:"primary,tls_activation"`
+	CreatedAt            string                `jsonapi:"attr,created_at,omitempty"`
+	Certificate          *CustomTLSCertificate `jsonapi:"relation,tls_certificate,omitempty"`
+	Configuration        *TLSConfiguration     `jsonapi:"relation,tls_configuration,omitempty"`
+	Domain               *TLSDomain            `jsonapi:"relation,tls_domain,omitempty"`
+	MutualAuthentication *MutualAuthentication `jsonapi:"relation,mutual_authentication,omitempty"`
+}
+
+// tlsActivationType is used for reflection because JSONAPI wants to know what
+// it's decoding into.
+var tlsActivationType = reflect.TypeOf(new(TLSActivation))
+
+// ListTLSActivationsInput is used as input to the ListTLSActivations
+// function.
+type ListTLSActivationsInput struct {
+	// FilterCertificate limits the returned activations to those using the
+	// given certificate ID. Optional.
+	FilterCertificate string
+
+	// FilterDomain limits the returned activations to those for the given
+	// domain. Optional.
+	FilterDomain string
+
+	// MaxResults is the number of activations to return per request.
+	// Optional.
+	MaxResults int
+}
+
+// ListTLSActivations returns every TLS activation for the account, following
+// every page of results.
+func (c *Client) ListTLSActivations(i *ListTLSActivationsInput) ([]*TLSActivation, error) {
+	params := map[string]string{}
+	if i.FilterCertificate != "" {
+		params["filter[tls_certificate.id]"] = i.FilterCertificate
+	}
+	if i.FilterDomain != "" {
+		params["filter[tls_domain.id]"] = i.FilterDomain
+	}
+	if i.MaxResults != 0 {
+		params["page[size]"] = strconv.Itoa(i.MaxResults)
+	}
+
+	data, err := c.getAllJSONAPIPages("/tls/activations", params, tlsActivationType)
+	if err != nil {
+		return nil, err
+	}
+
+	activations := make([]*TLSActivation, len(data))
+	for i := range data {
+		typed, ok := data[i].(*TLSActivation)
+		if !ok {
+			return nil, fmt.Errorf("got back a non-TLSActivation response")
+		}
+		activations[i] = typed
+	}
+	return activations, nil
+}
+
+// GetTLSActivationInput is used as input to the GetTLSActivation function.
+type GetTLSActivationInput struct {
+	// ID is the ID of the activation and is required.
+	ID string
+}
+
+// GetTLSActivation gets the TLS activation with the given ID.
+func (c *Client) GetTLSActivation(i *GetTLSActivationInput) (*TLSActivation, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/tls/activations/%s", i.ID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var activation TLSActivation
+	if err := jsonapi.UnmarshalPayload(resp.Body, &activation); err != nil {
+		return nil, err
+	}
+	return &activation, nil
+}
+
+// CreateTLSActivationInput is used as input to the CreateTLSActivation
+// function.
+type CreateTLSActivationInput struct {
+	// Certificate is the certificate to serve and Domain is the domain to
+	// serve it for. Both fields are required.
+	Certificate *CustomTLSCertificate
+	Domain      *TLSDomain
+
+	// Configuration is the TLS configuration the domain is served with.
+	// Optional; the default is the one returned by
+	// GetDefaultTLSConfiguration.
+	Configuration *TLSConfiguration
+
+	// MutualAuthentication is the mutual authentication configuration to
+	// require of clients. Optional.
+	MutualAuthentication *MutualAuthentication
+}
+
+// createTLSActivationPayload is the request body of CreateTLSActivation.
+type createTLSActivationPayload struct {
+	ID                   string                     `jsonapi:"primary,tls_activation"`
+	Certificate          *CustomTLSCertificate      `jsonapi:"relation,tls_certificate"`
+	Domain               *TLSDomain                 `jsonapi:"relation,tls_domain"`
+	Configuration        *tlsConfigurationReference `jsonapi:"relation,tls_configuration"`
+	MutualAuthentication *MutualAuthentication      `jsonapi:"relation,mutual_authentication,omitempty"`
+}
+
+// CreateTLSActivation enables TLS for a domain with a custom certificate.
+func (c *Client) CreateTLSActivation(i *CreateTLSActivationInput) (*TLSActivation, error) {
+	if i.Certificate == nil {
+		return nil, ErrMissingCertificate
+	}
+
+	if i.Domain == nil {
+		return nil, ErrMissingDomain
+	}
+
+	config, err := c.tlsConfigurationOrDefault(i.Configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.PostJSONAPI("/tls/activations", &createTLSActivationPayload{
+		Certificate:          i.Certificate,
+		Domain:               i.Domain,
+		Configuration:        config,
+		MutualAuthentication: i.MutualAuthentication,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var activation TLSActivation
+	if err := jsonapi.UnmarshalPayload(resp.Body, &activation); err != nil {
+		return nil, err
+	}
+	return &activation, nil
+}
+
+// UpdateTLSActivationInput is used as input to the UpdateTLSActivation
+// function.
+type UpdateTLSActivationInput struct {
+	// ID is the ID of the activation and is required.
+	ID string `jsonapi:"primary,tls_activation"`
+
+	// Certificate is the certificate to serve for the domain. Optional.
+	Certificate *CustomTLSCertificate `jsonapi:"relation,tls_certificate,omitempty"`
+
+	// MutualAuthentication is the mutual authentication configuration to
+	// require of clients. Optional.
+	MutualAuthentication *MutualAuthentication `jsonapi:"relation,mutual_authentication,omitempty"`
+}
+
+// UpdateTLSActivation updates the certificate or mutual authentication
+// configuration used by a TLS activation.
+func (c *Client) UpdateTLSActivation(i *UpdateTLSActivationInput) (*TLSActivation, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/tls/activations/%s", i.ID)
+	resp, err := c.PatchJSONAPI(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var activation TLSActivation
+	if err := jsonapi.UnmarshalPayload(resp.Body, &activation); err != nil {
+		return nil, err
+	}
+	return &activation, nil
+}
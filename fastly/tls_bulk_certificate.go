@@ -0,0 +1,90 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/google/jsonapi"
+)
+
+// BulkCertificate is a TLS certificate uploaded through the Platform TLS
+// (bulk certificate) product. NotAfter and NotBefore are RFC 3339 timestamps.
+type BulkCertificate struct {
+	ID        string       `jsonapi:"primary,tls_bulk_certificate"`
+	Replace   bool         `jsonapi:"attr,replace,omitempty"`
+	NotAfter  string       `jsonapi:"attr,not_after,omitempty"`
+	NotBefore string       `jsonapi:"attr,not_before,omitempty"`
+	CreatedAt string       `jsonapi:"attr,created_at,omitempty"`
+	UpdatedAt string       `jsonapi:"attr,updated_at,omitempty"`
+	Domains   []*TLSDomain `jsonapi:"relation,tls_domains,omitempty"`
+}
+
+// bulkCertificateType is used for reflection because JSONAPI wants to know
+// what it's decoding into.
+var bulkCertificateType = reflect.TypeOf(new(BulkCertificate))
+
+// ListBulkCertificatesInput is used as input to the ListBulkCertificates
+// function.
+type ListBulkCertificatesInput struct {
+	// FilterDomain limits the returned certificates to those covering the
+	// given domain. Optional.
+	FilterDomain string
+
+	// MaxResults is the number of certificates to return per request.
+	// Optional.
+	MaxResults int
+}
+
+// ListBulkCertificates returns every bulk TLS certificate for the account,
+// following every page of results.
+func (c *Client) ListBulkCertificates(i *ListBulkCertificatesInput) ([]*BulkCertificate, error) {
+	params := map[string]string{}
+	if i.FilterDomain != "" {
+		params["filter[tls_domain.id][match]"] = i.FilterDomain
+	}
+	if i.MaxResults != 0 {
+		params["page[size]"] = strconv.Itoa(i.MaxResults)
+	}
+
+	data, err := c.getAllJSONAPIPages("/tls/bulk/certificates", params, bulkCertificateType)
+	if err != nil {
+		return nil, err
+	}
+
+	certs := make([]*BulkCertificate, len(data))
+	for i := range data {
+		typed, ok := data[i].(*BulkCertificate)
+		if !ok {
+			return nil, fmt.Errorf("got back a non-BulkCertificate response")
+		}
+		certs[i] = typed
+	}
+	return certs, nil
+}
+
+// GetBulkCertificateInput is used as input to the GetBulkCertificate
+// function.
+type GetBulkCertificateInput struct {
+	// ID is the ID of the certificate and is required.
+	ID string
+}
+
+// GetBulkCertificate gets the bulk TLS certificate with the given ID.
+func (c *Client) GetBulkCertificate(i *GetBulkCertificateInput) (*BulkCertificate, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/tls/bulk/certificates/%s", i.ID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var cert BulkCertificate
+	if err := jsonapi.UnmarshalPayload(resp.Body, &cert); err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
@@ -0,0 +1,177 @@
+package fastly
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// TLSCertificateKind identifies where a certificate in an expiry report came
+// from.
+type TLSCertificateKind string
+
+const (
+	// TLSCertificateKindCustom is a custom certificate uploaded to Fastly.
+	TLSCertificateKindCustom TLSCertificateKind = "custom"
+
+	// TLSCertificateKindBulk is a Platform TLS (bulk) certificate.
+	TLSCertificateKindBulk TLSCertificateKind = "bulk"
+
+	// TLSCertificateKindSubscription is a certificate managed by a Fastly TLS
+	// subscription.
+	TLSCertificateKindSubscription TLSCertificateKind = "subscription"
+)
+
+// TLSCertificateExpiry is a single entry in a certificate expiry report.
+type TLSCertificateExpiry struct {
+	// Kind is where the certificate came from.
+	Kind TLSCertificateKind
+
+	// ID is the ID of the certificate, or of the subscription for
+	// TLSCertificateKindSubscription.
+	ID string
+
+	// Name is the certificate name. It is only set for custom certificates.
+	Name string
+
+	// Domains are the domains covered by the certificate.
+	Domains []string
+
+	// NotAfter is when the certificate expires.
+	NotAfter time.Time
+
+	// DaysUntilExpiry is the number of whole days until NotAfter, rounded
+	// down. It is negative for certificates which have already expired, so a
+	// certificate which expired an hour ago has -1 and one which expires in
+	// an hour has 0.
+	DaysUntilExpiry int
+}
+
+// ListExpiringTLSCertificatesInput is used as input to the
+// ListExpiringTLSCertificates function.
+type ListExpiringTLSCertificatesInput struct {
+	// Within is the threshold, in days. Certificates expiring in fewer than
+	// this many days are returned. Required.
+	Within int
+
+	// Now is the time to compute expiry from. The default is the current
+	// time.
+	Now time.Time
+}
+
+// ListExpiringTLSCertificates lists every custom certificate, bulk certificate
+// and TLS subscription on the account and returns those expiring in fewer
+// than Within days, soonest first. For subscriptions, the most recently
+// issued certificate is used.
+func (c *Client) ListExpiringTLSCertificates(i *ListExpiringTLSCertificatesInput) ([]*TLSCertificateExpiry, error) {
+	if i.Within <= 0 {
+		return nil, ErrMissingWithin
+	}
+
+	now := i.Now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	var all []*TLSCertificateExpiry
+
+	custom, err := c.ListCustomTLSCertificates(&ListCustomTLSCertificatesInput{})
+	if err != nil {
+		return nil, err
+	}
+	for _, cert := range custom {
+		e, err := newTLSCertificateExpiry(TLSCertificateKindCustom, cert.ID, cert.NotAfter, cert.Domains, now)
+		if err != nil {
+			return nil, err
+		}
+		e.Name = cert.Name
+		all = append(all, e)
+	}
+
+	bulk, err := c.ListBulkCertificates(&ListBulkCertificatesInput{})
+	if err != nil {
+		return nil, err
+	}
+	for _, cert := range bulk {
+		e, err := newTLSCertificateExpiry(TLSCertificateKindBulk, cert.ID, cert.NotAfter, cert.Domains, now)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, e)
+	}
+
+	subs, err := c.ListTLSSubscriptions(&ListTLSSubscriptionsInput{
+		Include: "tls_certificates",
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, sub := range subs {
+		var latest string
+		var latestTime time.Time
+		for _, cert := range sub.Certificates {
+			if cert.NotAfter == "" {
+				continue
+			}
+			t, err := time.Parse(time.RFC3339, cert.NotAfter)
+			if err != nil {
+				return nil, err
+			}
+			if t.After(latestTime) {
+				latest, latestTime = cert.NotAfter, t
+			}
+		}
+		// Subscriptions which have not been issued a certificate yet have
+		// nothing to expire.
+		if latest == "" {
+			continue
+		}
+		e, err := newTLSCertificateExpiry(TLSCertificateKindSubscription, sub.ID, latest, sub.Domains, now)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, e)
+	}
+
+	expiring := make([]*TLSCertificateExpiry, 0, len(all))
+	for _, e := range all {
+		if e.DaysUntilExpiry < i.Within {
+			expiring = append(expiring, e)
+		}
+	}
+	sort.Sort(tlsCertificateExpiriesByNotAfter(expiring))
+	return expiring, nil
+}
+
+// newTLSCertificateExpiry builds a report entry from a certificate's RFC 3339
+// expiry timestamp.
+func newTLSCertificateExpiry(kind TLSCertificateKind, id, notAfter string, domains []*TLSDomain, now time.Time) (*TLSCertificateExpiry, error) {
+	t, err := time.Parse(time.RFC3339, notAfter)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make([]string, len(domains))
+	for i, d := range domains {
+		names[i] = d.ID
+	}
+
+	return &TLSCertificateExpiry{
+		Kind:            kind,
+		ID:              id,
+		Domains:         names,
+		NotAfter:        t,
+		DaysUntilExpiry: int(math.Floor(t.Sub(now).Hours() / 24)),
+	}, nil
+}
+
+// tlsCertificateExpiriesByNotAfter is a sortable list of expiry report
+// entries.
+type tlsCertificateExpiriesByNotAfter []*TLSCertificateExpiry
+
+// Len, Swap, and Less implement the sortable interface.
+func (s tlsCertificateExpiriesByNotAfter) Len() int      { return len(s) }
+func (s tlsCertificateExpiriesByNotAfter) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s tlsCertificateExpiriesByNotAfter) Less(i, j int) bool {
+	return s[i].NotAfter.Before(s[j].NotAfter)
+}
@@ -0,0 +1,98 @@
+package fastly
+
+import (
+	"testing"
+	"time"
+)
+
+func TestClient_ListExpiringTLSCertificates(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2020, 5, 5, 0, 0, 0, 0, time.UTC)
+
+	var err error
+	var certs []*TLSCertificateExpiry
+	record(t, "tls/expiring", func(c *Client) {
+		certs, err = c.ListExpiringTLSCertificates(&ListExpiringTLSCertificatesInput{
+			Within: 30,
+			Now:    now,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(certs) != 3 {
+		t.Fatalf("bad certs: %v", certs)
+	}
+
+	if certs[0].Kind != TLSCertificateKindBulk || certs[0].ID != "7qXiAwQtDqb0YBrK1wZqd1" {
+		t.Errorf("bad cert: %#v", certs[0])
+	}
+	if certs[0].DaysUntilExpiry != 15 {
+		t.Errorf("bad days_until_expiry: %d", certs[0].DaysUntilExpiry)
+	}
+	if len(certs[0].Domains) != 2 || certs[0].Domains[1] != "www.example.org" {
+		t.Errorf("bad domains: %v", certs[0].Domains)
+	}
+
+	// The subscription is reported from its newest certificate.
+	if certs[1].Kind != TLSCertificateKindSubscription || certs[1].ID != "sUb4Wz2BvUJY8CkF6x9M1a" {
+		t.Errorf("bad cert: %#v", certs[1])
+	}
+	if certs[1].DaysUntilExpiry != 20 {
+		t.Errorf("bad days_until_expiry: %d", certs[1].DaysUntilExpiry)
+	}
+
+	if certs[2].Kind != TLSCertificateKindCustom || certs[2].Name != "www.example.com" {
+		t.Errorf("bad cert: %#v", certs[2])
+	}
+	if !certs[2].NotAfter.Equal(time.Date(2020, 6, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("bad not_after: %s", certs[2].NotAfter)
+	}
+}
+
+func TestNewTLSCertificateExpiry_daysUntilExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2020, 5, 5, 12, 0, 0, 0, time.UTC)
+	for _, tc := range []struct {
+		notAfter string
+		days     int
+	}{
+		{"2020-05-06T13:00:00Z", 1},
+		{"2020-05-05T13:00:00Z", 0},
+		{"2020-05-05T11:00:00Z", -1},
+		{"2020-05-04T11:00:00Z", -2},
+	} {
+		e, err := newTLSCertificateExpiry(TLSCertificateKindCustom, "id", tc.notAfter, nil, now)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if e.DaysUntilExpiry != tc.days {
+			t.Errorf("expected %d days until %s, got %d", tc.days, tc.notAfter, e.DaysUntilExpiry)
+		}
+	}
+}
+
+func TestClient_ListExpiringTLSCertificates_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListExpiringTLSCertificates(&ListExpiringTLSCertificatesInput{})
+	if err != ErrMissingWithin {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetCustomTLSCertificate(&GetCustomTLSCertificateInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetBulkCertificate(&GetBulkCertificateInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetTLSSubscription(&GetTLSSubscriptionInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
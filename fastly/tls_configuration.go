@@ -0,0 +1,173 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/google/jsonapi"
+)
+
+// TLSConfiguration is a set of TLS settings, such as the supported protocols,
+// used by TLS activations. DNSRecords are the records that domains using the
+// configuration should point at.
+type TLSConfiguration struct {
+	ID            string          `jsonapi:"primary,tls_configuration"`
+	Name          string          `jsonapi:"attr,name,omitempty"`
+	Default       bool            `jsonapi:"attr,default,omitempty"`
+	Bulk          bool            `jsonapi:"attr,bulk,omitempty"`
+	HTTPProtocols []string        `jsonapi:"attr,http_protocols,omitempty"`
+	TLSProtocols  []string        `jsonapi:"attr,tls_protocols,omitempty"`
+	CreatedAt     string          `jsonapi:"attr,created_at,omitempty"`
+	UpdatedAt     string          `jsonapi:"attr,updated_at,omitempty"`
+	DNSRecords    []*TLSDNSRecord `jsonapi:"relation,dns_records,omitempty"`
+}
+
+// TLSDNSRecord is a DNS record that domains using a TLS configuration should
+// point at. Its ID is the record value, such as a hostname or an IP address.
+type TLSDNSRecord struct {
+	ID         string `jsonapi:"primary,dns_record"`
+	RecordType string `jsonapi:"attr,record_type,omitempty"`
+	Region     string `jsonapi:"attr,region,omitempty"`
+}
+
+// tlsConfigurationType is used for reflection because JSONAPI wants to know
+// what it's decoding into.
+var tlsConfigurationType = reflect.TypeOf(new(TLSConfiguration))
+
+// ListTLSConfigurationsInput is used as input to the ListTLSConfigurations
+// function.
+type ListTLSConfigurationsInput struct {
+	// FilterBulk limits the returned configurations to those for bulk
+	// certificates when true, or to the others when false. Optional.
+	FilterBulk *bool
+
+	// Include is a comma-separated list of related objects to include in the
+	// response, such as "dns_records". Optional.
+	Include string
+}
+
+// ListTLSConfigurations returns every TLS configuration for the account,
+// following every page of results.
+func (c *Client) ListTLSConfigurations(i *ListTLSConfigurationsInput) ([]*TLSConfiguration, error) {
+	params := map[string]string{}
+	if i.FilterBulk != nil {
+		params["filter[bulk]"] = fmt.Sprint(*i.FilterBulk)
+	}
+	if i.Include != "" {
+		params["include"] = i.Include
+	}
+
+	data, err := c.getAllJSONAPIPages("/tls/configurations", params, tlsConfigurationType)
+	if err != nil {
+		return nil, err
+	}
+
+	configs := make([]*TLSConfiguration, len(data))
+	for i := range data {
+		typed, ok := data[i].(*TLSConfiguration)
+		if !ok {
+			return nil, fmt.Errorf("got back a non-TLSConfiguration response")
+		}
+		configs[i] = typed
+	}
+	return configs, nil
+}
+
+// GetDefaultTLSConfiguration returns the TLS configuration used by
+// CreateTLSSubscription and CreateTLSActivation when their input has none:
+// the client's DefaultTLSConfigurationID if it is set, or else the
+// configuration the account marks as its default. It returns
+// ErrNoDefaultTLSConfiguration if the account has no default.
+func (c *Client) GetDefaultTLSConfiguration() (*TLSConfiguration, error) {
+	if c.DefaultTLSConfigurationID != "" {
+		return c.GetTLSConfiguration(&GetTLSConfigurationInput{ID: c.DefaultTLSConfigurationID})
+	}
+
+	bulk := false
+	configs, err := c.ListTLSConfigurations(&ListTLSConfigurationsInput{FilterBulk: &bulk})
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range configs {
+		if config.Default {
+			return config, nil
+		}
+	}
+	return nil, ErrNoDefaultTLSConfiguration
+}
+
+// FindTLSConfiguration returns the account's TLS configuration with the
+// given name. Its ID can be set as the client's DefaultTLSConfigurationID
+// before the client is shared, so that subscriptions and activations created
+// through it use the configuration; the field is not changed here, as it is
+// read by concurrent requests.
+func (c *Client) FindTLSConfiguration(name string) (*TLSConfiguration, error) {
+	if name == "" {
+		return nil, ErrMissingName
+	}
+
+	configs, err := c.ListTLSConfigurations(&ListTLSConfigurationsInput{})
+	if err != nil {
+		return nil, err
+	}
+	for _, config := range configs {
+		if config.Name == name {
+			return config, nil
+		}
+	}
+	return nil, fmt.Errorf("no TLS configuration named %q", name)
+}
+
+// tlsConfigurationReference is a TLS configuration relationship in a request
+// body. A TLSConfiguration itself cannot be encoded, as the JSON:API encoder
+// fails on its empty list attributes.
+type tlsConfigurationReference struct {
+	ID string `jsonapi:"primary,tls_configuration"`
+}
+
+// tlsConfigurationOrDefault returns a reference to config, or to the default
+// TLS configuration if config is nil.
+func (c *Client) tlsConfigurationOrDefault(config *TLSConfiguration) (*tlsConfigurationReference, error) {
+	if config == nil {
+		var err error
+		if config, err = c.GetDefaultTLSConfiguration(); err != nil {
+			return nil, err
+		}
+	}
+	return &tlsConfigurationReference{ID: config.ID}, nil
+}
+
+// GetTLSConfigurationInput is used as input to the GetTLSConfiguration
+// function.
+type GetTLSConfigurationInput struct {
+	// ID is the ID of the configuration and is required.
+	ID string
+
+	// Include is a comma-separated list of related objects to include in the
+	// response, such as "dns_records". Optional.
+	Include string
+}
+
+// GetTLSConfiguration gets the TLS configuration with the given ID.
+func (c *Client) GetTLSConfiguration(i *GetTLSConfigurationInput) (*TLSConfiguration, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	ro := &RequestOptions{Params: map[string]string{}}
+	if i.Include != "" {
+		ro.Params["include"] = i.Include
+	}
+
+	path := fmt.Sprintf("/tls/configurations/%s", i.ID)
+	resp, err := c.Get(path, ro)
+	if err != nil {
+		return nil, err
+	}
+
+	var config TLSConfiguration
+	if err := jsonapi.UnmarshalPayload(resp.Body, &config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
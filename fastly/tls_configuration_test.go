@@ -0,0 +1,93 @@
+package fastly
+
+import "testing"
+
+func TestClient_CreateTLSSubscription_defaultConfiguration(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var sub *TLSSubscription
+	record(t, "tls/default_configuration", func(c *Client) {
+		sub, err = c.CreateTLSSubscription(&CreateTLSSubscriptionInput{
+			CertificateAuthority: "lets-encrypt",
+			Domains:              []*TLSDomain{{ID: "www.example.com"}},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub.ID != "sUbQtGqTzWAD0acXr3RG1a" {
+		t.Errorf("bad id: %q", sub.ID)
+	}
+	if sub.State != "pending" {
+		t.Errorf("bad state: %q", sub.State)
+	}
+}
+
+func TestClient_FindTLSConfiguration(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var config *TLSConfiguration
+	var activation *TLSActivation
+	record(t, "tls/find_configuration", func(c *Client) {
+		config, err = c.FindTLSConfiguration("HTTP/3")
+		if err != nil {
+			return
+		}
+		if c.DefaultTLSConfigurationID != "" {
+			t.Errorf("expected the default to be unchanged, got %q", c.DefaultTLSConfigurationID)
+		}
+		c.DefaultTLSConfigurationID = config.ID
+		activation, err = c.CreateTLSActivation(&CreateTLSActivationInput{
+			Certificate: &CustomTLSCertificate{ID: "cRTkUqPo3ZT5xQHhmEhyQ2"},
+			Domain:      &TLSDomain{ID: "www.example.com"},
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if config.Name != "HTTP/3" {
+		t.Errorf("bad name: %q", config.Name)
+	}
+	if activation.Configuration == nil || activation.Configuration.ID != "hTtP3qTzWAD0acXr3RG1aZ" {
+		t.Errorf("bad configuration: %+v", activation.Configuration)
+	}
+}
+
+func TestClient_GetDefaultTLSConfiguration_none(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	record(t, "tls/no_default_configuration", func(c *Client) {
+		_, err = c.GetDefaultTLSConfiguration()
+	})
+	if err != ErrNoDefaultTLSConfiguration {
+		t.Errorf("bad error: %v", err)
+	}
+}
+
+func TestClient_DefaultTLSConfiguration_validation(t *testing.T) {
+	var err error
+	_, err = testClient.CreateTLSSubscription(&CreateTLSSubscriptionInput{})
+	if err != ErrMissingDomains {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateTLSActivation(&CreateTLSActivationInput{})
+	if err != ErrMissingCertificate {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateTLSActivation(&CreateTLSActivationInput{
+		Certificate: &CustomTLSCertificate{ID: "cRTkUqPo3ZT5xQHhmEhyQ2"},
+	})
+	if err != ErrMissingDomain {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.FindTLSConfiguration("")
+	if err != ErrMissingName {
+		t.Errorf("bad error: %s", err)
+	}
+}
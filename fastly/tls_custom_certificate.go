@@ -0,0 +1,101 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/google/jsonapi"
+)
+
+// TLSDomain is a domain that TLS is configured for. Its ID is the domain name.
+type TLSDomain struct {
+	ID   string `jsonapi:"primary,tls_domain"`
+	Type string `jsonapi:"attr,type,omitempty"`
+}
+
+// CustomTLSCertificate is a TLS certificate uploaded to Fastly by the
+// customer. NotAfter and NotBefore are RFC 3339 timestamps.
+type CustomTLSCertificate struct {
+	ID                 string       `jsonapi:"primary,tls_certificate"`
+	Name               string       `jsonapi:"attr,name,omitempty"`
+	IssuedTo           string       `jsonapi:"attr,issued_to,omitempty"`
+	Issuer             string       `jsonapi:"attr,issuer,omitempty"`
+	SerialNumber       string       `jsonapi:"attr,serial_number,omitempty"`
+	SignatureAlgorithm string       `jsonapi:"attr,signature_algorithm,omitempty"`
+	Replace            bool         `jsonapi:"attr,replace,omitempty"`
+	NotAfter           string       `jsonapi:"attr,not_after,omitempty"`
+	NotBefore          string       `jsonapi:"attr,not_before,omitempty"`
+	CreatedAt          string       `jsonapi:"attr,created_at,omitempty"`
+	UpdatedAt          string       `jsonapi:"attr,updated_at,omitempty"`
+	Domains            []*TLSDomain `jsonapi:"relation,tls_domains,omitempty"`
+}
+
+// customTLSCertificateType is used for reflection because JSONAPI wants to
+// know what it's decoding into.
+var customTLSCertificateType = reflect.TypeOf(new(CustomTLSCertificate))
+
+// ListCustomTLSCertificatesInput is used as input to the
+// ListCustomTLSCertificates function.
+type ListCustomTLSCertificatesInput struct {
+	// FilterNotAfter limits the returned certificates to those expiring on or
+	// before the given date, such as "2020-05-05". Optional.
+	FilterNotAfter string
+
+	// MaxResults is the number of certificates to return per request.
+	// Optional.
+	MaxResults int
+}
+
+// ListCustomTLSCertificates returns every custom TLS certificate for the
+// account, following every page of results.
+func (c *Client) ListCustomTLSCertificates(i *ListCustomTLSCertificatesInput) ([]*CustomTLSCertificate, error) {
+	params := map[string]string{}
+	if i.FilterNotAfter != "" {
+		params["filter[not_after][lte]"] = i.FilterNotAfter
+	}
+	if i.MaxResults != 0 {
+		params["page[size]"] = strconv.Itoa(i.MaxResults)
+	}
+
+	data, err := c.getAllJSONAPIPages("/tls/certificates", params, customTLSCertificateType)
+	if err != nil {
+		return nil, err
+	}
+
+	certs := make([]*CustomTLSCertificate, len(data))
+	for i := range data {
+		typed, ok := data[i].(*CustomTLSCertificate)
+		if !ok {
+			return nil, fmt.Errorf("got back a non-CustomTLSCertificate response")
+		}
+		certs[i] = typed
+	}
+	return certs, nil
+}
+
+// GetCustomTLSCertificateInput is used as input to the
+// GetCustomTLSCertificate function.
+type GetCustomTLSCertificateInput struct {
+	// ID is the ID of the certificate and is required.
+	ID string
+}
+
+// GetCustomTLSCertificate gets the custom TLS certificate with the given ID.
+func (c *Client) GetCustomTLSCertificate(i *GetCustomTLSCertificateInput) (*CustomTLSCertificate, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/tls/certificates/%s", i.ID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var cert CustomTLSCertificate
+	if err := jsonapi.UnmarshalPayload(resp.Body, &cert); err != nil {
+		return nil, err
+	}
+	return &cert, nil
+}
@@ -0,0 +1,298 @@
+package fastly
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+// DNSResolver looks up the DNS records checked by VerifyTLSSubscriptionDNS
+// and VerifyTLSActivationDNS. It is satisfied by DefaultDNSResolver, and may be
+// replaced to query a particular nameserver or in tests.
+type DNSResolver interface {
+	LookupCNAME(host string) (string, error)
+	LookupHost(host string) ([]string, error)
+	LookupTXT(name string) ([]string, error)
+}
+
+// DefaultDNSResolver is the DNSResolver used when none is given. It uses the
+// system resolver through the net package.
+var DefaultDNSResolver DNSResolver = netResolver{}
+
+// netResolver implements DNSResolver with the net package lookup functions.
+type netResolver struct{}
+
+func (netResolver) LookupCNAME(host string) (string, error)  { return net.LookupCNAME(host) }
+func (netResolver) LookupHost(host string) ([]string, error) { return net.LookupHost(host) }
+func (netResolver) LookupTXT(name string) ([]string, error)  { return net.LookupTXT(name) }
+
+// TLSAuthorization is a domain ownership authorization for a TLS
+// subscription. Any one of its challenges may be satisfied to authorize the
+// domain.
+type TLSAuthorization struct {
+	ID         string          `mapstructure:"id"`
+	State      string          `mapstructure:"state"`
+	Challenges []*TLSChallenge `mapstructure:"challenges"`
+}
+
+// TLSChallenge is a DNS record that satisfies a TLS authorization. Type is
+// the kind of challenge, such as "managed-dns" or "managed-http-cname".
+type TLSChallenge struct {
+	Type       string   `mapstructure:"type"`
+	RecordType string   `mapstructure:"record_type"`
+	RecordName string   `mapstructure:"record_name"`
+	Values     []string `mapstructure:"values"`
+}
+
+// TLSDNSRecordCheck is the result of checking a single DNS record.
+type TLSDNSRecordCheck struct {
+	// Type is the record type, such as "CNAME", "A" or "TXT".
+	Type string
+
+	// Name is the name the record was looked up for.
+	Name string
+
+	// Expected are the acceptable values for the record.
+	Expected []string
+
+	// Found are the values returned by the resolver.
+	Found []string
+
+	// OK is true when the record is set to an expected value.
+	OK bool
+
+	// Err is the lookup error, if any. A domain with no such record is
+	// reported here.
+	Err error
+}
+
+// TLSDomainDNSStatus is the DNS verification result for a single domain.
+type TLSDomainDNSStatus struct {
+	// Domain is the domain name.
+	Domain string
+
+	// Delegated is true when at least one of the domain's records is set
+	// correctly.
+	Delegated bool
+
+	// Records are the individual record checks, so callers can report which
+	// records are missing or wrong.
+	Records []*TLSDNSRecordCheck
+}
+
+// VerifyTLSSubscriptionDNSInput is used as input to the
+// VerifyTLSSubscriptionDNS function.
+type VerifyTLSSubscriptionDNSInput struct {
+	// ID is the ID of the TLS subscription and is required.
+	ID string
+
+	// Resolver is used to look up records. The default is DefaultDNSResolver.
+	Resolver DNSResolver
+}
+
+// VerifyTLSSubscriptionDNS checks the DNS records for every domain of a TLS
+// subscription against the subscription's authorization challenges. A domain
+// is delegated when any one of its challenge records is set correctly. The
+// results are sorted by domain.
+func (c *Client) VerifyTLSSubscriptionDNS(i *VerifyTLSSubscriptionDNSInput) ([]*TLSDomainDNSStatus, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	resolver := i.Resolver
+	if resolver == nil {
+		resolver = DefaultDNSResolver
+	}
+
+	authorizations, err := c.getTLSAuthorizations(i.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make(map[string]*TLSDomainDNSStatus)
+	for _, a := range authorizations {
+		for _, ch := range a.Challenges {
+			domain := strings.TrimPrefix(ch.RecordName, "_acme-challenge.")
+			s, ok := statuses[domain]
+			if !ok {
+				s = &TLSDomainDNSStatus{Domain: domain}
+				statuses[domain] = s
+			}
+
+			check := checkDNSRecord(resolver, ch.RecordType, ch.RecordName, ch.Values)
+			s.Records = append(s.Records, check)
+			s.Delegated = s.Delegated || check.OK
+		}
+	}
+
+	domains := make([]string, 0, len(statuses))
+	for d := range statuses {
+		domains = append(domains, d)
+	}
+	sort.Strings(domains)
+
+	result := make([]*TLSDomainDNSStatus, len(domains))
+	for n, d := range domains {
+		result[n] = statuses[d]
+	}
+	return result, nil
+}
+
+// getTLSAuthorizations returns the authorizations included with a TLS
+// subscription. Challenges are lists of objects, which the JSON:API decoder
+// cannot unmarshal, so the included objects are decoded separately.
+func (c *Client) getTLSAuthorizations(id string) ([]*TLSAuthorization, error) {
+	path := fmt.Sprintf("/tls/subscriptions/%s", id)
+	resp, err := c.Get(path, &RequestOptions{
+		Params: map[string]string{"include": "tls_authorizations"},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var payload struct {
+		Included []struct {
+			ID         string            `mapstructure:"id"`
+			Type       string            `mapstructure:"type"`
+			Attributes *TLSAuthorization `mapstructure:"attributes"`
+		} `mapstructure:"included"`
+	}
+	if err := decodeJSON(&payload, resp.Body); err != nil {
+		return nil, err
+	}
+
+	var authorizations []*TLSAuthorization
+	for _, inc := range payload.Included {
+		if inc.Type != "tls_authorization" || inc.Attributes == nil {
+			continue
+		}
+		inc.Attributes.ID = inc.ID
+		authorizations = append(authorizations, inc.Attributes)
+	}
+	return authorizations, nil
+}
+
+// VerifyTLSActivationDNSInput is used as input to the VerifyTLSActivationDNS
+// function.
+type VerifyTLSActivationDNSInput struct {
+	// ID is the ID of the TLS activation and is required.
+	ID string
+
+	// Resolver is used to look up records. The default is DefaultDNSResolver.
+	Resolver DNSResolver
+}
+
+// VerifyTLSActivationDNS checks that the domain of a TLS activation points at
+// one of the DNS records of its TLS configuration, either with a CNAME or
+// with A records.
+func (c *Client) VerifyTLSActivationDNS(i *VerifyTLSActivationDNSInput) (*TLSDomainDNSStatus, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	resolver := i.Resolver
+	if resolver == nil {
+		resolver = DefaultDNSResolver
+	}
+
+	activation, err := c.GetTLSActivation(&GetTLSActivationInput{ID: i.ID})
+	if err != nil {
+		return nil, err
+	}
+	if activation.Domain == nil || activation.Configuration == nil {
+		return nil, fmt.Errorf("TLS activation %s has no domain or configuration", i.ID)
+	}
+
+	config, err := c.GetTLSConfiguration(&GetTLSConfigurationInput{
+		ID:      activation.Configuration.ID,
+		Include: "dns_records",
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	expected := make(map[string][]string)
+	for _, r := range config.DNSRecords {
+		expected[r.RecordType] = append(expected[r.RecordType], r.ID)
+	}
+
+	s := &TLSDomainDNSStatus{Domain: activation.Domain.ID}
+	for _, t := range []string{"CNAME", "A"} {
+		if len(expected[t]) == 0 {
+			continue
+		}
+		check := checkDNSRecord(resolver, t, s.Domain, expected[t])
+		s.Records = append(s.Records, check)
+		s.Delegated = s.Delegated || check.OK
+	}
+	return s, nil
+}
+
+// checkDNSRecord looks up a single record and compares it to the expected
+// values. A CNAME must match one of the values, every IPv4 address of an A
+// record must be one of the values, and a TXT record must contain one of the
+// values.
+func checkDNSRecord(r DNSResolver, recordType, name string, expected []string) *TLSDNSRecordCheck {
+	check := &TLSDNSRecordCheck{
+		Type:     recordType,
+		Name:     name,
+		Expected: expected,
+	}
+
+	want := make(map[string]bool, len(expected))
+	for _, v := range expected {
+		want[normalizeDNSName(v)] = true
+	}
+
+	switch strings.ToUpper(recordType) {
+	case "CNAME":
+		cname, err := r.LookupCNAME(name)
+		if err != nil {
+			check.Err = err
+			return check
+		}
+		check.Found = []string{normalizeDNSName(cname)}
+		check.OK = want[check.Found[0]]
+	case "A":
+		addrs, err := r.LookupHost(name)
+		if err != nil {
+			check.Err = err
+			return check
+		}
+		for _, a := range addrs {
+			if ip := net.ParseIP(a); ip != nil && ip.To4() != nil {
+				check.Found = append(check.Found, a)
+			}
+		}
+		check.OK = len(check.Found) > 0
+		for _, a := range check.Found {
+			if !want[a] {
+				check.OK = false
+			}
+		}
+	case "TXT":
+		txts, err := r.LookupTXT(name)
+		if err != nil {
+			check.Err = err
+			return check
+		}
+		check.Found = txts
+		for _, t := range txts {
+			for _, v := range expected {
+				if t == v {
+					check.OK = true
+				}
+			}
+		}
+	default:
+		check.Err = fmt.Errorf("unsupported record type %q", recordType)
+	}
+	return check
+}
+
+// normalizeDNSName lowercases a name and strips any trailing dot, so
+// "Example.com." and "example.com" compare equal.
+func normalizeDNSName(name string) string {
+	return strings.ToLower(strings.TrimSuffix(name, "."))
+}
@@ -0,0 +1,141 @@
+package fastly
+
+import (
+	"errors"
+	"testing"
+)
+
+// testDNSResolver answers lookups from fixed tables and returns an error for
+// any name not in them.
+type testDNSResolver struct {
+	cnames map[string]string
+	hosts  map[string][]string
+	txts   map[string][]string
+}
+
+var errTestNoSuchHost = errors.New("no such host")
+
+func (r *testDNSResolver) LookupCNAME(host string) (string, error) {
+	if v, ok := r.cnames[host]; ok {
+		return v, nil
+	}
+	return "", errTestNoSuchHost
+}
+
+func (r *testDNSResolver) LookupHost(host string) ([]string, error) {
+	if v, ok := r.hosts[host]; ok {
+		return v, nil
+	}
+	return nil, errTestNoSuchHost
+}
+
+func (r *testDNSResolver) LookupTXT(name string) ([]string, error) {
+	if v, ok := r.txts[name]; ok {
+		return v, nil
+	}
+	return nil, errTestNoSuchHost
+}
+
+func TestClient_VerifyTLSSubscriptionDNS(t *testing.T) {
+	t.Parallel()
+
+	// www is delegated with an ACME challenge CNAME; api points at an address
+	// outside of Fastly.
+	resolver := &testDNSResolver{
+		cnames: map[string]string{
+			"_acme-challenge.www.example.com": "AB12CD34EF56.fastly-validations.com.",
+		},
+		hosts: map[string][]string{
+			"api.example.com": {"203.0.113.10"},
+		},
+	}
+
+	var err error
+	var statuses []*TLSDomainDNSStatus
+	record(t, "tls/verify_subscription_dns", func(c *Client) {
+		statuses, err = c.VerifyTLSSubscriptionDNS(&VerifyTLSSubscriptionDNSInput{
+			ID:       "dNsSuBqTzWAD0acXr3RG1a",
+			Resolver: resolver,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(statuses) != 2 {
+		t.Fatalf("bad statuses: %v", statuses)
+	}
+
+	api := statuses[0]
+	if api.Domain != "api.example.com" || api.Delegated {
+		t.Errorf("bad status: %#v", api)
+	}
+	if len(api.Records) != 3 {
+		t.Fatalf("bad records: %v", api.Records)
+	}
+	if a := api.Records[2]; a.Type != "A" || a.OK || len(a.Found) != 1 || a.Found[0] != "203.0.113.10" {
+		t.Errorf("bad record: %#v", a)
+	}
+	if cname := api.Records[1]; cname.Err != errTestNoSuchHost {
+		t.Errorf("bad record: %#v", cname)
+	}
+
+	www := statuses[1]
+	if www.Domain != "www.example.com" || !www.Delegated {
+		t.Errorf("bad status: %#v", www)
+	}
+	if !www.Records[0].OK {
+		t.Errorf("bad record: %#v", www.Records[0])
+	}
+}
+
+func TestClient_VerifyTLSActivationDNS(t *testing.T) {
+	t.Parallel()
+
+	resolver := &testDNSResolver{
+		hosts: map[string][]string{
+			"www.example.com": {"151.101.2.132", "151.101.66.132", "2a04:4e42::644"},
+		},
+	}
+
+	var err error
+	var status *TLSDomainDNSStatus
+	record(t, "tls/verify_activation_dns", func(c *Client) {
+		status, err = c.VerifyTLSActivationDNS(&VerifyTLSActivationDNSInput{
+			ID:       "dNsAcTqTzWAD0acXr3RG1a",
+			Resolver: resolver,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Domain != "www.example.com" || !status.Delegated {
+		t.Errorf("bad status: %#v", status)
+	}
+	if len(status.Records) != 2 {
+		t.Fatalf("bad records: %v", status.Records)
+	}
+	if cname := status.Records[0]; cname.Type != "CNAME" || cname.OK {
+		t.Errorf("bad record: %#v", cname)
+	}
+	if a := status.Records[1]; a.Type != "A" || !a.OK || len(a.Found) != 2 {
+		t.Errorf("bad record: %#v", a)
+	}
+}
+
+func TestClient_VerifyTLSDNS_validation(t *testing.T) {
+	var err error
+	_, err = testClient.VerifyTLSSubscriptionDNS(&VerifyTLSSubscriptionDNSInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.VerifyTLSActivationDNS(&VerifyTLSActivationDNSInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetTLSConfiguration(&GetTLSConfigurationInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
@@ -0,0 +1,254 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/google/jsonapi"
+)
+
+// MutualAuthentication is a mutual TLS configuration. CertBundle is the PEM
+// encoded bundle of CA certificates that client certificates are checked
+// against.
+type MutualAuthentication struct {
+	ID          string           `jsonapi:"primary,mutual_authentication"`
+	Name        string           `jsonapi:"attr,name,omitempty"`
+	CertBundle  string           `jsonapi:"attr,cert_bundle,omitempty"`
+	Enforced    bool             `jsonapi:"attr,enforced,omitempty"`
+	CreatedAt   string           `jsonapi:"attr,created_at,omitempty"`
+	UpdatedAt   string           `jsonapi:"attr,updated_at,omitempty"`
+	Activations []*TLSActivation `jsonapi:"relation,tls_activations,omitempty"`
+}
+
+// mutualAuthenticationType is used for reflection because JSONAPI wants to
+// know what it's decoding into.
+var mutualAuthenticationType = reflect.TypeOf(new(MutualAuthentication))
+
+// ListMutualAuthenticationsInput is used as input to the
+// ListMutualAuthentications function.
+type ListMutualAuthenticationsInput struct {
+	// Include is a comma-separated list of related objects to include in the
+	// response, such as "tls_activations". Optional.
+	Include string
+}
+
+// ListMutualAuthentications returns every mutual TLS configuration for the
+// account, following every page of results.
+func (c *Client) ListMutualAuthentications(i *ListMutualAuthenticationsInput) ([]*MutualAuthentication, error) {
+	params := map[string]string{}
+	if i.Include != "" {
+		params["include"] = i.Include
+	}
+
+	data, err := c.getAllJSONAPIPages("/tls/mutual_authentications", params, mutualAuthenticationType)
+	if err != nil {
+		return nil, err
+	}
+
+	mas := make([]*MutualAuthentication, len(data))
+	for i := range data {
+		typed, ok := data[i].(*MutualAuthentication)
+		if !ok {
+			return nil, fmt.Errorf("got back a non-MutualAuthentication response")
+		}
+		mas[i] = typed
+	}
+	return mas, nil
+}
+
+// GetMutualAuthenticationInput is used as input to the
+// GetMutualAuthentication function.
+type GetMutualAuthenticationInput struct {
+	// ID is the ID of the mutual TLS configuration and is required.
+	ID string
+
+	// Include is a comma-separated list of related objects to include in the
+	// response, such as "tls_activations". Optional.
+	Include string
+}
+
+// GetMutualAuthentication gets the mutual TLS configuration with the given ID.
+func (c *Client) GetMutualAuthentication(i *GetMutualAuthenticationInput) (*MutualAuthentication, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	ro := &RequestOptions{Params: map[string]string{}}
+	if i.Include != "" {
+		ro.Params["include"] = i.Include
+	}
+
+	path := fmt.Sprintf("/tls/mutual_authentications/%s", i.ID)
+	resp, err := c.Get(path, ro)
+	if err != nil {
+		return nil, err
+	}
+
+	var ma MutualAuthentication
+	if err := jsonapi.UnmarshalPayload(resp.Body, &ma); err != nil {
+		return nil, err
+	}
+	return &ma, nil
+}
+
+// CreateMutualAuthenticationInput is used as input to the
+// CreateMutualAuthentication function.
+type CreateMutualAuthenticationInput struct {
+	// ID is ignored; it is required by the JSON:API encoding.
+	ID string `jsonapi:"primary,mutual_authentication"`
+
+	// CertBundle is the PEM encoded CA bundle and is required.
+	CertBundle string `jsonapi:"attr,cert_bundle"`
+
+	// Enforced rejects clients that do not present a valid certificate,
+	// rather than just reporting the result. Optional.
+	Enforced bool `jsonapi:"attr,enforced,omitempty"`
+
+	// Name is a name for the configuration. Optional.
+	Name string `jsonapi:"attr,name,omitempty"`
+}
+
+// CreateMutualAuthentication uploads a CA bundle as a new mutual TLS
+// configuration.
+func (c *Client) CreateMutualAuthentication(i *CreateMutualAuthenticationInput) (*MutualAuthentication, error) {
+	if i.CertBundle == "" {
+		return nil, ErrMissingCertBundle
+	}
+
+	resp, err := c.PostJSONAPI("/tls/mutual_authentications", i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var ma MutualAuthentication
+	if err := jsonapi.UnmarshalPayload(resp.Body, &ma); err != nil {
+		return nil, err
+	}
+	return &ma, nil
+}
+
+// DeleteMutualAuthenticationInput is used as input to the
+// DeleteMutualAuthentication function.
+type DeleteMutualAuthenticationInput struct {
+	// ID is the ID of the mutual TLS configuration and is required.
+	ID string
+}
+
+// DeleteMutualAuthentication deletes the mutual TLS configuration with the
+// given ID. It must not be in use by any TLS activation.
+func (c *Client) DeleteMutualAuthentication(i *DeleteMutualAuthenticationInput) error {
+	if i.ID == "" {
+		return ErrMissingID
+	}
+
+	path := fmt.Sprintf("/tls/mutual_authentications/%s", i.ID)
+	_, err := c.Delete(path, nil)
+	return err
+}
+
+// RotateMutualAuthenticationInput is used as input to the
+// RotateMutualAuthentication function.
+type RotateMutualAuthenticationInput struct {
+	// ID is the ID of the mutual TLS configuration being replaced and is
+	// required.
+	ID string
+
+	// CertBundle is the PEM encoded replacement CA bundle and is required.
+	CertBundle string
+
+	// Name is the name for the new configuration. The default is the name of
+	// the configuration being replaced.
+	Name string
+
+	// Enforced sets whether the new configuration is enforced. The default is
+	// to copy the setting of the configuration being replaced.
+	Enforced *bool
+}
+
+// RotateMutualAuthentication replaces the CA bundle used by every TLS
+// activation of a mutual TLS configuration. A new configuration is created
+// from CertBundle, each activation is re-pointed at it, and only then is the
+// old configuration deleted. If re-pointing any activation fails, the
+// activations already moved are pointed back at the old configuration and the
+// new one is deleted, so clients are never left without a valid bundle. If
+// only deleting the old configuration fails, the new configuration is
+// returned with a *MutualAuthenticationCleanupError.
+func (c *Client) RotateMutualAuthentication(i *RotateMutualAuthenticationInput) (*MutualAuthentication, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	if i.CertBundle == "" {
+		return nil, ErrMissingCertBundle
+	}
+
+	old, err := c.GetMutualAuthentication(&GetMutualAuthenticationInput{ID: i.ID})
+	if err != nil {
+		return nil, err
+	}
+
+	name := i.Name
+	if name == "" {
+		name = old.Name
+	}
+	enforced := old.Enforced
+	if i.Enforced != nil {
+		enforced = *i.Enforced
+	}
+
+	ma, err := c.CreateMutualAuthentication(&CreateMutualAuthenticationInput{
+		CertBundle: i.CertBundle,
+		Enforced:   enforced,
+		Name:       name,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for n, a := range old.Activations {
+		_, err := c.UpdateTLSActivation(&UpdateTLSActivationInput{
+			ID:                   a.ID,
+			MutualAuthentication: &MutualAuthentication{ID: ma.ID},
+		})
+		if err != nil {
+			if rerr := c.rollbackMutualAuthentication(old.ID, ma.ID, old.Activations[:n]); rerr != nil {
+				return nil, fmt.Errorf("updating TLS activation %s: %s (rollback failed: %s)", a.ID, err, rerr)
+			}
+			return nil, fmt.Errorf("updating TLS activation %s: %s", a.ID, err)
+		}
+	}
+
+	if err := c.DeleteMutualAuthentication(&DeleteMutualAuthenticationInput{ID: old.ID}); err != nil {
+		return ma, &MutualAuthenticationCleanupError{ID: old.ID, Err: err}
+	}
+	return ma, nil
+}
+
+// MutualAuthenticationCleanupError is returned by RotateMutualAuthentication
+// when every activation was moved to the new configuration but the old one
+// could not be deleted. The new configuration is returned with it, and
+// deleting ID can be retried with DeleteMutualAuthentication.
+type MutualAuthenticationCleanupError struct {
+	ID  string
+	Err error
+}
+
+// Error implements the error interface.
+func (e *MutualAuthenticationCleanupError) Error() string {
+	return fmt.Sprintf("deleting replaced mutual TLS configuration %s: %s", e.ID, e.Err)
+}
+
+// rollbackMutualAuthentication points the given activations back at the old
+// mutual TLS configuration and deletes the new one.
+func (c *Client) rollbackMutualAuthentication(oldID, newID string, activations []*TLSActivation) error {
+	for _, a := range activations {
+		_, err := c.UpdateTLSActivation(&UpdateTLSActivationInput{
+			ID:                   a.ID,
+			MutualAuthentication: &MutualAuthentication{ID: oldID},
+		})
+		if err != nil {
+			return fmt.Errorf("restoring TLS activation %s: %s", a.ID, err)
+		}
+	}
+	return c.DeleteMutualAuthentication(&DeleteMutualAuthenticationInput{ID: newID})
+}
@@ -0,0 +1,114 @@
+package fastly
+
+import (
+	"strings"
+	"testing"
+)
+
+const testCABundle = "-----BEGIN CERTIFICATE-----\nMIIBszCCAVmgAwIBAgIUY2EtYnVuZGxlLXRlc3QwCgYIKoZIzj0EAwIwEjEQMA4G\n-----END CERTIFICATE-----\n"
+
+func TestClient_RotateMutualAuthentication(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var ma *MutualAuthentication
+	record(t, "tls/rotate_mutual_authentication", func(c *Client) {
+		ma, err = c.RotateMutualAuthentication(&RotateMutualAuthenticationInput{
+			ID:         "oLdMa1bTqTzWAD0acXr3RG",
+			CertBundle: testCABundle,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ma.ID != "nEwMa1bTqTzWAD0acXr3RG" {
+		t.Errorf("bad id: %q", ma.ID)
+	}
+	if ma.Name != "clients" {
+		t.Errorf("bad name: %q", ma.Name)
+	}
+	if !ma.Enforced {
+		t.Errorf("bad enforced: %t", ma.Enforced)
+	}
+}
+
+func TestClient_RotateMutualAuthentication_rollback(t *testing.T) {
+	t.Parallel()
+
+	// The second activation fails to update, so the first is pointed back at
+	// the old configuration, the new one is deleted, and the old one is kept.
+	var err error
+	record(t, "tls/rotate_mutual_authentication_rollback", func(c *Client) {
+		_, err = c.RotateMutualAuthentication(&RotateMutualAuthenticationInput{
+			ID:         "oLdMa2bTqTzWAD0acXr3RG",
+			CertBundle: testCABundle,
+		})
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if !strings.Contains(err.Error(), "aCt4iAwQtDqb0YBrK1wZqd") {
+		t.Errorf("bad error: %s", err)
+	}
+	if strings.Contains(err.Error(), "rollback failed") {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_RotateMutualAuthentication_cleanup(t *testing.T) {
+	t.Parallel()
+
+	// Every activation is moved, but deleting the old configuration fails, so
+	// the new one is returned for the caller to retry the delete.
+	var err error
+	var ma *MutualAuthentication
+	record(t, "tls/rotate_mutual_authentication_cleanup", func(c *Client) {
+		ma, err = c.RotateMutualAuthentication(&RotateMutualAuthenticationInput{
+			ID:         "oLdMa3bTqTzWAD0acXr3RG",
+			CertBundle: testCABundle,
+		})
+	})
+	cerr, ok := err.(*MutualAuthenticationCleanupError)
+	if !ok {
+		t.Fatalf("expected a *MutualAuthenticationCleanupError, got %v", err)
+	}
+	if cerr.ID != "oLdMa3bTqTzWAD0acXr3RG" {
+		t.Errorf("bad id: %q", cerr.ID)
+	}
+	if herr, ok := cerr.Err.(*HTTPError); !ok || herr.StatusCode != 500 {
+		t.Errorf("bad wrapped error: %v", cerr.Err)
+	}
+	if ma == nil || ma.ID != "nEwMa1bTqTzWAD0acXr3RG" {
+		t.Errorf("bad configuration: %#v", ma)
+	}
+}
+
+func TestClient_RotateMutualAuthentication_validation(t *testing.T) {
+	var err error
+	_, err = testClient.RotateMutualAuthentication(&RotateMutualAuthenticationInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.RotateMutualAuthentication(&RotateMutualAuthenticationInput{
+		ID: "oLdMa1bTqTzWAD0acXr3RG",
+	})
+	if err != ErrMissingCertBundle {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.CreateMutualAuthentication(&CreateMutualAuthenticationInput{})
+	if err != ErrMissingCertBundle {
+		t.Errorf("bad error: %s", err)
+	}
+
+	err = testClient.DeleteMutualAuthentication(&DeleteMutualAuthenticationInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateTLSActivation(&UpdateTLSActivationInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
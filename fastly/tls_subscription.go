@@ -0,0 +1,167 @@
+package fastly
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/google/jsonapi"
+)
+
+// TLSSubscription is a Fastly-managed TLS certificate subscription, which
+// obtains and renews certificates for its domains automatically.
+type TLSSubscription struct {
+	ID                   string                        `jsonapi:"primary,tls_subscription"`
+	CertificateAuthority string                        `jsonapi:"attr,certificate_authority,omitempty"`
+	State                string                        `jsonapi:"attr,state,omitempty"`
+	CreatedAt            string                        `jsonapi:"attr,created_at,omitempty"`
+	UpdatedAt            string                        `jsonapi:"attr,updated_at,omitempty"`
+	CommonName           *TLSDomain                    `jsonapi:"relation,common_name,omitempty"`
+	Domains              []*TLSDomain                  `jsonapi:"relation,tls_domains,omitempty"`
+	Certificates         []*TLSSubscriptionCertificate `jsonapi:"relation,tls_certificates,omitempty"`
+}
+
+// TLSSubscriptionCertificate is a certificate issued for a TLS subscription.
+// Its attributes are only set when requested with Include. NotAfter and
+// NotBefore are RFC 3339 timestamps.
+type TLSSubscriptionCertificate struct {
+	ID        string `jsonapi:"primary,tls_certificate"`
+	NotAfter  string `jsonapi:"attr,not_after,omitempty"`
+	NotBefore string `jsonapi:"attr,not_before,omitempty"`
+	CreatedAt string `jsonapi:"attr,created_at,omitempty"`
+	UpdatedAt string `jsonapi:"attr,updated_at,omitempty"`
+}
+
+// tlsSubscriptionType is used for reflection because JSONAPI wants to know
+// what it's decoding into.
+var tlsSubscriptionType = reflect.TypeOf(new(TLSSubscription))
+
+// ListTLSSubscriptionsInput is used as input to the ListTLSSubscriptions
+// function.
+type ListTLSSubscriptionsInput struct {
+	// FilterState limits the returned subscriptions to those in the given
+	// state, such as "issued" or "pending". Optional.
+	FilterState string
+
+	// Include is a comma-separated list of related objects to include in the
+	// response, such as "tls_certificates". Optional.
+	Include string
+}
+
+// ListTLSSubscriptions returns every TLS subscription for the account,
+// following every page of results.
+func (c *Client) ListTLSSubscriptions(i *ListTLSSubscriptionsInput) ([]*TLSSubscription, error) {
+	params := map[string]string{}
+	if i.FilterState != "" {
+		params["filter[state]"] = i.FilterState
+	}
+	if i.Include != "" {
+		params["include"] = i.Include
+	}
+
+	data, err := c.getAllJSONAPIPages("/tls/subscriptions", params, tlsSubscriptionType)
+	if err != nil {
+		return nil, err
+	}
+
+	subs := make([]*TLSSubscription, len(data))
+	for i := range data {
+		typed, ok := data[i].(*TLSSubscription)
+		if !ok {
+			return nil, fmt.Errorf("got back a non-TLSSubscription response")
+		}
+		subs[i] = typed
+	}
+	return subs, nil
+}
+
+// GetTLSSubscriptionInput is used as input to the GetTLSSubscription function.
+type GetTLSSubscriptionInput struct {
+	// ID is the ID of the subscription and is required.
+	ID string
+
+	// Include is a comma-separated list of related objects to include in the
+	// response, such as "tls_certificates". Optional.
+	Include string
+}
+
+// GetTLSSubscription gets the TLS subscription with the given ID.
+func (c *Client) GetTLSSubscription(i *GetTLSSubscriptionInput) (*TLSSubscription, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	ro := &RequestOptions{Params: map[string]string{}}
+	if i.Include != "" {
+		ro.Params["include"] = i.Include
+	}
+
+	path := fmt.Sprintf("/tls/subscriptions/%s", i.ID)
+	resp, err := c.Get(path, ro)
+	if err != nil {
+		return nil, err
+	}
+
+	var sub TLSSubscription
+	if err := jsonapi.UnmarshalPayload(resp.Body, &sub); err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+// CreateTLSSubscriptionInput is used as input to the CreateTLSSubscription
+// function.
+type CreateTLSSubscriptionInput struct {
+	// CertificateAuthority is the authority which issues the certificates,
+	// such as "lets-encrypt" or "globalsign". Optional; the API chooses one
+	// if it is not set.
+	CertificateAuthority string
+
+	// Domains are the domains to obtain certificates for and are required.
+	// CommonName is the domain used as the certificate's common name, which
+	// must be one of Domains. Optional.
+	Domains    []*TLSDomain
+	CommonName *TLSDomain
+
+	// Configuration is the TLS configuration the domains are served with.
+	// Optional; the default is the one returned by
+	// GetDefaultTLSConfiguration.
+	Configuration *TLSConfiguration
+}
+
+// createTLSSubscriptionPayload is the request body of CreateTLSSubscription.
+type createTLSSubscriptionPayload struct {
+	ID                   string                     `jsonapi:"primary,tls_subscription"`
+	CertificateAuthority string                     `jsonapi:"attr,certificate_authority,omitempty"`
+	Domains              []*TLSDomain               `jsonapi:"relation,tls_domains"`
+	CommonName           *TLSDomain                 `jsonapi:"relation,common_name,omitempty"`
+	Configuration        *tlsConfigurationReference `jsonapi:"relation,tls_configuration"`
+}
+
+// CreateTLSSubscription creates a TLS subscription, which obtains and renews
+// certificates for its domains once they are verified.
+func (c *Client) CreateTLSSubscription(i *CreateTLSSubscriptionInput) (*TLSSubscription, error) {
+	if len(i.Domains) == 0 {
+		return nil, ErrMissingDomains
+	}
+
+	config, err := c.tlsConfigurationOrDefault(i.Configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.PostJSONAPI("/tls/subscriptions", &createTLSSubscriptionPayload{
+		CertificateAuthority: i.CertificateAuthority,
+		Domains:              i.Domains,
+		CommonName:           i.CommonName,
+		Configuration:        config,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var sub TLSSubscription
+	if err := jsonapi.UnmarshalPayload(resp.Body, &sub); err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
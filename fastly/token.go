@@ -0,0 +1,138 @@
+package fastly
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Token represents an API token.
+type Token struct {
+	ID         string     `mapstructure:"id"`
+	Name       string     `mapstructure:"name"`
+	UserID     string     `mapstructure:"user_id"`
+	CustomerID string     `mapstructure:"customer_id"`
+	Services   []string   `mapstructure:"services"`
+	Scope      string     `mapstructure:"scope"`
+	IP         string     `mapstructure:"ip"`
+	UserAgent  string     `mapstructure:"user_agent"`
+	CreatedAt  *time.Time `mapstructure:"created_at"`
+	LastUsedAt *time.Time `mapstructure:"last_used_at"`
+	ExpiresAt  *time.Time `mapstructure:"expires_at"`
+}
+
+// tokensByName is a sortable list of tokens.
+type tokensByName []*Token
+
+// Len, Swap, and Less implement the sortable interface.
+func (s tokensByName) Len() int      { return len(s) }
+func (s tokensByName) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s tokensByName) Less(i, j int) bool {
+	return s[i].Name < s[j].Name
+}
+
+// ListCustomerTokensInput is used as input to the ListCustomerTokens function.
+type ListCustomerTokensInput struct {
+	// CustomerID is the ID of the customer (required).
+	CustomerID string
+
+	// UserID limits the returned tokens to those belonging to a user.
+	// Optional.
+	UserID string
+
+	// Scope limits the returned tokens to those with the given scope, such as
+	// "global". Optional.
+	Scope string
+
+	// UnusedSince limits the returned tokens to those which have not been used
+	// since the given time, including tokens which have never been used.
+	// Optional.
+	UnusedSince time.Time
+
+	// ExpiresBefore limits the returned tokens to those with an expiry before
+	// the given time. Tokens which never expire are excluded. Optional.
+	ExpiresBefore time.Time
+}
+
+// ListCustomerTokens returns every API token for the customer, across all of
+// its users, sorted by name. The filters are applied to the full list
+// returned by the API.
+func (c *Client) ListCustomerTokens(i *ListCustomerTokensInput) ([]*Token, error) {
+	if i.CustomerID == "" {
+		return nil, ErrMissingCustomerID
+	}
+
+	path := fmt.Sprintf("/customer/%s/tokens", i.CustomerID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var all []*Token
+	if err := decodeJSON(&all, resp.Body); err != nil {
+		return nil, err
+	}
+
+	tokens := make([]*Token, 0, len(all))
+	for _, t := range all {
+		if i.UserID != "" && t.UserID != i.UserID {
+			continue
+		}
+		if i.Scope != "" && !tokenHasScope(t, i.Scope) {
+			continue
+		}
+		if !i.UnusedSince.IsZero() && t.LastUsedAt != nil && !t.LastUsedAt.Before(i.UnusedSince) {
+			continue
+		}
+		if !i.ExpiresBefore.IsZero() && (t.ExpiresAt == nil || !t.ExpiresAt.Before(i.ExpiresBefore)) {
+			continue
+		}
+		tokens = append(tokens, t)
+	}
+	sort.Stable(tokensByName(tokens))
+	return tokens, nil
+}
+
+// tokenHasScope reports whether scope is one of the space-separated scopes of
+// the token.
+func tokenHasScope(t *Token, scope string) bool {
+	for _, s := range strings.Fields(t.Scope) {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// GetTokenSelf returns the token used to authenticate the client.
+func (c *Client) GetTokenSelf() (*Token, error) {
+	resp, err := c.Get("/tokens/self", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var t *Token
+	if err := decodeJSON(&t, resp.Body); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// VerifyToken reports whether the token used to authenticate the client is
+// valid and unexpired. A token rejected by the API returns false and no
+// error; any other failure, such as a network error, is returned.
+func (c *Client) VerifyToken() (bool, error) {
+	t, err := c.GetTokenSelf()
+	if err != nil {
+		if herr, ok := err.(*HTTPError); ok && (herr.StatusCode == 401 || herr.StatusCode == 403) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	if t.ExpiresAt != nil && !t.ExpiresAt.After(time.Now()) {
+		return false, nil
+	}
+	return true, nil
+}
@@ -0,0 +1,116 @@
+package fastly
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestClient_GetTokenSelf(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var tok *Token
+	record(t, "tokens/self", func(c *Client) {
+		tok, err = c.GetTokenSelf()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok.ID != "5Yo3XXnrQpjc20u0ybrf2g" {
+		t.Errorf("bad id: %q", tok.ID)
+	}
+	if tok.Scope != "global" {
+		t.Errorf("bad scope: %q", tok.Scope)
+	}
+	if tok.ExpiresAt != nil {
+		t.Errorf("bad expires_at: %s", tok.ExpiresAt)
+	}
+}
+
+func TestClient_VerifyToken(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		fixture string
+		valid   bool
+	}{
+		{"tokens/self", true},
+		{"tokens/self_expired", false},
+		{"tokens/self_invalid", false},
+	} {
+		var err error
+		var valid bool
+		record(t, tc.fixture, func(c *Client) {
+			valid, err = c.VerifyToken()
+		})
+		if err != nil {
+			t.Fatalf("%s: %s", tc.fixture, err)
+		}
+		if valid != tc.valid {
+			t.Errorf("%s: expected %t, got %t", tc.fixture, tc.valid, valid)
+		}
+	}
+}
+
+func TestClient_ListCustomerTokens(t *testing.T) {
+	t.Parallel()
+
+	for _, tc := range []struct {
+		description string
+		input       *ListCustomerTokensInput
+		expected    []string
+	}{
+		{
+			description: "all tokens sorted by name",
+			input:       &ListCustomerTokensInput{},
+			expected:    []string{"ci", "deploy", "old laptop", "purger"},
+		},
+		{
+			description: "by user",
+			input:       &ListCustomerTokensInput{UserID: "7UHOkmw2RVtuJ6jNxxxxxx"},
+			expected:    []string{"old laptop", "purger"},
+		},
+		{
+			description: "by scope",
+			input:       &ListCustomerTokensInput{Scope: "purge_select"},
+			expected:    []string{"ci"},
+		},
+		{
+			description: "stale tokens",
+			input:       &ListCustomerTokensInput{UnusedSince: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)},
+			expected:    []string{"ci", "old laptop"},
+		},
+		{
+			description: "expiring tokens",
+			input:       &ListCustomerTokensInput{ExpiresBefore: time.Date(2020, 12, 31, 0, 0, 0, 0, time.UTC)},
+			expected:    []string{"ci"},
+		},
+	} {
+		tc.input.CustomerID = "zwBncFVs2Ixrhd8xxxxxx"
+
+		var err error
+		var tokens []*Token
+		record(t, "tokens/list_customer", func(c *Client) {
+			tokens, err = c.ListCustomerTokens(tc.input)
+		})
+		if err != nil {
+			t.Fatalf("%s: %s", tc.description, err)
+		}
+
+		names := make([]string, len(tokens))
+		for i, tok := range tokens {
+			names[i] = tok.Name
+		}
+		if strings.Join(names, ",") != strings.Join(tc.expected, ",") {
+			t.Errorf("%s: expected %q, got %q", tc.description, tc.expected, names)
+		}
+	}
+}
+
+func TestClient_ListCustomerTokens_validation(t *testing.T) {
+	_, err := testClient.ListCustomerTokens(&ListCustomerTokensInput{})
+	if err != ErrMissingCustomerID {
+		t.Errorf("bad error: %s", err)
+	}
+}
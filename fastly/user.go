@@ -0,0 +1,179 @@
+package fastly
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// UserRole is the role of a user, which determines their permissions.
+type UserRole string
+
+const (
+	// UserRoleUser can view services and their configuration, but not change
+	// them.
+	UserRoleUser UserRole = "user"
+
+	// UserRoleBilling can view services and manage billing information.
+	UserRoleBilling UserRole = "billing"
+
+	// UserRoleEngineer can create, change and activate services.
+	UserRoleEngineer UserRole = "engineer"
+
+	// UserRoleSuperuser can do everything, including manage users and the
+	// account.
+	UserRoleSuperuser UserRole = "superuser"
+)
+
+// User represents a user of the Fastly API and web interface.
+type User struct {
+	ID                     string     `mapstructure:"id"`
+	Login                  string     `mapstructure:"login"`
+	Name                   string     `mapstructure:"name"`
+	Role                   UserRole   `mapstructure:"role"`
+	CustomerID             string     `mapstructure:"customer_id"`
+	EmailHash              string     `mapstructure:"email_hash"`
+	LimitServices          bool       `mapstructure:"limit_services"`
+	Locked                 bool       `mapstructure:"locked"`
+	RequireNewPassword     bool       `mapstructure:"require_new_password"`
+	TwoFactorAuthEnabled   bool       `mapstructure:"two_factor_auth_enabled"`
+	TwoFactorSetupRequired bool       `mapstructure:"two_factor_setup_required"`
+	CreatedAt              *time.Time `mapstructure:"created_at"`
+	UpdatedAt              *time.Time `mapstructure:"updated_at"`
+	DeletedAt              *time.Time `mapstructure:"deleted_at"`
+}
+
+// usersByLogin is a sortable list of users.
+type usersByLogin []*User
+
+// Len, Swap, and Less implement the sortable interface.
+func (s usersByLogin) Len() int      { return len(s) }
+func (s usersByLogin) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
+func (s usersByLogin) Less(i, j int) bool {
+	return s[i].Login < s[j].Login
+}
+
+// ListCustomerUsersInput is used as input to the ListCustomerUsers function.
+type ListCustomerUsersInput struct {
+	// CustomerID is the ID of the customer (required).
+	CustomerID string
+
+	// IncludeDeleted keeps users the API returns with a DeletedAt time. By
+	// default they are left out.
+	IncludeDeleted bool
+}
+
+// ListCustomerUsers returns every user of the customer, sorted by login.
+func (c *Client) ListCustomerUsers(i *ListCustomerUsersInput) ([]*User, error) {
+	if i.CustomerID == "" {
+		return nil, ErrMissingCustomerID
+	}
+
+	path := fmt.Sprintf("/customer/%s/users", i.CustomerID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var u []*User
+	if err := decodeJSON(&u, resp.Body); err != nil {
+		return nil, err
+	}
+	if !i.IncludeDeleted {
+		kept := make([]*User, 0, len(u))
+		for _, user := range u {
+			if user.DeletedAt == nil {
+				kept = append(kept, user)
+			}
+		}
+		u = kept
+	}
+	sort.Stable(usersByLogin(u))
+	return u, nil
+}
+
+// GetCurrentUser returns the user the client is authenticated as.
+func (c *Client) GetCurrentUser() (*User, error) {
+	resp, err := c.Get("/current_user", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var u *User
+	if err := decodeJSON(&u, resp.Body); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// GetUserInput is used as input to the GetUser function.
+type GetUserInput struct {
+	// ID is the ID of the user (required).
+	ID string
+}
+
+// GetUser gets the user with the given ID.
+func (c *Client) GetUser(i *GetUserInput) (*User, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	path := fmt.Sprintf("/user/%s", i.ID)
+	resp, err := c.Get(path, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var u *User
+	if err := decodeJSON(&u, resp.Body); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// UpdateUserInput is used as input to the UpdateUser function.
+type UpdateUserInput struct {
+	// ID is the ID of the user (required).
+	ID string
+
+	Name          string       `form:"name,omitempty"`
+	Role          UserRole     `form:"role,omitempty"`
+	LimitServices *Compatibool `form:"limit_services,omitempty"`
+
+	// Locked locks the user out of the account when true, and unlocks them
+	// when false.
+	Locked *Compatibool `form:"locked,omitempty"`
+}
+
+// UpdateUser updates a user, such as to change their role or to lock or unlock
+// them.
+func (c *Client) UpdateUser(i *UpdateUserInput) (*User, error) {
+	if i.ID == "" {
+		return nil, ErrMissingID
+	}
+
+	if i.Role != "" && !validUserRole(i.Role) {
+		return nil, fmt.Errorf("Unknown user role %q", i.Role)
+	}
+
+	path := fmt.Sprintf("/user/%s", i.ID)
+	resp, err := c.PutForm(path, i, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var u *User
+	if err := decodeJSON(&u, resp.Body); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// validUserRole reports whether r is one of the known user roles.
+func validUserRole(r UserRole) bool {
+	switch r {
+	case UserRoleUser, UserRoleBilling, UserRoleEngineer, UserRoleSuperuser:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package fastly
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestClient_Users(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var users []*User
+	record(t, "users/list", func(c *Client) {
+		users, err = c.ListCustomerUsers(&ListCustomerUsersInput{
+			CustomerID: "zwBncFVs2Ixrhd8xxxxxx",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(users) != 2 {
+		t.Fatalf("bad users: %v", users)
+	}
+	if users[0].Login != "admin@example.com" || users[0].Role != UserRoleSuperuser {
+		t.Errorf("bad user: %#v", users[0])
+	}
+
+	var u *User
+	record(t, "users/current", func(c *Client) {
+		u, err = c.GetCurrentUser()
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.ID != "6TGNjlv1QUstI5iMxxxxxx" {
+		t.Errorf("bad id: %q", u.ID)
+	}
+
+	record(t, "users/update", func(c *Client) {
+		u, err = c.UpdateUser(&UpdateUserInput{
+			ID:     "7UHOkmw2RVtuJ6jNxxxxxx",
+			Role:   UserRoleUser,
+			Locked: CBool(true),
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Role != UserRoleUser {
+		t.Errorf("bad role: %q", u.Role)
+	}
+	if !u.Locked {
+		t.Errorf("bad locked: %t", u.Locked)
+	}
+}
+
+func TestClient_ListCustomerUsers_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListCustomerUsers(&ListCustomerUsersInput{})
+	if err != ErrMissingCustomerID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_GetUser_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetUser(&GetUserInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_UpdateUser_validation(t *testing.T) {
+	var err error
+	_, err = testClient.UpdateUser(&UpdateUserInput{})
+	if err != ErrMissingID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.UpdateUser(&UpdateUserInput{
+		ID:   "7UHOkmw2RVtuJ6jNxxxxxx",
+		Role: "admin",
+	})
+	if err == nil || !strings.Contains(err.Error(), "Unknown user role") {
+		t.Errorf("bad error: %s", err)
+	}
+}
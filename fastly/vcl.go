@@ -2,6 +2,7 @@ package fastly
 
 import (
 	"fmt"
+	"net/url"
 	"sort"
 )
 
@@ -44,6 +45,10 @@ func (c *Client) ListVCLs(i *ListVCLsInput) ([]*VCL, error) {
 		return nil, ErrMissingVersion
 	}
 
+	if err := c.requireVCLService(i.Service); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/vcl", i.Service, i.Version)
 	resp, err := c.Get(path, nil)
 	if err != nil {
@@ -83,7 +88,11 @@ func (c *Client) GetVCL(i *GetVCLInput) (*VCL, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/vcl/%s", i.Service, i.Version, i.Name)
+	if err := c.requireVCLService(i.Service); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/vcl/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Get(path, nil)
 	if err != nil {
 		return nil, err
@@ -114,6 +123,10 @@ func (c *Client) GetGeneratedVCL(i *GetGeneratedVCLInput) (*VCL, error) {
 		return nil, ErrMissingVersion
 	}
 
+	if err := c.requireVCLService(i.Service); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/generated_vcl", i.Service, i.Version)
 	resp, err := c.Get(path, nil)
 	if err != nil {
@@ -148,6 +161,10 @@ func (c *Client) CreateVCL(i *CreateVCLInput) (*VCL, error) {
 		return nil, ErrMissingVersion
 	}
 
+	if err := c.requireVCLService(i.Service); err != nil {
+		return nil, err
+	}
+
 	path := fmt.Sprintf("/service/%s/version/%d/vcl", i.Service, i.Version)
 	resp, err := c.PostForm(path, i, nil)
 	if err != nil {
@@ -189,7 +206,11 @@ func (c *Client) UpdateVCL(i *UpdateVCLInput) (*VCL, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/vcl/%s", i.Service, i.Version, i.Name)
+	if err := c.requireVCLService(i.Service); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/vcl/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.PutForm(path, i, nil)
 	if err != nil {
 		return nil, err
@@ -227,7 +248,11 @@ func (c *Client) ActivateVCL(i *ActivateVCLInput) (*VCL, error) {
 		return nil, ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/vcl/%s/main", i.Service, i.Version, i.Name)
+	if err := c.requireVCLService(i.Service); err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/vcl/%s/main", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Put(path, nil)
 	if err != nil {
 		return nil, err
@@ -265,7 +290,11 @@ func (c *Client) DeleteVCL(i *DeleteVCLInput) error {
 		return ErrMissingName
 	}
 
-	path := fmt.Sprintf("/service/%s/version/%d/vcl/%s", i.Service, i.Version, i.Name)
+	if err := c.requireVCLService(i.Service); err != nil {
+		return err
+	}
+
+	path := fmt.Sprintf("/service/%s/version/%d/vcl/%s", i.Service, i.Version, url.PathEscape(i.Name))
 	resp, err := c.Delete(path, nil)
 	if err != nil {
 		return err
@@ -3,6 +3,7 @@ package fastly
 import (
 	"fmt"
 	"sort"
+	"time"
 )
 
 // Version represents a distinct configuration version.
@@ -15,6 +16,10 @@ type Version struct {
 	Deployed  bool   `mapstructure:"deployed"`
 	Staging   bool   `mapstructure:"staging"`
 	Testing   bool   `mapstructure:"testing"`
+
+	CreatedAt *time.Time `mapstructure:"created_at"`
+	UpdatedAt *time.Time `mapstructure:"updated_at"`
+	DeletedAt *time.Time `mapstructure:"deleted_at"`
 }
 
 // versionsByNumber is a sortable list of versions. This is used by the version
@@ -32,14 +37,24 @@ func (s versionsByNumber) Less(i, j int) bool {
 type ListVersionsInput struct {
 	// Service is the ID of the service (required).
 	Service string
+
+	// IncludeDeleted keeps versions the API returns with a DeletedAt time. By
+	// default they are left out.
+	IncludeDeleted bool
 }
 
 // ListVersions returns the full list of all versions of the given service.
+// With a VersionCache on the client, a cached list is returned if there is
+// one.
 func (c *Client) ListVersions(i *ListVersionsInput) ([]*Version, error) {
 	if i.Service == "" {
 		return nil, ErrMissingService
 	}
 
+	if e := c.VersionCache.get(i.Service); e != nil {
+		return withoutDeletedVersions(e, i.IncludeDeleted), nil
+	}
+
 	path := fmt.Sprintf("/service/%s/version", i.Service)
 	resp, err := c.Get(path, nil)
 	if err != nil {
@@ -51,8 +66,24 @@ func (c *Client) ListVersions(i *ListVersionsInput) ([]*Version, error) {
 		return nil, err
 	}
 	sort.Sort(versionsByNumber(e))
+	c.VersionCache.set(i.Service, e)
 
-	return e, nil
+	return withoutDeletedVersions(e, i.IncludeDeleted), nil
+}
+
+// withoutDeletedVersions returns the versions which have not been deleted, or
+// all of them with includeDeleted.
+func withoutDeletedVersions(e []*Version, includeDeleted bool) []*Version {
+	if includeDeleted {
+		return e
+	}
+	kept := make([]*Version, 0, len(e))
+	for _, v := range e {
+		if v.DeletedAt == nil {
+			kept = append(kept, v)
+		}
+	}
+	return kept
 }
 
 // LatestVersionInput is the input to the LatestVersion function.
@@ -100,6 +131,7 @@ func (c *Client) CreateVersion(i *CreateVersionInput) (*Version, error) {
 	if err != nil {
 		return nil, err
 	}
+	c.VersionCache.Invalidate(i.Service)
 
 	var e *Version
 	if err := decodeJSON(&e, resp.Body); err != nil {
@@ -147,6 +179,8 @@ type UpdateVersionInput struct {
 	Service string
 	Version int
 
+	// Comment is a free-form note stored with the version, such as the commit
+	// or change ticket it was built from. It is returned as Version.Comment.
 	Comment string `form:"comment,omitempty"`
 }
 
@@ -165,6 +199,7 @@ func (c *Client) UpdateVersion(i *UpdateVersionInput) (*Version, error) {
 	if err != nil {
 		return nil, err
 	}
+	c.VersionCache.Invalidate(i.Service)
 
 	var e *Version
 	if err := decodeJSON(&e, resp.Body); err != nil {
@@ -196,6 +231,7 @@ func (c *Client) ActivateVersion(i *ActivateVersionInput) (*Version, error) {
 	if err != nil {
 		return nil, err
 	}
+	c.VersionCache.Invalidate(i.Service)
 
 	var e *Version
 	if err := decodeJSON(&e, resp.Body); err != nil {
@@ -204,6 +240,87 @@ func (c *Client) ActivateVersion(i *ActivateVersionInput) (*Version, error) {
 	return e, nil
 }
 
+const (
+	// DefaultWaitForVersionActiveTimeout is the amount of time
+	// WaitForVersionActive waits when no timeout is given.
+	DefaultWaitForVersionActiveTimeout = 5 * time.Minute
+
+	// DefaultWaitForVersionActiveInterval is the initial delay between polls
+	// in WaitForVersionActive when no interval is given.
+	DefaultWaitForVersionActiveInterval = 1 * time.Second
+
+	// maxWaitForVersionActiveInterval caps the backoff between polls.
+	maxWaitForVersionActiveInterval = 30 * time.Second
+)
+
+// WaitForVersionActiveInput is the input to the WaitForVersionActive function.
+type WaitForVersionActiveInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// Timeout is the maximum amount of time to wait for the version to become
+	// active. Interval is the initial delay between polls, which doubles after
+	// each attempt up to 30 seconds. Both are optional.
+	Timeout  time.Duration
+	Interval time.Duration
+}
+
+// WaitForVersionActive polls the service until its active version is the
+// given version. Activation is asynchronous, so this should be called after
+// ActivateVersion by anything that needs to know the new configuration is
+// live. The service is polled once more when the timeout elapses, and
+// ErrWaitForVersionActiveTimeout is returned if the version is still not
+// active then.
+func (c *Client) WaitForVersionActive(i *WaitForVersionActiveInput) (*Service, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	timeout := i.Timeout
+	if timeout == 0 {
+		timeout = DefaultWaitForVersionActiveTimeout
+	}
+
+	interval := i.Interval
+	if interval == 0 {
+		interval = DefaultWaitForVersionActiveInterval
+	}
+
+	deadline := time.Now().Add(timeout)
+	for {
+		s, err := c.GetService(&GetServiceInput{ID: i.Service})
+		if err != nil {
+			return nil, err
+		}
+		if s.ActiveVersion == uint(i.Version) {
+			return s, nil
+		}
+
+		// The last sleep is cut short so that the final poll happens at the
+		// deadline rather than up to an interval before it.
+		remaining := deadline.Sub(time.Now())
+		if remaining <= 0 {
+			return nil, ErrWaitForVersionActiveTimeout
+		}
+		if interval < remaining {
+			time.Sleep(interval)
+		} else {
+			time.Sleep(remaining)
+		}
+
+		interval *= 2
+		if interval > maxWaitForVersionActiveInterval {
+			interval = maxWaitForVersionActiveInterval
+		}
+	}
+}
+
 // DeactivateVersionInput is the input to the DeactivateVersion function.
 type DeactivateVersionInput struct {
 	// Service is the ID of the service. Version is the specific configuration
@@ -227,6 +344,7 @@ func (c *Client) DeactivateVersion(i *DeactivateVersionInput) (*Version, error)
 	if err != nil {
 		return nil, err
 	}
+	c.VersionCache.Invalidate(i.Service)
 
 	var e *Version
 	if err := decodeJSON(&e, resp.Body); err != nil {
@@ -260,6 +378,7 @@ func (c *Client) CloneVersion(i *CloneVersionInput) (*Version, error) {
 	if err != nil {
 		return nil, err
 	}
+	c.VersionCache.Invalidate(i.Service)
 
 	var e *Version
 	if err := decodeJSON(&e, resp.Body); err != nil {
@@ -326,6 +445,7 @@ func (c *Client) LockVersion(i *LockVersionInput) (*Version, error) {
 	if err != nil {
 		return nil, err
 	}
+	c.VersionCache.Invalidate(i.Service)
 
 	var e *Version
 	if err := decodeJSON(&e, resp.Body); err != nil {
@@ -0,0 +1,129 @@
+package fastly
+
+import (
+	"sync"
+	"time"
+)
+
+// VersionCache caches the versions of each service, so code which repeatedly
+// looks up a service's versions, such as its active version during a long
+// apply, does not call the API each time. Entries expire after the cache's
+// TTL and can be invalidated explicitly, such as after changing versions with
+// another client. It is safe for concurrent use, and a nil *VersionCache is
+// a cache which holds nothing. The zero VersionCache is an empty cache whose
+// entries never expire.
+type VersionCache struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[string]*versionCacheEntry
+}
+
+// versionCacheEntry is the cached versions of a single service.
+type versionCacheEntry struct {
+	versions []*Version
+	expires  time.Time
+}
+
+// NewVersionCache returns an empty VersionCache whose entries expire after
+// ttl. A ttl of zero keeps entries until they are invalidated.
+func NewVersionCache(ttl time.Duration) *VersionCache {
+	return &VersionCache{
+		ttl:     ttl,
+		entries: make(map[string]*versionCacheEntry),
+	}
+}
+
+// Invalidate removes the cached versions of a service.
+func (vc *VersionCache) Invalidate(service string) {
+	if vc == nil {
+		return
+	}
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	delete(vc.entries, service)
+}
+
+// InvalidateAll removes every cached entry.
+func (vc *VersionCache) InvalidateAll() {
+	if vc == nil {
+		return
+	}
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+	vc.entries = make(map[string]*versionCacheEntry)
+}
+
+// get returns a copy of the cached versions of a service, or nil if there
+// are none or they have expired.
+func (vc *VersionCache) get(service string) []*Version {
+	if vc == nil {
+		return nil
+	}
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+
+	e, ok := vc.entries[service]
+	if !ok {
+		return nil
+	}
+	if !e.expires.IsZero() && time.Now().After(e.expires) {
+		delete(vc.entries, service)
+		return nil
+	}
+	return copyVersions(e.versions)
+}
+
+// set caches a copy of the versions of a service.
+func (vc *VersionCache) set(service string, versions []*Version) {
+	if vc == nil {
+		return
+	}
+	vc.mu.Lock()
+	defer vc.mu.Unlock()
+
+	e := &versionCacheEntry{versions: copyVersions(versions)}
+	if vc.ttl > 0 {
+		e.expires = time.Now().Add(vc.ttl)
+	}
+	if vc.entries == nil {
+		vc.entries = make(map[string]*versionCacheEntry)
+	}
+	vc.entries[service] = e
+}
+
+// copyVersions returns a deep copy of a list of versions, so cached entries
+// are not shared with callers. An empty list is returned as a non-nil slice.
+func copyVersions(versions []*Version) []*Version {
+	cp := make([]*Version, len(versions))
+	for n, v := range versions {
+		c := *v
+		cp[n] = &c
+	}
+	return cp
+}
+
+// ActiveVersionInput is the input to the ActiveVersion function.
+type ActiveVersionInput struct {
+	// Service is the ID of the service (required).
+	Service string
+}
+
+// ActiveVersion returns the active version of a service, or nil (but not an
+// error) if no version is active. It uses ListVersions, and so the client's
+// VersionCache.
+func (c *Client) ActiveVersion(i *ActiveVersionInput) (*Version, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	list, err := c.ListVersions(&ListVersionsInput{Service: i.Service})
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range list {
+		if v.Active {
+			return v, nil
+		}
+	}
+	return nil, nil
+}
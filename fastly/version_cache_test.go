@@ -0,0 +1,97 @@
+package fastly
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestClient_VersionCache(t *testing.T) {
+	var lists int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == "GET" && r.URL.Path == "/service/foo/version":
+			atomic.AddInt32(&lists, 1)
+			w.Write([]byte(`[{"number":2,"active":false},{"number":1,"active":true}]`))
+		case r.Method == "PUT" && r.URL.Path == "/service/foo/version/2/activate":
+			w.Write([]byte(`{"number":2,"active":true}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.VersionCache = NewVersionCache(0)
+
+	RunBounded(4, 20, func(int) {
+		v, err := c.ActiveVersion(&ActiveVersionInput{Service: "foo"})
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if v == nil || v.Number != 1 {
+			t.Errorf("bad active version: %v", v)
+		}
+		v.Number = 100
+	})
+	if n := atomic.LoadInt32(&lists); n < 1 || n > 4 {
+		t.Errorf("expected the versions to be cached, got %d lists", n)
+	}
+
+	atomic.StoreInt32(&lists, 0)
+	vs, err := c.ListVersions(&ListVersionsInput{Service: "foo"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&lists); n != 0 || vs[0].Number != 1 {
+		t.Errorf("bad cached versions: %d lists, %v", n, vs)
+	}
+
+	if _, err := c.ActivateVersion(&ActivateVersionInput{Service: "foo", Version: 2}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ListVersions(&ListVersionsInput{Service: "foo"}); err != nil {
+		t.Fatal(err)
+	}
+	if n := atomic.LoadInt32(&lists); n != 1 {
+		t.Errorf("expected activation to invalidate the cache, got %d lists", n)
+	}
+
+	c.VersionCache.Invalidate("foo")
+	c.ListVersions(&ListVersionsInput{Service: "foo"})
+	if n := atomic.LoadInt32(&lists); n != 2 {
+		t.Errorf("expected Invalidate to invalidate the cache, got %d lists", n)
+	}
+}
+
+func TestVersionCache_ttl(t *testing.T) {
+	vc := NewVersionCache(10 * time.Millisecond)
+	vc.set("foo", []*Version{{Number: 1}})
+	if vs := vc.get("foo"); len(vs) != 1 {
+		t.Fatalf("bad versions: %v", vs)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+	if vs := vc.get("foo"); vs != nil {
+		t.Errorf("expected the entry to expire, got %v", vs)
+	}
+
+	zero := &VersionCache{}
+	zero.set("foo", []*Version{{Number: 1}})
+	if vs := zero.get("foo"); len(vs) != 1 {
+		t.Errorf("bad versions from zero cache: %v", vs)
+	}
+
+	var nilCache *VersionCache
+	nilCache.set("foo", []*Version{{Number: 1}})
+	nilCache.Invalidate("foo")
+	if vs := nilCache.get("foo"); vs != nil {
+		t.Errorf("bad versions from nil cache: %v", vs)
+	}
+}
@@ -0,0 +1,171 @@
+package fastly
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// VersionDetail is a service version with a summary of the objects attached
+// to it, for checking a version before it is activated.
+type VersionDetail struct {
+	*Version
+
+	// The number of each kind of object in the version.
+	ACLs            int
+	Backends        int
+	CacheSettings   int
+	Conditions      int
+	Dictionaries    int
+	Directors       int
+	Domains         int
+	Gzips           int
+	Headers         int
+	HealthChecks    int
+	RequestSettings int
+	ResponseObjects int
+	Snippets        int
+	VCLs            int
+
+	// LoggingEndpoints is the number of logging endpoints of every type. It
+	// is only set with IncludeLogging.
+	LoggingEndpoints int
+
+	// BackendNames and DomainNames are the names of the version's backends
+	// and domains, and MainVCL the name of its main custom VCL, if any.
+	BackendNames []string
+	DomainNames  []string
+	MainVCL      string
+}
+
+// Problems returns the reasons the version is unlikely to serve traffic
+// correctly if activated, such as having no domains. It returns nil if none
+// are found.
+func (d *VersionDetail) Problems() []string {
+	var problems []string
+	if d.Domains == 0 {
+		problems = append(problems, "version has no domains")
+	}
+	if d.Backends == 0 {
+		problems = append(problems, "version has no backends")
+	}
+	if d.VCLs > 0 && d.MainVCL == "" {
+		problems = append(problems, "version has custom VCL but none is set as main")
+	}
+	return problems
+}
+
+// versionDetailObject is an object attached to a version in a service details
+// response.
+type versionDetailObject struct {
+	Name string `mapstructure:"name"`
+	Main bool   `mapstructure:"main"`
+}
+
+// versionDetailResponse is the part of a service details response used by
+// GetVersionDetail.
+type versionDetailResponse struct {
+	Version *struct {
+		Version `mapstructure:",squash"`
+
+		ACLs            []*versionDetailObject `mapstructure:"acls"`
+		Backends        []*versionDetailObject `mapstructure:"backends"`
+		CacheSettings   []*versionDetailObject `mapstructure:"cache_settings"`
+		Conditions      []*versionDetailObject `mapstructure:"conditions"`
+		Dictionaries    []*versionDetailObject `mapstructure:"dictionaries"`
+		Directors       []*versionDetailObject `mapstructure:"directors"`
+		Domains         []*versionDetailObject `mapstructure:"domains"`
+		Gzips           []*versionDetailObject `mapstructure:"gzips"`
+		Headers         []*versionDetailObject `mapstructure:"headers"`
+		HealthChecks    []*versionDetailObject `mapstructure:"healthchecks"`
+		RequestSettings []*versionDetailObject `mapstructure:"request_settings"`
+		ResponseObjects []*versionDetailObject `mapstructure:"response_objects"`
+		Snippets        []*versionDetailObject `mapstructure:"snippets"`
+		VCLs            []*versionDetailObject `mapstructure:"vcls"`
+	} `mapstructure:"version"`
+}
+
+// GetVersionDetailInput is used as input to the GetVersionDetail function.
+type GetVersionDetailInput struct {
+	// Service is the ID of the service. Version is the specific configuration
+	// version. Both fields are required.
+	Service string
+	Version int
+
+	// IncludeLogging also counts the version's logging endpoints, which are
+	// not part of the service details and take a request per logging type.
+	IncludeLogging bool
+}
+
+// GetVersionDetail returns a version and the number of objects of each kind
+// attached to it, from a single service details request.
+func (c *Client) GetVersionDetail(i *GetVersionDetailInput) (*VersionDetail, error) {
+	if i.Service == "" {
+		return nil, ErrMissingService
+	}
+
+	if i.Version == 0 {
+		return nil, ErrMissingVersion
+	}
+
+	path := fmt.Sprintf("/service/%s/details", i.Service)
+	resp, err := c.Get(path, &RequestOptions{
+		Params: map[string]string{
+			"version": strconv.Itoa(i.Version),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var r *versionDetailResponse
+	if err := decodeJSON(&r, resp.Body); err != nil {
+		return nil, err
+	}
+	if r == nil || r.Version == nil {
+		return nil, fmt.Errorf("service %s has no version %d", i.Service, i.Version)
+	}
+
+	v := r.Version
+	version := v.Version
+	d := &VersionDetail{
+		Version:         &version,
+		ACLs:            len(v.ACLs),
+		Backends:        len(v.Backends),
+		CacheSettings:   len(v.CacheSettings),
+		Conditions:      len(v.Conditions),
+		Dictionaries:    len(v.Dictionaries),
+		Directors:       len(v.Directors),
+		Domains:         len(v.Domains),
+		Gzips:           len(v.Gzips),
+		Headers:         len(v.Headers),
+		HealthChecks:    len(v.HealthChecks),
+		RequestSettings: len(v.RequestSettings),
+		ResponseObjects: len(v.ResponseObjects),
+		Snippets:        len(v.Snippets),
+		VCLs:            len(v.VCLs),
+	}
+	for _, b := range v.Backends {
+		d.BackendNames = append(d.BackendNames, b.Name)
+	}
+	for _, dom := range v.Domains {
+		d.DomainNames = append(d.DomainNames, dom.Name)
+	}
+	for _, vcl := range v.VCLs {
+		if vcl.Main {
+			d.MainVCL = vcl.Name
+		}
+	}
+
+	if i.IncludeLogging {
+		es, err := c.ListAllLoggingEndpoints(&ListAllLoggingEndpointsInput{
+			Service: i.Service,
+			Version: i.Version,
+		})
+		if err != nil {
+			return nil, err
+		}
+		d.LoggingEndpoints = len(es)
+	}
+
+	return d, nil
+}
@@ -0,0 +1,93 @@
+package fastly
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+)
+
+// VersionLockedError is returned by a change to a locked or active version
+// when GuardLockedVersions is set on the client.
+type VersionLockedError struct {
+	Service string
+	Version int
+	Active  bool
+	Locked  bool
+}
+
+// Error implements the error interface.
+func (e *VersionLockedError) Error() string {
+	state := "locked"
+	if e.Active {
+		state = "active"
+	}
+	return fmt.Sprintf("version %d of service %s is %s and cannot be changed; "+
+		"use CloneVersion to create an editable copy", e.Version, e.Service, state)
+}
+
+// versionedPath matches the API paths of the objects of a service version,
+// such as /service/<id>/version/<number>/backend.
+var versionedPath = regexp.MustCompile(`^/?service/([^/]+)/version/(\d+)/([^/]+)`)
+
+// versionActions are the version paths which act on the version itself, and
+// so are allowed on locked and active versions.
+var versionActions = map[string]bool{
+	"activate":   true,
+	"deactivate": true,
+	"clone":      true,
+	"lock":       true,
+	"validate":   true,
+}
+
+// checkVersionGuard returns a *VersionLockedError for a change to the objects
+// of a locked or active version when GuardLockedVersions is set. The version
+// is looked up with ListVersions when the client has a VersionCache, and with
+// GetVersion otherwise.
+func (c *Client) checkVersionGuard(verb, p string) error {
+	if !c.GuardLockedVersions {
+		return nil
+	}
+	switch verb {
+	case "POST", "PUT", "PATCH", "DELETE":
+	default:
+		return nil
+	}
+
+	m := versionedPath.FindStringSubmatch(p)
+	if m == nil || versionActions[m[3]] {
+		return nil
+	}
+	service := m[1]
+	number, err := strconv.Atoi(m[2])
+	if err != nil {
+		return nil
+	}
+
+	var v *Version
+	if c.VersionCache != nil {
+		vs, err := c.ListVersions(&ListVersionsInput{Service: service, IncludeDeleted: true})
+		if err != nil {
+			return err
+		}
+		for _, e := range vs {
+			if e.Number == number {
+				v = e
+			}
+		}
+	} else {
+		v, err = c.GetVersion(&GetVersionInput{Service: service, Version: number})
+		if err != nil {
+			return err
+		}
+	}
+
+	if v != nil && (v.Locked || v.Active) {
+		return &VersionLockedError{
+			Service: service,
+			Version: number,
+			Active:  v.Active,
+			Locked:  v.Locked,
+		}
+	}
+	return nil
+}
@@ -0,0 +1,58 @@
+package fastly
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestClient_GuardLockedVersions(t *testing.T) {
+	var creates int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /service/foo/version/1":
+			w.Write([]byte(`{"number":1,"active":true,"locked":true}`))
+		case "GET /service/foo/version/2":
+			w.Write([]byte(`{"number":2,"locked":true}`))
+		case "GET /service/foo/version/3":
+			w.Write([]byte(`{"number":3}`))
+		case "POST /service/foo/version/3/backend":
+			atomic.AddInt32(&creates, 1)
+			w.Write([]byte(`{"name":"origin"}`))
+		case "PUT /service/foo/version/2/clone":
+			w.Write([]byte(`{"number":3}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.GuardLockedVersions = true
+
+	_, err = c.CreateBackend(&CreateBackendInput{Service: "foo", Version: 1, Name: "origin"})
+	if e, ok := err.(*VersionLockedError); !ok || !e.Active {
+		t.Errorf("bad error: %v", err)
+	}
+	_, err = c.CreateBackend(&CreateBackendInput{Service: "foo", Version: 2, Name: "origin"})
+	if e, ok := err.(*VersionLockedError); !ok || e.Active || !e.Locked {
+		t.Errorf("bad error: %v", err)
+	}
+	if n := atomic.LoadInt32(&creates); n != 0 {
+		t.Errorf("expected no creates to be sent, got %d", n)
+	}
+
+	if _, err := c.CloneVersion(&CloneVersionInput{Service: "foo", Version: 2}); err != nil {
+		t.Errorf("expected clone to be allowed: %v", err)
+	}
+	if _, err := c.CreateBackend(&CreateBackendInput{Service: "foo", Version: 3, Name: "origin"}); err != nil {
+		t.Error(err)
+	}
+	if n := atomic.LoadInt32(&creates); n != 1 {
+		t.Errorf("expected 1 create, got %d", n)
+	}
+}
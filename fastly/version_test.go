@@ -1,8 +1,13 @@
 package fastly
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"sort"
+	"sync"
 	"testing"
+	"time"
 )
 
 func TestClient_Versions(t *testing.T) {
@@ -194,6 +199,117 @@ func TestClient_ActivateVersion_validation(t *testing.T) {
 	}
 }
 
+func TestClient_WaitForVersionActive(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var s *Service
+	record(t, "versions/wait_active", func(c *Client) {
+		s, err = c.WaitForVersionActive(&WaitForVersionActiveInput{
+			Service: testServiceID,
+			Version: 805,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.ActiveVersion != 805 {
+		t.Errorf("bad active version: %d", s.ActiveVersion)
+	}
+
+	record(t, "versions/wait_active", func(c *Client) {
+		_, err = c.WaitForVersionActive(&WaitForVersionActiveInput{
+			Service:  testServiceID,
+			Version:  806,
+			Timeout:  10 * time.Millisecond,
+			Interval: time.Millisecond,
+		})
+	})
+	if err != ErrWaitForVersionActiveTimeout {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
+func TestClient_WaitForVersionActive_deadline(t *testing.T) {
+	var mu sync.Mutex
+	var polls, activeAt int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		polls++
+		version := 1
+		if activeAt > 0 && polls >= activeAt {
+			version = 2
+		}
+		fmt.Fprintf(w, `{"id":"foo","version":%d}`, version)
+	}))
+	defer srv.Close()
+
+	c, err := NewClientForEndpoint("", srv.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// With a 300ms timeout and a 200ms interval the polls are at 0ms, 200ms
+	// and, with the second sleep cut short, at the 300ms deadline.
+	input := &WaitForVersionActiveInput{
+		Service:  "foo",
+		Version:  2,
+		Timeout:  300 * time.Millisecond,
+		Interval: 200 * time.Millisecond,
+	}
+
+	mu.Lock()
+	activeAt = 3
+	mu.Unlock()
+
+	s, err := c.WaitForVersionActive(input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.ActiveVersion != 2 {
+		t.Errorf("bad active version: %d", s.ActiveVersion)
+	}
+
+	mu.Lock()
+	polls, activeAt = 0, 0
+	mu.Unlock()
+
+	start := time.Now()
+	_, err = c.WaitForVersionActive(input)
+	if err != ErrWaitForVersionActiveTimeout {
+		t.Errorf("bad error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < input.Timeout {
+		t.Errorf("expected to wait until the deadline, returned after %s", elapsed)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if polls != 3 {
+		t.Errorf("expected 3 polls, got %d", polls)
+	}
+}
+
+func TestClient_WaitForVersionActive_validation(t *testing.T) {
+	var err error
+	_, err = testClient.WaitForVersionActive(&WaitForVersionActiveInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.WaitForVersionActive(&WaitForVersionActiveInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
 func TestClient_DeactivateVersion_validation(t *testing.T) {
 	var err error
 	_, err = testClient.DeactivateVersion(&DeactivateVersionInput{
@@ -265,3 +381,72 @@ func TestClient_LockVersion_validation(t *testing.T) {
 		t.Errorf("bad error: %s", err)
 	}
 }
+
+func TestClient_ListVersions_deleted(t *testing.T) {
+	t.Parallel()
+
+	var live, all []*Version
+	var err error
+	record(t, "versions/list_deleted", func(c *Client) {
+		live, err = c.ListVersions(&ListVersionsInput{Service: testServiceID})
+		if err != nil {
+			return
+		}
+		all, err = c.ListVersions(&ListVersionsInput{Service: testServiceID, IncludeDeleted: true})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(live) != 2 || live[0].Number != 2 {
+		t.Errorf("bad versions: %v", live)
+	}
+	if len(all) != 3 || all[0].DeletedAt == nil || all[1].CreatedAt == nil {
+		t.Errorf("bad versions: %v", all)
+	}
+}
+
+func TestClient_GetVersionDetail(t *testing.T) {
+	t.Parallel()
+
+	var d *VersionDetail
+	var err error
+	record(t, "versions/detail", func(c *Client) {
+		d, err = c.GetVersionDetail(&GetVersionDetailInput{
+			Service: testServiceID,
+			Version: 4,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Number != 4 || d.Comment != "release candidate" || d.CreatedAt == nil {
+		t.Errorf("bad version: %+v", d.Version)
+	}
+	if d.Backends != 2 || d.Domains != 1 || d.Snippets != 1 || d.Conditions != 1 || d.Gzips != 1 || d.ACLs != 0 {
+		t.Errorf("bad counts: %+v", d)
+	}
+	if len(d.BackendNames) != 2 || d.BackendNames[1] != "origin-b" || d.DomainNames[0] != "www.example.com" {
+		t.Errorf("bad names: %v %v", d.BackendNames, d.DomainNames)
+	}
+	if p := d.Problems(); len(p) != 1 {
+		t.Errorf("bad problems: %v", p)
+	}
+}
+
+func TestClient_GetVersionDetail_validation(t *testing.T) {
+	var err error
+	_, err = testClient.GetVersionDetail(&GetVersionDetailInput{
+		Service: "",
+	})
+	if err != ErrMissingService {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetVersionDetail(&GetVersionDetailInput{
+		Service: "foo",
+		Version: 0,
+	})
+	if err != ErrMissingVersion {
+		t.Errorf("bad error: %s", err)
+	}
+}
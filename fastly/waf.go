@@ -59,7 +59,12 @@ func (c *Client) ListWAFs(i *ListWAFsInput) ([]*WAF, error) {
 		return nil, err
 	}
 
-	data, err := jsonapi.UnmarshalManyPayload(resp.Body, wafType)
+	body, err := compatWAFBody(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := jsonapi.UnmarshalManyPayload(body, wafType)
 	if err != nil {
 		return nil, err
 	}
@@ -103,8 +108,13 @@ func (c *Client) CreateWAF(i *CreateWAFInput) (*WAF, error) {
 		return nil, err
 	}
 
+	body, err := compatWAFBody(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
 	var waf WAF
-	if err := jsonapi.UnmarshalPayload(resp.Body, &waf); err != nil {
+	if err := jsonapi.UnmarshalPayload(body, &waf); err != nil {
 		return nil, err
 	}
 	return &waf, nil
@@ -140,8 +150,13 @@ func (c *Client) GetWAF(i *GetWAFInput) (*WAF, error) {
 		return nil, err
 	}
 
+	body, err := compatWAFBody(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
 	var waf WAF
-	if err := jsonapi.UnmarshalPayload(resp.Body, &waf); err != nil {
+	if err := jsonapi.UnmarshalPayload(body, &waf); err != nil {
 		return nil, err
 	}
 	return &waf, nil
@@ -179,8 +194,13 @@ func (c *Client) UpdateWAF(i *UpdateWAFInput) (*WAF, error) {
 		return nil, err
 	}
 
+	body, err := compatWAFBody(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
 	var waf WAF
-	if err := jsonapi.UnmarshalPayload(resp.Body, &waf); err != nil {
+	if err := jsonapi.UnmarshalPayload(body, &waf); err != nil {
 		return nil, err
 	}
 	return &waf, nil
@@ -1012,3 +1032,196 @@ func (c *Client) interpretWAFCongfigSetResponse(answer *UpdateWAFConfigSetRespon
 
 	return nil
 }
+
+// WAFRuleTag is a tag that groups WAF rules, such as "OWASP" or
+// "application-multi".
+type WAFRuleTag struct {
+	ID   string `jsonapi:"primary,tag"`
+	Name string `jsonapi:"attr,name"`
+}
+
+// ListWAFRuleTagsInput is used as input to the ListWAFRuleTags function.
+type ListWAFRuleTagsInput struct {
+	// Name limits the returned tags to those with the given name. Optional.
+	Name string
+
+	// MaxResults is the number of tags to return per request. Optional.
+	MaxResults int
+}
+
+// ListWAFRuleTags returns the tags that WAF rules can be grouped by, following
+// every page of results.
+func (c *Client) ListWAFRuleTags(i *ListWAFRuleTagsInput) ([]*WAFRuleTag, error) {
+	params := map[string]string{}
+	if i.Name != "" {
+		params["filter[name]"] = i.Name
+	}
+	if i.MaxResults != 0 {
+		params["page[size]"] = strconv.Itoa(i.MaxResults)
+	}
+
+	data, err := c.getAllJSONAPIPages("/wafs/tags", params, reflect.TypeOf(new(WAFRuleTag)))
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*WAFRuleTag, len(data))
+	for i := range data {
+		typed, ok := data[i].(*WAFRuleTag)
+		if !ok {
+			return nil, fmt.Errorf("got back a non-WAFRuleTag response")
+		}
+		tags[i] = typed
+	}
+	return tags, nil
+}
+
+// ListRulesByTagInput is used as input to the ListRulesByTag function.
+type ListRulesByTagInput struct {
+	// Tag is the name of the tag, such as "OWASP", and is required.
+	Tag string
+}
+
+// ListRulesByTag returns every WAF rule with the given tag, following every
+// page of results.
+func (c *Client) ListRulesByTag(i *ListRulesByTagInput) ([]*Rule, error) {
+	if i.Tag == "" {
+		return nil, ErrMissingTag
+	}
+
+	params := map[string]string{
+		"filter[tags][name]": i.Tag,
+	}
+
+	data, err := c.getAllJSONAPIPages("/wafs/rules", params, rulesType)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]*Rule, len(data))
+	for i := range data {
+		typed, ok := data[i].(*Rule)
+		if !ok {
+			return nil, fmt.Errorf("got back a non-Rules response")
+		}
+		rules[i] = typed
+	}
+	return rules, nil
+}
+
+// WAFRuleRevision is a single revision of a WAF rule. Source is only set when
+// it was requested and the account is permitted to view it.
+type WAFRuleRevision struct {
+	ID       string `jsonapi:"primary,rule_revision"`
+	RuleID   string `jsonapi:"attr,rule_id,omitempty"`
+	Revision int    `jsonapi:"attr,revision,omitempty"`
+	Message  string `jsonapi:"attr,message,omitempty"`
+	Severity int    `jsonapi:"attr,severity,omitempty"`
+	State    string `jsonapi:"attr,state,omitempty"`
+	Source   string `jsonapi:"attr,source,omitempty"`
+	VCL      string `jsonapi:"attr,vcl,omitempty"`
+}
+
+// ListWAFRuleRevisionsInput is used as input to the ListWAFRuleRevisions
+// function.
+type ListWAFRuleRevisionsInput struct {
+	// RuleID is the ID of the rule and is required.
+	RuleID string
+}
+
+// ListWAFRuleRevisions returns every revision of a WAF rule.
+func (c *Client) ListWAFRuleRevisions(i *ListWAFRuleRevisionsInput) ([]*WAFRuleRevision, error) {
+	if i.RuleID == "" {
+		return nil, ErrMissingRuleID
+	}
+
+	path := fmt.Sprintf("/wafs/rules/%s/rule_revisions", i.RuleID)
+	data, err := c.getAllJSONAPIPages(path, nil, reflect.TypeOf(new(WAFRuleRevision)))
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*WAFRuleRevision, len(data))
+	for i := range data {
+		typed, ok := data[i].(*WAFRuleRevision)
+		if !ok {
+			return nil, fmt.Errorf("got back a non-WAFRuleRevision response")
+		}
+		revisions[i] = typed
+	}
+	return revisions, nil
+}
+
+// GetWAFRuleRevisionInput is used as input to the GetWAFRuleRevision function.
+type GetWAFRuleRevisionInput struct {
+	// RuleID is the ID of the rule. Revision is the revision number. Both are
+	// required.
+	RuleID   string
+	Revision int
+
+	// IncludeSource requests the rule's source. The API returns a 403 if the
+	// account is not permitted to view it.
+	IncludeSource bool
+}
+
+// GetWAFRuleRevision gets a single revision of a WAF rule.
+func (c *Client) GetWAFRuleRevision(i *GetWAFRuleRevisionInput) (*WAFRuleRevision, error) {
+	if i.RuleID == "" {
+		return nil, ErrMissingRuleID
+	}
+
+	if i.Revision == 0 {
+		return nil, ErrMissingRevision
+	}
+
+	ro := &RequestOptions{Params: map[string]string{}}
+	if i.IncludeSource {
+		ro.Params["include"] = "source"
+	}
+
+	path := fmt.Sprintf("/wafs/rules/%s/rule_revisions/%d", i.RuleID, i.Revision)
+	resp, err := c.Get(path, ro)
+	if err != nil {
+		return nil, err
+	}
+
+	var revision WAFRuleRevision
+	if err := jsonapi.UnmarshalPayload(resp.Body, &revision); err != nil {
+		return nil, err
+	}
+	return &revision, nil
+}
+
+// getAllJSONAPIPages fetches a JSON API collection and every following page
+// of it, decoding each item as the given type.
+func (c *Client) getAllJSONAPIPages(path string, params map[string]string, t reflect.Type) ([]interface{}, error) {
+	resp, err := c.Get(path, &RequestOptions{Params: params})
+	if err != nil {
+		return nil, err
+	}
+
+	var all []interface{}
+	for {
+		pages, body, err := getPages(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := jsonapi.UnmarshalManyPayload(body, t)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, data...)
+
+		if pages.Next == "" {
+			return all, nil
+		}
+
+		// NOTE: pages.Next URL includes filters already
+		resp, err = c.SimpleGet(pages.Next)
+		if err != nil {
+			return nil, err
+		}
+	}
+}
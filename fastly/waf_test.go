@@ -326,6 +326,99 @@ func TestClient_GetWAF_validation(t *testing.T) {
 // 	}
 // }
 
+func TestClient_WAFRuleTags(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var tags []*WAFRuleTag
+	record(t, "wafs/tags", func(c *Client) {
+		tags, err = c.ListWAFRuleTags(&ListWAFRuleTagsInput{
+			MaxResults: 2,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tags) != 3 {
+		t.Fatalf("bad tags: %v", tags)
+	}
+	if tags[0].Name != "OWASP" {
+		t.Errorf("bad name: %q", tags[0].Name)
+	}
+
+	var rules []*Rule
+	record(t, "wafs/rules_by_tag", func(c *Client) {
+		rules, err = c.ListRulesByTag(&ListRulesByTagInput{
+			Tag: "OWASP",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rules) != 2 {
+		t.Fatalf("bad rules: %v", rules)
+	}
+	if rules[1].RuleID != "941100" {
+		t.Errorf("bad rule_id: %q", rules[1].RuleID)
+	}
+}
+
+func TestClient_WAFRuleRevisions(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	var revisions []*WAFRuleRevision
+	record(t, "wafs/rule_revisions", func(c *Client) {
+		revisions, err = c.ListWAFRuleRevisions(&ListWAFRuleRevisionsInput{
+			RuleID: "941100",
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(revisions) != 2 {
+		t.Fatalf("bad revisions: %v", revisions)
+	}
+
+	var revision *WAFRuleRevision
+	record(t, "wafs/rule_revisions", func(c *Client) {
+		revision, err = c.GetWAFRuleRevision(&GetWAFRuleRevisionInput{
+			RuleID:        "941100",
+			Revision:      2,
+			IncludeSource: true,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revision.Revision != 2 {
+		t.Errorf("bad revision: %d", revision.Revision)
+	}
+	if revision.Source == "" {
+		t.Errorf("bad source: %q", revision.Source)
+	}
+}
+
+func TestClient_WAFRuleTags_validation(t *testing.T) {
+	var err error
+	_, err = testClient.ListRulesByTag(&ListRulesByTagInput{})
+	if err != ErrMissingTag {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.ListWAFRuleRevisions(&ListWAFRuleRevisionsInput{})
+	if err != ErrMissingRuleID {
+		t.Errorf("bad error: %s", err)
+	}
+
+	_, err = testClient.GetWAFRuleRevision(&GetWAFRuleRevisionInput{
+		RuleID: "941100",
+	})
+	if err != ErrMissingRevision {
+		t.Errorf("bad error: %s", err)
+	}
+}
+
 func TestUpdateWAFRuleStatusesInput_validate(t *testing.T) {
 	tests := []struct {
 		description string